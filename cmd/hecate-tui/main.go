@@ -43,6 +43,7 @@ func main() {
 		a,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(),
+		tea.WithOutput(a.Output()),
 	)
 
 	if _, err := p.Run(); err != nil {
@@ -166,6 +167,9 @@ OPTIONS:
 ENVIRONMENT:
     HECATE_SOCKET         Path to Unix socket (preferred over TCP)
     HECATE_URL            Hecate daemon URL (default: http://localhost:4444)
+    HECATE_CA_CERT        CA bundle (PEM) for an https:// HECATE_URL
+    HECATE_TLS_INSECURE   Set to "1" to skip TLS certificate checks (testing only)
+    HECATE_TOKEN          Bearer token for a TCP daemon that requires auth
     HECATE_SKIP_GEO_CHECK Set to "1" to skip geo-restriction check
 
 CONNECTION:
@@ -2,6 +2,7 @@ package alc
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +14,7 @@ type VentureConfig struct {
 	VentureID string `json:"venture_id"`
 	Name      string `json:"name"`
 	Brief     string `json:"brief,omitempty"`
+	Root      string `json:"root,omitempty"`
 }
 
 // DetectResult holds the result of venture detection.
@@ -20,18 +22,25 @@ type DetectResult struct {
 	Found  bool
 	Source string // "git" or "config"
 	Config *VentureConfig
+	Err    error // the nearest .hecate/venture.json could not be used
 }
 
 // DetectVenture attempts to detect a venture from the current directory.
 // It checks (in order):
-// 1. Git remote URL - matches against known ventures (via daemon API)
-// 2. .hecate/venture.json in CWD or parent directories
+// 1. .hecate/venture.json in CWD or parent directories
+// 2. Git remote URL - matches against known ventures (via daemon API)
 //
 // Returns the detection result. Caller should use the daemon API to resolve
-// the venture ID to full venture info.
+// the venture ID to full venture info. A malformed manifest is reported in
+// Err and stops detection, since falling back to the git remote would
+// silently pick a venture the manifest did not name.
 func DetectVenture() DetectResult {
 	// First, try to find .hecate/venture.json
-	if config := findVentureConfig(); config != nil {
+	config, err := findVentureConfig()
+	if err != nil {
+		return DetectResult{Err: err}
+	}
+	if config != nil {
 		return DetectResult{
 			Found:  true,
 			Source: "config",
@@ -55,11 +64,13 @@ func DetectVenture() DetectResult {
 	return DetectResult{Found: false}
 }
 
-// findVentureConfig searches for .hecate/venture.json in CWD and parent directories.
-func findVentureConfig() *VentureConfig {
+// findVentureConfig searches for .hecate/venture.json in CWD and parent
+// directories, returning an error if the nearest one is unreadable JSON or
+// names no venture.
+func findVentureConfig() (*VentureConfig, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
-		return nil
+		return nil, nil
 	}
 
 	dir := cwd
@@ -67,9 +78,15 @@ func findVentureConfig() *VentureConfig {
 		configPath := filepath.Join(dir, ".hecate", "venture.json")
 		if data, err := os.ReadFile(configPath); err == nil {
 			var config VentureConfig
-			if json.Unmarshal(data, &config) == nil && config.VentureID != "" {
-				return &config
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("%s is malformed: %w", configPath, err)
+			}
+			if config.VentureID == "" {
+				return nil, fmt.Errorf("%s has no venture_id", configPath)
 			}
+			// Trust where we found it over what was recorded at init
+			config.Root = dir
+			return &config, nil
 		}
 
 		// Move to parent directory
@@ -81,7 +98,23 @@ func findVentureConfig() *VentureConfig {
 		dir = parent
 	}
 
-	return nil
+	return nil, nil
+}
+
+// EnterRoot changes the working directory to a venture root.
+// Returns a descriptive error if the directory has been moved or deleted.
+func EnterRoot(root string) error {
+	info, err := os.Stat(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("venture directory no longer exists: %s", root)
+		}
+		return fmt.Errorf("cannot access venture directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("venture root is not a directory: %s", root)
+	}
+	return os.Chdir(root)
 }
 
 // getGitRemoteURL returns the git remote origin URL if in a git repository.
@@ -0,0 +1,89 @@
+package alc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEnterRoot_ChangesDirectory(t *testing.T) {
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(orig) })
+
+	root := t.TempDir()
+	if err := EnterRoot(root); err != nil {
+		t.Fatalf("EnterRoot(%q) error = %v", root, err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := filepath.EvalSymlinks(root)
+	got, _ := filepath.EvalSymlinks(cwd)
+	if got != want {
+		t.Errorf("cwd = %q, want %q", got, want)
+	}
+}
+
+func TestEnterRoot_Missing(t *testing.T) {
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	missing := filepath.Join(t.TempDir(), "gone")
+	err = EnterRoot(missing)
+	if err == nil {
+		t.Fatal("EnterRoot on a missing directory should return an error")
+	}
+	if !strings.Contains(err.Error(), "no longer exists") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	cwd, _ := os.Getwd()
+	if cwd != orig {
+		t.Errorf("cwd changed to %q after failed EnterRoot", cwd)
+	}
+}
+
+func TestDetectVenture_Manifest(t *testing.T) {
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(orig) })
+
+	root := t.TempDir()
+	hecate := filepath.Join(root, ".hecate")
+	if err := os.MkdirAll(filepath.Join(root, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(hecate, 0755); err != nil {
+		t.Fatal(err)
+	}
+	manifest := filepath.Join(hecate, "venture.json")
+	if err := os.WriteFile(manifest, []byte(`{"venture_id": "ven-1", "name": "shop"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Join(root, "sub")); err != nil {
+		t.Fatal(err)
+	}
+
+	result := DetectVenture()
+	if result.Err != nil || !result.Found || result.Config.VentureID != "ven-1" {
+		t.Fatalf("DetectVenture() = %+v, want ven-1 from the parent manifest", result)
+	}
+
+	if err := os.WriteFile(manifest, []byte(`{"venture_id": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result = DetectVenture()
+	if result.Found || result.Err == nil || !strings.Contains(result.Err.Error(), "malformed") {
+		t.Errorf("malformed manifest: DetectVenture() = %+v, want a malformed error", result)
+	}
+}
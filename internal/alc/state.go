@@ -35,6 +35,7 @@ type VentureInfo struct {
 	Name        string    // e.g., "auth-system"
 	Brief       string    // Short description
 	InitiatedAt time.Time // When the venture was created
+	Root        string    // Local checkout directory, if known
 }
 
 // DepartmentInfo holds information about the current department (bounded context).
@@ -53,7 +54,7 @@ type State struct {
 	Department *DepartmentInfo
 
 	// Source indicates how the context was detected
-	// "manual" = user selected, "git" = auto-detected from git remote, "config" = from .hecate/venture.json
+	// "manual" = user selected, "git" = auto-detected from git remote, "auto" = from .hecate/venture.json
 	DetectionSource string
 }
 
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -8,18 +9,16 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/chat"
 	"github.com/hecate-social/hecate-tui/internal/commands"
 	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/factbus"
 	"github.com/hecate-social/hecate-tui/internal/modes"
 	"github.com/hecate-social/hecate-tui/internal/statusbar"
 	"github.com/hecate-social/hecate-tui/internal/studio"
-	"github.com/hecate-social/hecate-tui/internal/studios/arcade"
-	"github.com/hecate-social/hecate-tui/internal/studios/devops"
 	llmstudio "github.com/hecate-social/hecate-tui/internal/studios/llm"
-	"github.com/hecate-social/hecate-tui/internal/studios/node"
-	"github.com/hecate-social/hecate-tui/internal/studios/social"
 	"github.com/hecate-social/hecate-tui/internal/theme"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 
 	"github.com/hecate-social/hecate-tui/internal/client"
 )
@@ -34,10 +33,17 @@ type App struct {
 	width  int
 	height int
 
+	// Resize debouncing: the latest pending size and the last settled one
+	resizeSeq   int
+	settledSize tea.WindowSizeMsg
+
 	// Studios
-	studios      []studio.Studio
-	activeStudio int
-	showHome     bool // first launch = home screen
+	studios       []studio.Studio
+	activeStudio  int
+	showHome      bool // first launch = home screen
+	showSwitcher  bool // studio picker overlay (ctrl+s)
+	switcherIndex int
+	keysView      *ui.TextViewer // /keys reference overlay
 
 	// Command mode (shell-level)
 	inCommandMode bool
@@ -57,6 +63,8 @@ type App struct {
 
 	// Health polling
 	daemonStatus string
+	healthDelay  time.Duration
+	healthGen    int // current health polling chain; older ticks are dropped
 
 	// Fact stream (SSE from daemon)
 	factConn            *factbus.Connection
@@ -64,8 +72,20 @@ type App struct {
 	rxActive            bool
 	txActive            bool
 
+	// Start of the response being generated, for completion notifications
+	responseStart time.Time
+
+	// Terminal output shared by the renderer and notifications
+	out *syncOutput
+
 	// Flash notification (shown in hints area, auto-clears)
 	flashMsg string
+
+	// Connection warning flashed once the UI starts
+	startupNotice string
+
+	// Terminal color support, used to degrade themes on switch
+	colorDepth theme.ColorDepth
 }
 
 // New creates a new App with the modal chat interface.
@@ -74,12 +94,39 @@ func New(hecateURL string) *App {
 	if cfg.DaemonURL() != "" && hecateURL == "http://localhost:4444" {
 		hecateURL = cfg.DaemonURL()
 	}
-	return newApp(client.New(hecateURL), cfg)
+	opts := client.TLSOptionsFromEnv(client.TLSOptions{
+		CAFile:             cfg.Connection.CACert,
+		InsecureSkipVerify: cfg.Connection.InsecureSkipVerify,
+	})
+	c, err := client.NewWithTLS(hecateURL, opts)
+	notice := ""
+	if err != nil {
+		notice = "TLS setup failed (" + err.Error() + "), using system CAs"
+		c = client.New(hecateURL)
+	} else if c.Insecure() {
+		notice = "TLS certificate verification is OFF for " + hecateURL
+	}
+	if notice != "" {
+		fmt.Fprintln(os.Stderr, "Warning: "+notice)
+	}
+	token := cfg.Connection.Token
+	if env := os.Getenv("HECATE_TOKEN"); env != "" {
+		token = env
+	}
+	c.SetToken(token)
+	c.SetTimeout(time.Duration(cfg.Connection.Timeout) * time.Second)
+
+	a := newApp(c, cfg)
+	a.startupNotice = notice
+	return a
 }
 
 // NewWithSocket creates a new App connected via Unix domain socket.
 func NewWithSocket(socketPath string) *App {
-	return newApp(client.NewWithSocket(socketPath), config.Load())
+	cfg := config.Load()
+	c := client.NewWithSocket(socketPath)
+	c.SetTimeout(time.Duration(cfg.Connection.Timeout) * time.Second)
+	return newApp(c, cfg)
 }
 
 // newApp builds the App with all shared initialization.
@@ -90,6 +137,9 @@ func newApp(c *client.Client, cfg config.Config) *App {
 			t = saved
 		}
 	}
+	theme.ApplyColorOverride()
+	depth := theme.DetectColorDepth()
+	t = t.ForDepth(depth)
 	s := t.ComputeStyles()
 
 	ci := textinput.New()
@@ -105,12 +155,7 @@ func newApp(c *client.Client, cfg config.Config) *App {
 	}
 
 	// Create factbus connection
-	var fc *factbus.Connection
-	if c.SocketPath() != "" {
-		fc = factbus.NewConnection(c.SocketPath(), "http://localhost")
-	} else {
-		fc = factbus.NewConnection("", c.BaseURL())
-	}
+	fc := factbus.NewConnection(c)
 
 	// Create studio context (shared resources)
 	ctx := &studio.Context{
@@ -122,13 +167,7 @@ func newApp(c *client.Client, cfg config.Config) *App {
 	}
 
 	// Create all studios
-	studios := []studio.Studio{
-		llmstudio.New(ctx),
-		devops.New(ctx),
-		node.New(ctx),
-		social.New(ctx),
-		arcade.New(ctx),
-	}
+	studios := newStudios(ctx)
 
 	// Determine initial studio
 	activeStudio := 0
@@ -150,14 +189,15 @@ func newApp(c *client.Client, cfg config.Config) *App {
 		cmdInput:     ci,
 		registry:     commands.NewRegistry(),
 		factConn:     fc,
+		colorDepth:   depth,
+		out:          &syncOutput{File: os.Stdout},
 	}
 }
 
 // Init starts the app — health polling, fact stream, active studio init.
 func (a *App) Init() tea.Cmd {
 	cmds := []tea.Cmd{
-		a.checkHealth,
-		a.scheduleHealthTick(),
+		a.checkHealth(a.healthGen),
 		a.factConn.Subscribe(),
 	}
 
@@ -165,6 +205,9 @@ func (a *App) Init() tea.Cmd {
 		a.studios[a.activeStudio].SetFocused(true)
 		cmds = append(cmds, a.studios[a.activeStudio].Init())
 	}
+	if a.startupNotice != "" {
+		cmds = append(cmds, a.setFlash(a.startupNotice))
+	}
 
 	return tea.Batch(cmds...)
 }
@@ -181,6 +224,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
+		if a.shouldDebounceResize(msg) {
+			return a, a.debounceResize(msg)
+		}
+		a.resizeSeq++ // drop any pending size; this one wins
 		a.width = msg.Width
 		a.height = msg.Height
 		a.statusBar.SetWidth(msg.Width)
@@ -188,6 +235,23 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for _, s := range a.studios {
 			s.SetSize(msg.Width, contentHeight)
 		}
+		if a.keysView != nil {
+			a.keysView.SetSize(msg.Width, contentHeight)
+		}
+
+	case resizeSettledMsg:
+		if msg.seq != a.resizeSeq {
+			return a, nil
+		}
+		// Apply it as a normal size message so the active studio sees it too.
+		a.settledSize = msg.size
+		return a.Update(msg.size)
+
+	case tea.MouseMsg:
+		if cmd, handled := a.handleChromeClick(msg); handled {
+			a.syncStatusBar()
+			return a, cmd
+		}
 
 	case tea.KeyMsg:
 		cmd := a.handleKey(msg)
@@ -200,6 +264,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case healthMsg:
+		wasDown := a.daemonStatus == "error"
 		if msg.status == "error" {
 			a.daemonStatus = "error"
 		} else if !msg.ready {
@@ -208,16 +273,23 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			a.daemonStatus = msg.status
 		}
 		a.statusBar.DaemonStatus = a.daemonStatus
+		if isDown := a.daemonStatus == "error"; isDown != wasDown {
+			cmds = append(cmds, func() tea.Msg { return studio.DaemonHealthMsg{Up: !isDown} })
+		}
+		// Only the current chain reschedules, so an on-demand check
+		// replaces the polling loop instead of starting a second one.
+		if msg.gen == a.healthGen {
+			a.healthDelay = nextHealthDelay(a.healthDelay, wasDown, a.daemonStatus)
+			cmds = append(cmds, a.scheduleHealthTick(a.healthGen, a.healthDelay))
+		}
 
 	case healthTickMsg:
-		if a.daemonStatus == "error" || a.daemonStatus == "starting" {
-			cmds = append(cmds, a.checkHealth, a.scheduleHealthTickFast())
-		} else {
-			cmds = append(cmds, a.checkHealth, a.scheduleHealthTick())
+		if msg.gen == a.healthGen {
+			cmds = append(cmds, a.checkHealth(msg.gen))
 		}
 
 	case commands.SwitchThemeMsg:
-		a.switchTheme(msg.Theme)
+		a.switchTheme(msg.Key, msg.Theme)
 
 	case commands.SwitchStudioMsg:
 		cmd := a.switchStudio(msg.Index)
@@ -226,6 +298,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return a, tea.Batch(cmds...)
 
+	case commands.ShowKeysMsg:
+		a.openKeys()
+		return a, tea.Batch(cmds...)
+
 	case commands.ChangeDirMsg:
 		if err := os.Chdir(msg.Path); err == nil {
 			a.statusBar.Cwd = msg.Path
@@ -243,6 +319,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Show flash notification visible in any studio
 		cmds = append(cmds, a.setFlash(stripAnsi(msg.Content)))
 
+	case commands.ProvidersChangedMsg:
+		cmds = append(cmds, a.setFlash(stripAnsi(msg.Notice)))
+		// Still forward to active studio, which refreshes its models
+
 	// Fact stream messages
 	case factbus.FactMsg:
 		a.factStreamConnected = true
@@ -262,6 +342,10 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case factbus.FactDisconnectedMsg:
 		a.factStreamConnected = false
 
+	case factbus.FactErrorMsg:
+		a.factStreamConnected = false
+		cmds = append(cmds, a.setFlash("Fact stream stopped: "+msg.Err.Error()))
+
 	case rxFlashDoneMsg:
 		a.rxActive = false
 
@@ -274,6 +358,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Forward message to active studio
 	if !a.showHome && a.activeStudio < len(a.studios) {
+		// Studios get mouse rows relative to their content area
+		if mouse, ok := msg.(tea.MouseMsg); ok {
+			mouse.Y -= lipgloss.Height(a.renderHeader())
+			msg = mouse
+		}
+
 		// Track streaming state for TX LED
 		llm := a.llmStudio()
 		wasStreaming := false
@@ -289,6 +379,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if llm != nil {
 			nowStreaming := llm.IsStreaming()
 			if !wasStreaming && nowStreaming {
+				if a.responseStart.IsZero() {
+					a.responseStart = time.Now()
+				}
 				a.txActive = true
 				cmds = append(cmds, tea.Tick(500*time.Millisecond, func(time.Time) tea.Msg {
 					return txFlashDoneMsg{}
@@ -300,10 +393,22 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			if wasStreaming && !nowStreaming {
 				a.rxActive = false
+				// Tool turns continue the same response; notify at the end.
+				if !llm.HasPendingApproval() && !llm.IsExecutingTool() {
+					cmds = append(cmds, a.notifyResponseDone(time.Since(a.responseStart)))
+					a.responseStart = time.Time{}
+				}
 			}
 		}
 	}
 
+	// The studio changes into a selected venture's root
+	if _, ok := msg.(commands.SetALCContextMsg); ok {
+		if cwd, err := os.Getwd(); err == nil {
+			a.statusBar.Cwd = cwd
+		}
+	}
+
 	// Sync status bar from active studio
 	a.syncStatusBar()
 
@@ -325,16 +430,24 @@ func (a *App) shellConsumedKey(msg tea.KeyMsg) bool {
 		return true
 	}
 
-	// Command mode consumes all keys
-	if a.inCommandMode {
+	// Command mode and the shell overlays consume all keys
+	if a.inCommandMode || a.showSwitcher || a.keysView != nil {
 		return true
 	}
 
+	// Approval dialog gets every key
+	if a.approvalPending() {
+		return false
+	}
+
 	// Studio switch keys in Normal mode
 	activeMode := a.studios[a.activeStudio].Mode()
 	if activeMode == modes.Normal {
+		if _, ok := studioKeyIndex(key); ok {
+			return true
+		}
 		switch key {
-		case "ctrl+1", "ctrl+2", "ctrl+3", "ctrl+4", "ctrl+5":
+		case "ctrl+s":
 			return true
 		case "q":
 			return true
@@ -365,7 +478,7 @@ func (a *App) switchStudio(index int) tea.Cmd {
 
 	// Persist last studio
 	a.cfg.LastStudio = index
-	_ = a.cfg.Save()
+	_, _ = config.Update(func(c *config.Config) { c.LastStudio = index })
 
 	return a.studios[index].Init()
 }
@@ -408,7 +521,8 @@ func stripAnsi(s string) string {
 	return result.String()
 }
 
-func (a *App) switchTheme(t *theme.Theme) {
+func (a *App) switchTheme(key string, t *theme.Theme) {
+	t = t.ForDepth(a.colorDepth)
 	a.theme = t
 	a.styles = t.ComputeStyles()
 
@@ -422,7 +536,7 @@ func (a *App) switchTheme(t *theme.Theme) {
 	a.cmdInput.TextStyle = lipgloss.NewStyle().Foreground(t.Text)
 
 	// Persist theme choice
-	a.saveThemeToConfig(t)
+	a.saveThemeToConfig(key)
 
 	// Update LLM studio theme
 	if llm := a.llmStudio(); llm != nil {
@@ -430,14 +544,14 @@ func (a *App) switchTheme(t *theme.Theme) {
 	}
 }
 
-func (a *App) saveThemeToConfig(t *theme.Theme) {
-	for key, builtin := range theme.BuiltinThemes() {
-		if builtin.Name == t.Name {
-			a.cfg.Theme = key
-			_ = a.cfg.Save()
-			return
-		}
+// saveThemeToConfig persists the theme by its key, so a user theme whose
+// display name matches a built-in one still comes back on the next start.
+func (a *App) saveThemeToConfig(key string) {
+	if key == "" {
+		return
 	}
+	a.cfg.Theme = key
+	_, _ = config.Update(func(c *config.Config) { c.Theme = key })
 }
 
 func (a *App) syncStatusBar() {
@@ -456,6 +570,10 @@ func (a *App) syncStatusBar() {
 	a.statusBar.ModelError = info.ModelError
 	a.statusBar.InputLen = info.InputLen
 	a.statusBar.SessionTokens = info.SessionTokens
+	a.statusBar.Streaming = info.Streaming
+	a.statusBar.StreamChunks = info.StreamChunks
+	a.statusBar.StreamElapsed = info.StreamElapsed
+	a.statusBar.StreamFrame = info.StreamFrame
 
 	// ALC context from LLM studio
 	if llm := a.llmStudio(); llm != nil {
@@ -470,6 +588,7 @@ func (a *App) syncStatusBar() {
 		} else {
 			a.statusBar.ActivePhase = ""
 		}
+		a.statusBar.ActiveRole = config.RoleTag(llm.ActiveRole())
 	}
 }
 
@@ -484,6 +603,12 @@ func (a *App) llmStudio() *llmstudio.Studio {
 	return nil
 }
 
+// approvalPending reports whether the active studio is showing the tool approval dialog.
+func (a *App) approvalPending() bool {
+	llm := a.llmStudio()
+	return llm != nil && a.activeStudio == 0 && llm.HasPendingApproval()
+}
+
 // commandContext builds a commands.Context for command dispatch.
 // Routes to the LLM studio's context if available.
 func (a *App) commandContext() *commands.Context {
@@ -491,22 +616,42 @@ func (a *App) commandContext() *commands.Context {
 		ctx := llm.CommandContext()
 		ctx.Width = a.width
 		ctx.Height = a.height
+		ctx.Studios = a.studioInfos()
 		return ctx
 	}
 
 	// Fallback for non-LLM studios
-	return &commands.Context{
-		Client: a.client,
-		Theme:  a.theme,
-		Styles: a.styles,
-		Width:  a.width,
-		Height: a.height,
+	ctx := &commands.Context{
+		Client:     a.client,
+		Theme:      a.theme,
+		Styles:     a.styles,
+		Width:      a.width,
+		Height:     a.height,
+		SocketPath: a.client.SocketPath(),
+		Studios:    a.studioInfos(),
+	}
+	if ctx.SocketPath == "" {
+		ctx.HTTPUrl = a.client.BaseURL()
+	}
+	return ctx
+}
+
+// compact reports whether the narrow-terminal layout is active.
+func (a *App) compact() bool {
+	return a.cfg.UI.CompactMode || a.width < chat.CompactWidth
+}
+
+// headerHeight returns the number of rows above the studio content.
+func (a *App) headerHeight() int {
+	if a.compact() {
+		return 2 // brand row + tab bar
 	}
+	return 4 // brand row + context row + tab bar + separator
 }
 
 // contentAreaHeight returns the height available for studio content.
 func (a *App) contentAreaHeight() int {
-	headerHeight := 4 // brand row + context row + tab bar + separator
+	headerHeight := a.headerHeight()
 	statusBarHeight := 2
 	commandHeight := 0
 	if a.inCommandMode {
@@ -520,35 +665,69 @@ func (a *App) contentAreaHeight() int {
 	return h
 }
 
+// Health polling cadence: slow while healthy, fast with backoff while the
+// daemon is down so the header recovers promptly without hammering it.
+const (
+	healthPollInterval = 30 * time.Second
+	healthRetryMin     = 3 * time.Second
+	healthRetryMax     = 30 * time.Second
+)
 
-func (a *App) scheduleHealthTick() tea.Cmd {
-	return tea.Tick(30*time.Second, func(t time.Time) tea.Msg {
-		return healthTickMsg{}
-	})
+// nextHealthDelay returns the wait before the next health check, given the
+// previous delay, whether the daemon was already down, and the status just observed.
+func nextHealthDelay(prev time.Duration, wasDown bool, status string) time.Duration {
+	switch status {
+	case "error":
+		if !wasDown || prev < healthRetryMin {
+			return healthRetryMin
+		}
+		next := prev * 2
+		if next > healthRetryMax {
+			next = healthRetryMax
+		}
+		return next
+	case "starting":
+		// Up but not ready — poll fast, it should be ready soon
+		return healthRetryMin
+	default:
+		return healthPollInterval
+	}
 }
 
-func (a *App) scheduleHealthTickFast() tea.Cmd {
-	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
-		return healthTickMsg{}
+func (a *App) scheduleHealthTick(gen int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
+		return healthTickMsg{gen: gen}
 	})
 }
 
-func (a *App) checkHealth() tea.Msg {
-	health, err := a.client.GetHealth()
-	if err != nil {
-		return healthMsg{status: "error"}
+func (a *App) checkHealth(gen int) tea.Cmd {
+	return func() tea.Msg {
+		health, err := a.client.GetHealth()
+		if err != nil {
+			return healthMsg{gen: gen, status: "error"}
+		}
+		return healthMsg{gen: gen, status: health.WorstStatus(), ready: health.Ready}
 	}
-	return healthMsg{status: health.Status, ready: health.Ready}
+}
+
+// recheckHealth checks health now and restarts polling from this check,
+// retiring the chain that was running.
+func (a *App) recheckHealth() tea.Cmd {
+	a.healthGen++
+	return a.checkHealth(a.healthGen)
 }
 
 // healthMsg carries daemon health check results.
 type healthMsg struct {
+	gen    int // polling chain the check belongs to
 	status string
 	ready  bool
 }
 
 // healthTickMsg triggers periodic health polling.
-type healthTickMsg struct{}
+type healthTickMsg struct {
+	gen int
+}
 
 // renderHeader builds the header: brand row + context row + tab bar + separator.
 func (a *App) renderHeader() string {
@@ -557,6 +736,12 @@ func (a *App) renderHeader() string {
 	// Brand row
 	rows = append(rows, a.renderBrandRow())
 
+	// Compact: skip context row and separator to give the chat more room
+	if a.compact() {
+		rows = append(rows, a.renderTabBar())
+		return strings.Join(rows, "\n")
+	}
+
 	// Context row (ALC)
 	contextRow := a.renderContextRow()
 	if contextRow != "" {
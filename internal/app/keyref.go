@@ -0,0 +1,44 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/ui"
+)
+
+// openKeys shows the full key reference over the content area.
+func (a *App) openKeys() {
+	a.keysView = ui.NewTextViewer(a.theme, a.styles, "Key Bindings", a.renderKeyReference())
+	a.keysView.SetSize(a.width, a.contentAreaHeight())
+}
+
+// renderKeyReference builds the /keys content: shell keys, each mode's
+// keys, then the active studio's own hints.
+func (a *App) renderKeyReference() string {
+	ctx := a.commandContext()
+	var b strings.Builder
+
+	b.WriteString(a.styles.CardTitle.Render("Shell"))
+	b.WriteString("\n")
+	b.WriteString(commands.RenderKeySections(ctx, commands.ShellKeys()))
+
+	for _, mk := range commands.AllModeKeys() {
+		b.WriteString("\n\n")
+		b.WriteString(a.styles.CardTitle.Render(mk.Title))
+		b.WriteString("\n")
+		b.WriteString(commands.RenderKeySections(ctx, mk.Sections))
+	}
+
+	if !a.showHome && a.activeStudio < len(a.studios) {
+		s := a.studios[a.activeStudio]
+		if hints := s.Hints(); hints != "" {
+			b.WriteString("\n\n")
+			b.WriteString(a.styles.CardTitle.Render(s.Name() + " Studio"))
+			b.WriteString("\n  ")
+			b.WriteString(hints)
+		}
+	}
+
+	return b.String()
+}
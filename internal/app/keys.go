@@ -25,11 +25,33 @@ func (a *App) handleKey(msg tea.KeyMsg) tea.Cmd {
 		return a.handleCommandKey(key, msg)
 	}
 
+	if a.showSwitcher {
+		return a.handleSwitcherKey(key)
+	}
+
+	if a.keysView != nil {
+		if a.keysView.HandleKey(key) {
+			a.keysView = nil
+		}
+		return nil
+	}
+
+	// The approval dialog owns the keyboard until answered
+	if a.approvalPending() {
+		return nil
+	}
+
 	// Active studio's mode determines which keys the shell intercepts
 	activeMode := a.studios[a.activeStudio].Mode()
 
 	if activeMode == modes.Normal {
+		if i, ok := studioKeyIndex(key); ok {
+			return a.switchStudio(i)
+		}
 		switch key {
+		case "ctrl+s":
+			a.openSwitcher()
+			return nil
 		case "[":
 			if a.activeStudio > 0 {
 				return a.switchStudio(a.activeStudio - 1)
@@ -51,20 +73,12 @@ func (a *App) handleKey(msg tea.KeyMsg) tea.Cmd {
 }
 
 func (a *App) handleHomeKey(key string) tea.Cmd {
-	switch key {
-	case "1":
-		return a.switchStudio(0)
-	case "2":
-		return a.switchStudio(1)
-	case "3":
-		return a.switchStudio(2)
-	case "4":
-		return a.switchStudio(3)
-	case "5":
-		return a.switchStudio(4)
-	case "q":
+	if key == "q" {
 		return tea.Quit
 	}
+	if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+		return a.switchStudio(int(key[0] - '1'))
+	}
 	return nil
 }
 
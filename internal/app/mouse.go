@@ -0,0 +1,36 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleChromeClick handles clicks on the shell's own UI: the daemon
+// indicator in the brand row rechecks health, and the model name in the
+// status bar cycles models. It reports whether the click was consumed.
+func (a *App) handleChromeClick(msg tea.MouseMsg) (tea.Cmd, bool) {
+	if a.showHome || msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return nil, false
+	}
+
+	// Brand row
+	if msg.Y == 0 {
+		if start, end := a.daemonRegion(); msg.X >= start && msg.X < end {
+			return tea.Batch(a.setFlash("Checking daemon health..."), a.recheckHealth()), true
+		}
+		return nil, true
+	}
+
+	// First status bar line
+	if msg.Y == a.height-a.statusBar.Height() {
+		llm := a.llmStudio()
+		if start, end := a.statusBar.ModelRegion(); llm != nil && a.activeStudio == 0 &&
+			msg.X >= start && msg.X < end {
+			if llm.IsStreaming() {
+				return a.setFlash("Can't switch models while streaming"), true
+			}
+			llm.CycleModel()
+			return nil, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultNotifyAfter is how long a response must take before notifying.
+const defaultNotifyAfter = 10 * time.Second
+
+// notifyResponseDone rings the terminal bell or sends an OSC 9 desktop
+// notification when a slow response finishes, as configured in [ui].
+func (a *App) notifyResponseDone(took time.Duration) tea.Cmd {
+	threshold := defaultNotifyAfter
+	if a.cfg.UI.NotifyAfter > 0 {
+		threshold = time.Duration(a.cfg.UI.NotifyAfter) * time.Second
+	}
+	if took < threshold {
+		return nil
+	}
+
+	var seq string
+	switch a.cfg.UI.Notify {
+	case "bell":
+		seq = "\a"
+	case "osc9":
+		seq = fmt.Sprintf("\x1b]9;Hecate: response ready (%ds)\a", int(took.Seconds()))
+	default:
+		return nil
+	}
+	out := a.out
+	return func() tea.Msg {
+		_, _ = out.Write([]byte(seq))
+		return nil
+	}
+}
+
+// syncOutput is the terminal the program renders to. Writes are serialized,
+// so a notification sent from a Cmd never lands inside a frame the renderer
+// is writing. It embeds the file so Bubble Tea still sees a TTY.
+type syncOutput struct {
+	*os.File
+	mu sync.Mutex
+}
+
+func (o *syncOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.File.Write(p)
+}
+
+// Output is the writer to pass to tea.WithOutput. Notifications are written
+// through it, in step with the renderer.
+func (a *App) Output() io.Writer {
+	return a.out
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// resizeDebounce is how long the terminal size must hold still before
+// the layout is redone. Drag-resizing sends a size message per step, and
+// each relayout re-renders the whole conversation.
+const resizeDebounce = 80 * time.Millisecond
+
+// resizeSettledMsg fires once a size has held for resizeDebounce. Only
+// the one matching the latest seq is applied.
+type resizeSettledMsg struct {
+	seq  int
+	size tea.WindowSizeMsg
+}
+
+// shouldDebounceResize reports whether msg should wait for the terminal
+// to settle. The first size lays out straight away, as does a size that
+// has already settled.
+func (a *App) shouldDebounceResize(msg tea.WindowSizeMsg) bool {
+	return a.width != 0 && msg != a.settledSize
+}
+
+// debounceResize restarts the quiet period with msg as the latest size.
+func (a *App) debounceResize(msg tea.WindowSizeMsg) tea.Cmd {
+	a.resizeSeq++
+	seq := a.resizeSeq
+	return tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+		return resizeSettledMsg{seq: seq, size: msg}
+	})
+}
@@ -0,0 +1,116 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/studio"
+	"github.com/hecate-social/hecate-tui/internal/studios/arcade"
+	"github.com/hecate-social/hecate-tui/internal/studios/devops"
+	llmstudio "github.com/hecate-social/hecate-tui/internal/studios/llm"
+	"github.com/hecate-social/hecate-tui/internal/studios/node"
+	"github.com/hecate-social/hecate-tui/internal/studios/social"
+)
+
+// studioFactories builds the studios in tab order. The LLM studio must
+// stay first; the shell routes commands and chat through it. Adding a
+// studio means adding its constructor here.
+var studioFactories = []func(*studio.Context) studio.Studio{
+	func(ctx *studio.Context) studio.Studio { return llmstudio.New(ctx) },
+	func(ctx *studio.Context) studio.Studio { return devops.New(ctx) },
+	func(ctx *studio.Context) studio.Studio { return node.New(ctx) },
+	func(ctx *studio.Context) studio.Studio { return social.New(ctx) },
+	func(ctx *studio.Context) studio.Studio { return arcade.New(ctx) },
+}
+
+// newStudios constructs every registered studio.
+func newStudios(ctx *studio.Context) []studio.Studio {
+	studios := make([]studio.Studio, 0, len(studioFactories))
+	for _, f := range studioFactories {
+		studios = append(studios, f(ctx))
+	}
+	return studios
+}
+
+// studioInfos describes the studios for commands such as /studio.
+func (a *App) studioInfos() []commands.StudioInfo {
+	infos := make([]commands.StudioInfo, len(a.studios))
+	for i, s := range a.studios {
+		infos[i] = commands.StudioInfo{Name: s.Name(), ShortName: s.ShortName(), Aliases: s.Aliases(), Icon: s.Icon()}
+	}
+	return infos
+}
+
+// studioKeyIndex maps ctrl+1..ctrl+9 to a studio index.
+func studioKeyIndex(key string) (int, bool) {
+	if len(key) != len("ctrl+1") || !strings.HasPrefix(key, "ctrl+") {
+		return 0, false
+	}
+	d := key[len(key)-1]
+	if d < '1' || d > '9' {
+		return 0, false
+	}
+	return int(d - '1'), true
+}
+
+// openSwitcher shows the studio picker with the active studio selected.
+func (a *App) openSwitcher() {
+	a.showSwitcher = true
+	a.switcherIndex = a.activeStudio
+}
+
+// handleSwitcherKey drives the studio picker.
+func (a *App) handleSwitcherKey(key string) tea.Cmd {
+	switch key {
+	case "esc", "ctrl+s", "q":
+		a.showSwitcher = false
+	case "j", "down", "tab":
+		a.switcherIndex = (a.switcherIndex + 1) % len(a.studios)
+	case "k", "up", "shift+tab":
+		a.switcherIndex = (a.switcherIndex - 1 + len(a.studios)) % len(a.studios)
+	case "enter":
+		a.showSwitcher = false
+		return a.switchStudio(a.switcherIndex)
+	default:
+		if len(key) == 1 && key[0] >= '1' && key[0] <= '9' {
+			if i := int(key[0] - '1'); i < len(a.studios) {
+				a.showSwitcher = false
+				return a.switchStudio(i)
+			}
+		}
+	}
+	return nil
+}
+
+// renderSwitcher draws the studio picker over the content area.
+func (a *App) renderSwitcher(height int) string {
+	t := a.theme
+	var rows []string
+	for i, s := range a.studios {
+		marker := "  "
+		style := lipgloss.NewStyle().Foreground(t.Text)
+		if i == a.switcherIndex {
+			marker = lipgloss.NewStyle().Foreground(t.Primary).Render("› ")
+			style = style.Foreground(t.Primary).Bold(true)
+		}
+		line := marker + a.styles.Subtle.Render(fmt.Sprintf("%d ", i+1)) +
+			style.Render(s.Icon()+" "+s.Name())
+		if i == a.activeStudio {
+			line += a.styles.Subtle.Render("  (active)")
+		}
+		rows = append(rows, line)
+	}
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(0, 2).
+		Render(a.styles.CardTitle.Render("Switch Studio") + "\n\n" +
+			strings.Join(rows, "\n") + "\n\n" +
+			a.styles.Subtle.Render("j/k move  Enter/1-9 open  Esc close"))
+
+	return lipgloss.Place(a.width, height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -24,8 +24,12 @@ func (a *App) View() string {
 	// Header (brand + context + tab bar + separator)
 	sections = append(sections, a.renderHeader())
 
-	// Active studio content
-	if a.activeStudio < len(a.studios) {
+	// Active studio content, or a shell overlay over it
+	if a.showSwitcher {
+		sections = append(sections, a.renderSwitcher(a.contentAreaHeight()))
+	} else if a.keysView != nil {
+		sections = append(sections, a.keysView.View())
+	} else if a.activeStudio < len(a.studios) {
 		sections = append(sections, a.studios[a.activeStudio].View())
 	}
 
@@ -41,20 +45,7 @@ func (a *App) View() string {
 }
 
 func (a *App) renderBrandRow() string {
-	logo := lipgloss.NewStyle().Foreground(a.theme.Primary).Bold(true).Render("🔥🗝️🔥 Hecate")
-	versionSection := a.styles.Subtle.Render(" v" + version.Version)
-
-	daemonSection := "  "
-	switch a.daemonStatus {
-	case "healthy", "ok":
-		daemonSection += a.styles.StatusOK.Render("●") + a.styles.Subtle.Render(" daemon")
-	case "starting":
-		daemonSection += a.styles.StatusWarning.Render("●") + a.styles.Subtle.Render(" daemon starting")
-	case "degraded":
-		daemonSection += a.styles.StatusWarning.Render("●") + a.styles.Subtle.Render(" daemon")
-	default:
-		daemonSection += a.styles.Subtle.Render("○ daemon")
-	}
+	daemonSection := "  " + a.renderDaemonStatus()
 
 	rxLED := "  "
 	if !a.factStreamConnected {
@@ -74,7 +65,7 @@ func (a *App) renderBrandRow() string {
 		txLED += a.styles.Subtle.Render("▲ tx")
 	}
 
-	row1Left := logo + versionSection + daemonSection + rxLED + txLED
+	row1Left := a.renderBrand() + daemonSection + rxLED + txLED
 
 	donateURL := "https://" + version.DonateURL
 	donateText := a.styles.Subtle.Render("☕ donate")
@@ -89,6 +80,31 @@ func (a *App) renderBrandRow() string {
 	return " " + row1Left + strings.Repeat(" ", spacer) + donateLink + " "
 }
 
+func (a *App) renderBrand() string {
+	logo := lipgloss.NewStyle().Foreground(a.theme.Primary).Bold(true).Render("🔥🗝️🔥 Hecate")
+	return logo + a.styles.Subtle.Render(" v"+version.Version)
+}
+
+func (a *App) renderDaemonStatus() string {
+	switch a.daemonStatus {
+	case "healthy", "ok":
+		return a.styles.StatusOK.Render("●") + a.styles.Subtle.Render(" daemon")
+	case "starting":
+		return a.styles.StatusWarning.Render("●") + a.styles.Subtle.Render(" daemon starting")
+	case "degraded":
+		return a.styles.StatusWarning.Render("●") + a.styles.Subtle.Render(" daemon")
+	default:
+		return a.styles.Subtle.Render("○ daemon")
+	}
+}
+
+// daemonRegion returns the brand-row columns [start, end) of the daemon
+// indicator.
+func (a *App) daemonRegion() (start, end int) {
+	start = 1 + lipgloss.Width(a.renderBrand()) + 2
+	return start, start + lipgloss.Width(a.renderDaemonStatus())
+}
+
 func (a *App) renderContextRow() string {
 	llm := a.llmStudio()
 	if llm == nil {
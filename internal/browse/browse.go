@@ -1,14 +1,18 @@
 package browse
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
@@ -47,6 +51,10 @@ func (t Tab) String() string {
 	}
 }
 
+// staleAfter is how long fetched capabilities are reused when Browse is
+// reopened before they are discovered again.
+const staleAfter = 2 * time.Minute
+
 // SelectModelMsg is emitted when user selects an LLM model.
 type SelectModelMsg struct {
 	ModelName string
@@ -59,6 +67,7 @@ type Model struct {
 	styles  *theme.Styles
 	width   int
 	height  int
+	ratio   float64 // share of the width used by the modal
 	loading bool
 	spinner spinner.Model
 
@@ -67,6 +76,14 @@ type Model struct {
 	filtered     []client.Capability
 	selected     int
 	err          error
+	fetchedAt    time.Time
+	cancelFetch  context.CancelFunc // abandons the in-flight discovery
+
+	// Connection: set while the daemon is down; retries refetch on a
+	// backoff once it is back
+	connLost   bool
+	retrySeq   int
+	retryDelay time.Duration
 
 	// Tabs
 	activeTab Tab
@@ -86,6 +103,17 @@ type capabilitiesMsg struct {
 	err          error
 }
 
+// retryMsg refetches after the daemon recovers. seq drops stale retries.
+type retryMsg struct {
+	seq int
+}
+
+// Backoff bounds for refetching after the daemon comes back.
+const (
+	retryMin = 500 * time.Millisecond
+	retryMax = 8 * time.Second
+)
+
 // New creates a Browse mode model.
 func New(c *client.Client, t *theme.Theme, s *theme.Styles) Model {
 	sp := spinner.New()
@@ -107,14 +135,31 @@ func New(c *client.Client, t *theme.Theme, s *theme.Styles) Model {
 		spinner:     sp,
 		mode:        ModeList,
 		searchInput: ti,
+		ratio:       config.DefaultBrowseWidth,
 	}
 }
 
+// SetWidthRatio sets the share of the terminal width the modal uses.
+func (m *Model) SetWidthRatio(r float64) {
+	m.ratio = config.ClampRatio(r, config.DefaultBrowseWidth)
+	m.searchInput.Width = m.modalWidth() - 10
+}
+
+// Searching reports whether the filter input has focus.
+func (m Model) Searching() bool {
+	return m.mode == ModeSearch
+}
+
+// WidthRatio returns the share of the terminal width the modal uses.
+func (m Model) WidthRatio() float64 {
+	return m.ratio
+}
+
 // Init starts loading capabilities.
-func (m Model) Init() tea.Cmd {
+func (m *Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.spinner.Tick,
-		m.fetchCapabilities,
+		m.fetchCapabilities(),
 	)
 }
 
@@ -129,10 +174,27 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 	case capabilitiesMsg:
+		if errors.Is(msg.err, context.Canceled) {
+			// Abandoned by Close; still loading, so Resume fetches again
+			break
+		}
 		m.loading = false
-		m.capabilities = msg.capabilities
+		m.fetchedAt = time.Now()
 		m.err = msg.err
-		m.applyFilter()
+		m.replaceCapabilities(msg.capabilities)
+		switch {
+		case msg.err == nil:
+			m.retryDelay = 0
+		case m.retryDelay > 0 && !m.connLost:
+			// A retry after recovery failed; the daemon may still be starting.
+			cmds = append(cmds, m.scheduleRetry())
+		}
+
+	case retryMsg:
+		if msg.seq == m.retrySeq && !m.connLost {
+			m.loading = true
+			cmds = append(cmds, m.spinner.Tick, m.fetchCapabilities())
+		}
 	}
 
 	if m.mode == ModeSearch {
@@ -144,6 +206,80 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// SetDaemonUp records a daemon outage or recovery. On recovery it refetches
+// if the list failed or went stale while the daemon was away.
+func (m *Model) SetDaemonUp(up bool) tea.Cmd {
+	if !up {
+		m.connLost = true
+		m.retrySeq++ // drop any pending retry
+		return nil
+	}
+	wasLost := m.connLost
+	m.connLost = false
+	if !wasLost && m.err == nil {
+		return nil
+	}
+	m.retryDelay = 0
+	return m.scheduleRetry()
+}
+
+// scheduleRetry queues the next refetch, doubling the delay each time.
+func (m *Model) scheduleRetry() tea.Cmd {
+	if m.retryDelay == 0 {
+		m.retryDelay = retryMin
+	} else if m.retryDelay < retryMax {
+		m.retryDelay *= 2
+	}
+	m.retrySeq++
+	seq := m.retrySeq
+	return tea.Tick(m.retryDelay, func(time.Time) tea.Msg {
+		return retryMsg{seq: seq}
+	})
+}
+
+// Resume is called when Browse is reopened. It keeps the selection, tab and
+// filter, and only rediscovers capabilities when the list is stale or an
+// earlier fetch never completed.
+func (m *Model) Resume() tea.Cmd {
+	if !m.loading && !m.Stale() {
+		return nil
+	}
+	m.loading = true
+	return m.Init()
+}
+
+// Stale reports whether the fetched capabilities are too old to reuse.
+func (m Model) Stale() bool {
+	return m.err != nil || m.fetchedAt.IsZero() || time.Since(m.fetchedAt) > staleAfter
+}
+
+// replaceCapabilities swaps in a fresh list, keeping the cursor on the
+// same capability when it is still present.
+func (m *Model) replaceCapabilities(caps []client.Capability) {
+	prev := m.selectedMRI()
+	m.capabilities = caps
+	m.applyFilter()
+	m.selectMRI(prev)
+}
+
+func (m Model) selectedMRI() string {
+	if m.selected < len(m.filtered) {
+		return m.filtered[m.selected].MRI
+	}
+	return ""
+}
+
+// selectMRI moves the cursor to mri in the filtered list, or to the top.
+func (m *Model) selectMRI(mri string) {
+	m.selected = 0
+	for i, c := range m.filtered {
+		if c.MRI == mri {
+			m.selected = i
+			return
+		}
+	}
+}
+
 // HandleKey processes a keypress in Browse mode. Returns true if the key was consumed.
 // Returns a tea.Cmd if an action was triggered.
 func (m *Model) HandleKey(key string, msg tea.KeyMsg) (bool, tea.Cmd) {
@@ -212,14 +348,27 @@ func (m *Model) handleListKey(key string, msg tea.KeyMsg) (bool, tea.Cmd) {
 		m.loading = true
 		m.searchQuery = ""
 		m.searchInput.SetValue("")
-		return true, m.fetchCapabilities
+		return true, m.fetchCapabilities()
 	case "esc":
-		// Esc exits Browse mode — handled by app
+		// Esc clears an active filter first; otherwise the app exits Browse.
+		if m.searchQuery != "" {
+			m.clearSearch()
+			return true, nil
+		}
 		return false, nil
 	}
 	return false, nil
 }
 
+// clearSearch drops the filter, keeping the cursor on the selected capability.
+func (m *Model) clearSearch() {
+	prev := m.selectedMRI()
+	m.searchQuery = ""
+	m.searchInput.SetValue("")
+	m.applyFilter()
+	m.selectMRI(prev)
+}
+
 func (m *Model) nextTab() {
 	m.activeTab = (m.activeTab + 1) % 5
 	m.applyFilter()
@@ -241,13 +390,21 @@ func (m *Model) handleSearchKey(key string, msg tea.KeyMsg) (bool, tea.Cmd) {
 	case "esc":
 		m.mode = ModeList
 		m.searchInput.Blur()
+		m.clearSearch()
 		return true, nil
 	case "enter":
-		m.searchQuery = m.searchInput.Value()
-		m.applyFilter()
 		m.mode = ModeList
 		m.searchInput.Blur()
-		m.selected = 0
+		return true, nil
+	case "up", "ctrl+p":
+		if m.selected > 0 {
+			m.selected--
+		}
+		return true, nil
+	case "down", "ctrl+n":
+		if m.selected < len(m.filtered)-1 {
+			m.selected++
+		}
 		return true, nil
 	default:
 		var cmd tea.Cmd
@@ -270,7 +427,7 @@ func (m *Model) handleDetailKey(key string) (bool, tea.Cmd) {
 		m.loading = true
 		m.mode = ModeList
 		m.detailCap = nil
-		return true, m.fetchCapabilities
+		return true, m.fetchCapabilities()
 	}
 	return true, nil
 }
@@ -332,15 +489,7 @@ func containsTag(tags []string, query string) bool {
 func (m *Model) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	// Modal width is 70% of terminal, capped at 80 chars
-	modalW := width * 70 / 100
-	if modalW > 80 {
-		modalW = 80
-	}
-	if modalW < 50 {
-		modalW = width - 4
-	}
-	m.searchInput.Width = modalW - 10
+	m.searchInput.Width = m.modalWidth() - 10
 }
 
 // View renders the browse overlay panel.
@@ -375,6 +524,11 @@ func (m Model) renderList() string {
 		b.WriteString("\n")
 	}
 
+	if m.connLost {
+		b.WriteString(s.StatusWarning.Render("⚠ Daemon connection lost — will refresh when it's back"))
+		b.WriteString("\n\n")
+	}
+
 	if m.loading {
 		b.WriteString(m.spinner.View() + " Discovering...")
 		return m.wrapModal(b.String())
@@ -382,6 +536,10 @@ func (m Model) renderList() string {
 
 	if m.err != nil {
 		b.WriteString(s.Error.Render("Error: " + m.err.Error()))
+		if m.retryDelay > 0 && !m.connLost {
+			b.WriteString("\n")
+			b.WriteString(s.Subtle.Render("Retrying..."))
+		}
 		return m.wrapModal(b.String())
 	}
 
@@ -478,7 +636,14 @@ func (m Model) renderList() string {
 
 	// Help hint
 	b.WriteString("\n")
-	b.WriteString(s.Subtle.Render("  ←/→ tabs  ↑/↓ navigate  / search  ⏎ select  r refresh  esc close"))
+	switch {
+	case m.mode == ModeSearch:
+		b.WriteString(s.Subtle.Render("  type to filter  ↑/↓ navigate  ⏎ done  esc clear"))
+	case m.searchQuery != "":
+		b.WriteString(s.Subtle.Render("  ←/→ tabs  j/k navigate  / edit filter  ⏎ select  esc clear filter"))
+	default:
+		b.WriteString(s.Subtle.Render("  ←/→ tabs  j/k navigate  / filter  ⏎ select  r refresh  esc close"))
+	}
 
 	return m.wrapModal(b.String())
 }
@@ -588,11 +753,12 @@ func (m Model) renderDetail() string {
 	return m.wrapModal(b.String())
 }
 
-// modalWidth returns the width for the modal dialog.
+// modalWidth returns the width for the modal dialog: the configured share
+// of the terminal, with the 80-column cap scaled along with it.
 func (m Model) modalWidth() int {
-	w := m.width * 70 / 100
-	if w > 80 {
-		w = 80
+	w := int(float64(m.width) * m.ratio)
+	if limit := int(80 * m.ratio / config.DefaultBrowseWidth); w > limit {
+		w = limit
 	}
 	if w < 50 {
 		w = m.width - 4
@@ -662,9 +828,26 @@ func (m Model) wrapModal(content string) string {
 	return result.String()
 }
 
-func (m Model) fetchCapabilities() tea.Msg {
-	caps, err := m.client.DiscoverCapabilities("", "", 100)
-	return capabilitiesMsg{capabilities: caps, err: err}
+// fetchCapabilities discovers capabilities, replacing any discovery still
+// in flight so Close can abandon it.
+func (m *Model) fetchCapabilities() tea.Cmd {
+	m.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelFetch = cancel
+	c := m.client
+	return func() tea.Msg {
+		caps, err := c.WithContext(ctx).DiscoverCapabilities("", "", 100)
+		return capabilitiesMsg{capabilities: caps, err: err}
+	}
+}
+
+// Close abandons a discovery still in flight, so a slow mesh doesn't keep
+// a request open after Browse is dismissed.
+func (m *Model) Close() {
+	if m.cancelFetch != nil {
+		m.cancelFetch()
+		m.cancelFetch = nil
+	}
 }
 
 func isLocal(cap client.Capability) bool {
@@ -0,0 +1,152 @@
+package browse
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestResumeKeepsSelectionUntilStale(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	caps := []client.Capability{
+		{MRI: "mri:cap:local/a"},
+		{MRI: "mri:cap:local/b"},
+		{MRI: "mri:cap:local/c"},
+	}
+	m, _ = m.Update(capabilitiesMsg{capabilities: caps})
+	m.selected = 2
+
+	if cmd := m.Resume(); cmd != nil {
+		t.Fatal("fresh list should not be refetched")
+	}
+	if m.selected != 2 {
+		t.Fatalf("selected = %d, want 2", m.selected)
+	}
+
+	m.fetchedAt = time.Now().Add(-staleAfter - time.Second)
+	if cmd := m.Resume(); cmd == nil || !m.loading {
+		t.Fatal("stale list should be refetched")
+	}
+
+	// The refreshed list keeps the cursor on the same capability.
+	m, _ = m.Update(capabilitiesMsg{capabilities: []client.Capability{caps[2], caps[0]}})
+	if m.selected != 0 || m.filtered[m.selected].MRI != caps[2].MRI {
+		t.Fatalf("selection not restored: %d", m.selected)
+	}
+}
+
+func TestFilterEscClearsBeforeExit(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m, _ = m.Update(capabilitiesMsg{capabilities: []client.Capability{
+		{MRI: "mri:cap:local/echo", Description: "Echo back"},
+		{MRI: "mri:cap:local/weather"},
+		{MRI: "mri:cap:local/echo2"},
+	}})
+
+	m.HandleKey("/", tea.KeyMsg{})
+	for _, r := range "echo" {
+		m.HandleKey(string(r), tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(m.filtered) != 2 {
+		t.Fatalf("filtered = %d, want 2", len(m.filtered))
+	}
+	m.HandleKey("down", tea.KeyMsg{Type: tea.KeyDown})
+	m.HandleKey("enter", tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Searching() || m.selected != 1 {
+		t.Fatalf("enter should keep the filtered selection, got %d", m.selected)
+	}
+
+	if consumed, _ := m.HandleKey("esc", tea.KeyMsg{Type: tea.KeyEsc}); !consumed {
+		t.Fatal("first esc should clear the filter")
+	}
+	if m.searchQuery != "" || len(m.filtered) != 3 || m.filtered[m.selected].MRI != "mri:cap:local/echo2" {
+		t.Fatalf("filter not cleared or selection lost: %q %d", m.searchQuery, m.selected)
+	}
+	if consumed, _ := m.HandleKey("esc", tea.KeyMsg{Type: tea.KeyEsc}); consumed {
+		t.Fatal("second esc should be left for the app to exit Browse")
+	}
+}
+
+func TestDaemonRecoveryRetriesFetch(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m.SetSize(120, 40)
+	m, _ = m.Update(capabilitiesMsg{err: errors.New("connection refused")})
+
+	if cmd := m.SetDaemonUp(false); cmd != nil {
+		t.Error("an outage should not schedule a fetch")
+	}
+	if !strings.Contains(m.View(), "connection lost") {
+		t.Error("outage banner not shown")
+	}
+
+	if cmd := m.SetDaemonUp(true); cmd == nil {
+		t.Fatal("recovery should schedule a refetch")
+	}
+	if strings.Contains(m.View(), "connection lost") {
+		t.Error("banner still shown after recovery")
+	}
+
+	// The retry fires a fetch; a failure backs off further.
+	first := m.retryDelay
+	m, _ = m.Update(retryMsg{seq: m.retrySeq})
+	if !m.loading {
+		t.Fatal("retry should start a fetch")
+	}
+	m, cmd := m.Update(capabilitiesMsg{err: errors.New("starting")})
+	if cmd == nil || m.retryDelay <= first {
+		t.Errorf("failed retry should back off: delay %v after %v", m.retryDelay, first)
+	}
+
+	// Retries queued before another outage are dropped.
+	stale := m.retrySeq
+	m.SetDaemonUp(false)
+	if m, _ = m.Update(retryMsg{seq: stale}); m.loading {
+		t.Error("stale retry fetched while the daemon is down")
+	}
+}
+
+func TestCloseAbandonsFetch(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	th := theme.HecateDark()
+	m := New(client.New(server.URL), th, th.ComputeStyles())
+	fetch := m.fetchCapabilities()
+	done := make(chan tea.Msg, 1)
+	go func() { done <- fetch() }()
+
+	m.Close()
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Close did not cancel the discovery request")
+	}
+
+	// The abandoned result leaves Browse loading, so reopening refetches
+	m, _ = m.Update(msg)
+	if !m.loading || m.err != nil {
+		t.Errorf("loading = %v, err = %v after an abandoned fetch", m.loading, m.err)
+	}
+	if cmd := m.Resume(); cmd == nil {
+		t.Error("Resume should refetch after an abandoned fetch")
+	}
+}
@@ -33,8 +33,12 @@ type Model struct {
 	models        []llm.Model
 	activeModel   int
 	streaming     bool
+	stream        *streamState // response being streamed, nil when idle
 	streamBuf     *strings.Builder
 	thinkingFrame int
+	renders       *renderCache // per-message render memo
+	lastMsgID     int          // last ID handed out by InjectSystemMessage
+	drawTop       int          // first line the next render centres on; -1 = bottom
 
 	// Stats
 	lastTokenCount    int
@@ -42,10 +46,25 @@ type Model struct {
 	lastSpeed         float64
 	streamStart       time.Time
 	sessionTokenCount int // Cumulative tokens for session
+	turns             []TurnStats
+	priceOverrides    map[string]llm.Price
+
+	// Model comparison (/compare); nil when none is running
+	compare    *compareRun
+	compareSeq int
 
 	// Think tag state
 	thinkExpanded bool
 
+	// Follow keeps the viewport pinned to the newest content; when off,
+	// new content leaves the scroll position alone.
+	follow bool
+
+	// How message times are shown; timestampGen drops ticks from an
+	// earlier Init
+	timestampStyle TimestampStyle
+	timestampGen   int
+
 	// Error
 	err error
 
@@ -55,17 +74,30 @@ type Model struct {
 	// System prompt
 	systemPrompt string
 
+	// Pinned context sent after the system prompt on every turn
+	pins []string
+
+	// Mouse selection: highlighted message and where messages are drawn
+	selected int
+	msgSpans []msgSpan
+
 	// Preferred model (loaded from config, applied when models arrive)
 	preferredModel string
 
+	// Compact layout forced on via config (otherwise automatic below CompactWidth)
+	forceCompact bool
+
 	// Tool execution
 	toolExecutor    *llmtools.Executor
 	toolsEnabled    bool
-	pendingToolCall *llm.ToolCall       // Tool waiting for approval
-	toolInputBuf    *strings.Builder    // Accumulates streaming tool input JSON
-	currentToolUse  *llm.ToolCall       // Tool use being streamed
-	executingTool   bool                // Whether we're executing a tool
-	toolResults     []llm.ToolResult    // Results to send back to LLM
+	toolsAuto       bool             // follow the active model's tool support
+	pendingToolCall *llm.ToolCall    // Tool waiting for approval
+	toolInputBuf    *strings.Builder // Accumulates streaming tool input JSON
+	currentToolUse  *llm.ToolCall    // Tool use being streamed
+	executingTool   bool             // Whether we're executing a tool
+	toolResults     []llm.ToolResult // Results to send back to LLM
+	approvalTimeout time.Duration    // Auto-deny pending approvals after this long (0 = never)
+	approvalSeq     int              // Identifies the current pending approval for its timer
 }
 
 // Message represents a chat message (user, assistant, or system).
@@ -75,6 +107,7 @@ type Message struct {
 	ThinkContent string         // extracted <think>...</think> content, if any
 	ToolCalls    []llm.ToolCall // tool calls requested by assistant (for conversation history)
 	Time         time.Time      // when the message was created
+	id           int            // set on injected system messages; 0 otherwise
 }
 
 // ExportMsg is a message suitable for export (no internal state).
@@ -86,27 +119,36 @@ type ExportMsg struct {
 
 // Messages for Bubble Tea
 type modelsMsg struct {
-	models []llm.Model
-	err    error
+	models   []llm.Model
+	err      error
+	refresh  bool   // re-query while running; report what changed
+	switchTo string // model to activate once the list is in
 }
 
+// Stream messages carry the stream they were read from; see streamState.
 type streamChunkMsg struct {
-	chunk llm.ChatResponse
+	stream *streamState
+	chunk  llm.ChatResponse
 }
 
 type streamDoneMsg struct {
-	totalTokens int
-	duration    time.Duration
-	reason      string // debug: why stream ended
+	stream       *streamState
+	totalTokens  int
+	promptTokens int
+	duration     time.Duration
+	reason       string // debug: why stream ended
 }
 
 type streamErrorMsg struct {
-	err error
+	stream *streamState // nil when the stream never opened
+	err    error
 }
 
 type thinkingTickMsg struct{}
 
-type continueStreamMsg struct{}
+type continueStreamMsg struct {
+	stream *streamState
+}
 
 // Tool-related messages
 type toolUseStartMsg struct {
@@ -119,7 +161,8 @@ type toolInputDeltaMsg struct {
 }
 
 type toolUseCompleteMsg struct {
-	call llm.ToolCall
+	stream *streamState
+	call   llm.ToolCall
 }
 
 type toolApprovalRequestMsg struct {
@@ -130,15 +173,24 @@ type toolApprovalRequestMsg struct {
 type toolApprovalResponseMsg struct {
 	approved        bool
 	grantForSession bool
+	denyForSession  bool
+	timedOut        bool // auto-denied after the approval timeout
 	call            llm.ToolCall
 }
 
+type approvalTimeoutMsg struct {
+	seq int // approval the timer belongs to
+}
+
 type toolExecutionResultMsg struct {
 	result llm.ToolResult
 }
 
 type toolContinueMsg struct{} // Signal to continue after tool execution
 
+// DefaultApprovalTimeout is how long a tool approval waits before auto-denying.
+const DefaultApprovalTimeout = 120 * time.Second
+
 // New creates a new chat model.
 func New(c client.DaemonClient, t *theme.Theme, s *theme.Styles) Model {
 	ta := textarea.New()
@@ -174,25 +226,67 @@ func New(c client.DaemonClient, t *theme.Theme, s *theme.Styles) Model {
 	vp.Style = lipgloss.NewStyle().Padding(0, 1)
 
 	return Model{
-		client:       c,
-		theme:        t,
-		styles:       s,
-		viewport:     vp,
-		input:        ta,
-		messages:     []Message{},
-		streamBuf:    &strings.Builder{},
-		toolInputBuf: &strings.Builder{},
+		client:          c,
+		theme:           t,
+		styles:          s,
+		viewport:        vp,
+		input:           ta,
+		messages:        []Message{},
+		streamBuf:       &strings.Builder{},
+		toolInputBuf:    &strings.Builder{},
+		renders:         &renderCache{},
+		drawTop:         -1,
+		approvalTimeout: DefaultApprovalTimeout,
+		selected:        -1,
+		follow:          true,
 	}
 }
 
+// ReTheme restyles the chat in place, keeping messages, models, and session state.
+func (m *Model) ReTheme(t *theme.Theme, s *theme.Styles) {
+	m.theme = t
+	m.styles = s
+	m.input.FocusedStyle.Base = m.input.FocusedStyle.Base.BorderForeground(t.BorderFocus)
+	m.input.BlurredStyle.Base = m.input.BlurredStyle.Base.BorderForeground(t.Border)
+	m.updateViewport()
+}
+
+// CompactWidth is the terminal width below which the compact layout kicks in.
+const CompactWidth = 60
+
+// SetCompact forces the compact layout regardless of width.
+func (m *Model) SetCompact(force bool) {
+	m.forceCompact = force
+	m.resize()
+}
+
+// Compact reports whether the compact layout is active: no bubble borders,
+// glyph-only labels, no timestamps.
+func (m Model) Compact() bool {
+	return m.forceCompact || (m.width > 0 && m.width < CompactWidth)
+}
+
 // SetToolExecutor sets the tool executor for function calling.
 func (m *Model) SetToolExecutor(executor *llmtools.Executor) {
 	m.toolExecutor = executor
 }
 
-// EnableTools enables or disables tool/function calling.
+// EnableTools enables or disables tool/function calling. An explicit choice
+// stops tools from following the active model.
 func (m *Model) EnableTools(enabled bool) {
 	m.toolsEnabled = enabled
+	m.toolsAuto = false
+}
+
+// SetToolsAuto makes tool calling follow the active model's capabilities.
+func (m *Model) SetToolsAuto() {
+	m.toolsAuto = true
+	m.syncToolsToModel()
+}
+
+// ToolsAuto reports whether tool calling follows the active model.
+func (m Model) ToolsAuto() bool {
+	return m.toolsAuto
 }
 
 // ToolsEnabled returns whether tools are enabled.
@@ -215,9 +309,12 @@ func (m Model) PendingToolCall() *llm.ToolCall {
 	return m.pendingToolCall
 }
 
-// Init fetches available models.
-func (m Model) Init() tea.Cmd {
-	return m.fetchModels
+// Init fetches available models and starts the relative-timestamp tick.
+// It runs again on every return to the studio, so it retires the
+// previous tick loop rather than starting one beside it.
+func (m *Model) Init() tea.Cmd {
+	m.timestampGen++
+	return tea.Batch(m.fetchModels, m.timestampTick())
 }
 
 // Update handles messages routed from the app.
@@ -229,6 +326,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	// as it would override the calculated chat area height with the full terminal height.
 
 	case modelsMsg:
+		if msg.refresh {
+			m.applyRefresh(msg)
+			return m, nil
+		}
 		m.models = msg.models
 		m.err = msg.err
 		// Apply preferred model if set
@@ -240,30 +341,35 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				}
 			}
 		}
+		m.syncToolsToModel()
 		return m, nil
 
 	case streamChunkMsg:
-		// Handle both formats: nested (msg.chunk.Message.Content) and flat (msg.chunk.Content)
-		content := ""
-		if msg.chunk.Message != nil && msg.chunk.Message.Content != "" {
-			content = msg.chunk.Message.Content
-		} else if msg.chunk.Content != "" {
-			content = msg.chunk.Content
+		if msg.stream == nil || msg.stream != m.stream {
+			return m, nil
 		}
-		if content != "" {
+		if content := chunkContent(msg.chunk); content != "" {
 			m.streamBuf.WriteString(content)
 			m.updateStreamingMessage()
 		}
 		// Debug: count chunks received
 		m.lastTokenCount++ // Repurpose as chunk counter for debug
-		return m, func() tea.Msg { return pollStreamCmd() }
+		return m, pollStreamCmd(m.stream)
 
 	case continueStreamMsg:
+		if msg.stream == nil || msg.stream != m.stream {
+			return m, nil
+		}
+		st := m.stream
 		return m, tea.Tick(10*time.Millisecond, func(t time.Time) tea.Msg {
-			return pollStreamCmd()
+			return pollStream(st)
 		})
 
 	case streamDoneMsg:
+		if !m.ownsStream(msg.stream) {
+			return m, nil
+		}
+		m.stream = nil
 		m.streaming = false
 		m.lastTokenCount = msg.totalTokens
 		m.sessionTokenCount += msg.totalTokens // Accumulate session tokens
@@ -271,6 +377,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		if msg.duration > 0 {
 			m.lastSpeed = float64(msg.totalTokens) / msg.duration.Seconds()
 		}
+		m.recordTurn(msg)
 		bufContent := m.streamBuf.String()
 		if len(bufContent) > 0 {
 			visible, thinking := StripThinkTags(bufContent)
@@ -298,6 +405,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 
 	case streamErrorMsg:
+		if !m.ownsStream(msg.stream) {
+			return m, nil
+		}
+		m.stream = nil
 		m.streaming = false
 		// If we have partial content, save it before showing error
 		if m.streamBuf.Len() > 0 {
@@ -316,9 +427,22 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case compareChunkMsg, compareContinueMsg, compareDoneMsg:
+		return m, m.updateCompare(msg)
+
+	case timestampTickMsg:
+		if msg.gen != m.timestampGen {
+			return m, nil
+		}
+		// A streaming redraw would hide the partial reply; chunks redraw anyway.
+		if !m.streaming {
+			m.updateViewportPreserveScroll()
+		}
+		return m, m.timestampTick()
+
 	case thinkingTickMsg:
 		if m.streaming || m.executingTool {
-			m.thinkingFrame = (m.thinkingFrame + 1) % len(ThinkingFrames)
+			m.thinkingFrame++
 			// Update the chat area to show the new animation frame
 			if m.streaming {
 				m.updateStreamingMessage()
@@ -341,6 +465,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 
 	case toolUseCompleteMsg:
+		if !m.ownsStream(msg.stream) {
+			return m, nil
+		}
+		// Leave the rest of the stream unread; the tool result starts a new one.
+		m.stream = nil
 		// Save the assistant's tool_call message to history so the LLM
 		// sees it when we send tool results back (required by Ollama/OpenAI).
 		streamedContent := m.streamBuf.String()
@@ -357,6 +486,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case toolApprovalResponseMsg:
 		return m, m.handleApprovalResponse(msg)
 
+	case approvalTimeoutMsg:
+		return m, m.handleApprovalTimeout(msg)
+
 	case toolExecutionResultMsg:
 		m.toolResults = append(m.toolResults, msg.result)
 		m.executingTool = false
@@ -371,7 +503,8 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	}
 
 	// Update textarea when input is visible and not streaming
-	if m.inputVisible && !m.streaming {
+	// (keys belong to the approval dialog while one is pending)
+	if m.inputVisible && !m.streaming && m.pendingToolCall == nil {
 		var cmd tea.Cmd
 		m.input, cmd = m.input.Update(msg)
 		cmds = append(cmds, cmd)
@@ -380,6 +513,12 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// ownsStream reports whether a stream message belongs to this model's
+// current stream. Messages without a stream (it never opened) always do.
+func (m Model) ownsStream(st *streamState) bool {
+	return st == nil || st == m.stream
+}
+
 // -- State queries --
 
 // IsStreaming returns whether a response is being streamed.
@@ -387,6 +526,21 @@ func (m Model) IsStreaming() bool {
 	return m.streaming
 }
 
+// PartialResponse returns the visible text streamed so far for the
+// response in flight, or "" when nothing is streaming.
+func (m Model) PartialResponse() string {
+	if !m.streaming {
+		return ""
+	}
+	visible, _ := StripThinkTags(m.streamBuf.String())
+	return visible
+}
+
+// IsExecutingTool reports whether a tool call is running.
+func (m Model) IsExecutingTool() bool {
+	return m.executingTool
+}
+
 // HasError returns whether there was an error in the last operation.
 func (m Model) HasError() bool {
 	return m.err != nil
@@ -410,6 +564,15 @@ func (m Model) SessionTokenCount() int {
 	return m.sessionTokenCount
 }
 
+// StreamProgress reports the live chunk count, elapsed time and spinner
+// frame of the response being streamed. ok is false when idle.
+func (m Model) StreamProgress() (chunks int, elapsed time.Duration, frame int, ok bool) {
+	if !m.streaming {
+		return 0, 0, 0, false
+	}
+	return m.lastTokenCount, time.Since(m.streamStart), m.thinkingFrame, true
+}
+
 // -- Think toggle --
 
 // ToggleThinking toggles the visibility of think blocks in messages.
@@ -420,34 +583,56 @@ func (m *Model) ToggleThinking() {
 
 // -- Scroll API --
 
+// ToggleFollow turns auto-scrolling to new content on or off and returns
+// the new setting. Turning it on jumps to the bottom.
+func (m *Model) ToggleFollow() bool {
+	m.follow = !m.follow
+	if m.follow {
+		m.viewport.GotoBottom()
+		m.ensureDrawn()
+	}
+	return m.follow
+}
+
+// Following reports whether new content scrolls the viewport to the bottom.
+func (m Model) Following() bool {
+	return m.follow
+}
+
 // ScrollUp scrolls the viewport up by n lines.
 func (m *Model) ScrollUp(n int) {
 	m.viewport.ScrollUp(n)
+	m.ensureDrawn()
 }
 
 // ScrollDown scrolls the viewport down by n lines.
 func (m *Model) ScrollDown(n int) {
 	m.viewport.ScrollDown(n)
+	m.ensureDrawn()
 }
 
 // HalfPageUp scrolls up half a page.
 func (m *Model) HalfPageUp() {
 	m.viewport.HalfPageUp()
+	m.ensureDrawn()
 }
 
 // HalfPageDown scrolls down half a page.
 func (m *Model) HalfPageDown() {
 	m.viewport.HalfPageDown()
+	m.ensureDrawn()
 }
 
 // GotoTop jumps to the beginning of chat.
 func (m *Model) GotoTop() {
 	m.viewport.GotoTop()
+	m.ensureDrawn()
 }
 
 // GotoBottom jumps to the end of chat.
 func (m *Model) GotoBottom() {
 	m.viewport.GotoBottom()
+	m.ensureDrawn()
 }
 
 // -- Messages API --
@@ -477,25 +662,46 @@ func (m Model) ExportMessages() []ExportMsg {
 // LoadMessages replaces all messages (for loading saved conversations).
 func (m *Model) LoadMessages(msgs []Message) {
 	m.messages = msgs
+	m.selected = -1
 	m.updateViewport()
 }
 
 // ClearMessages removes all chat messages.
 func (m *Model) ClearMessages() {
 	m.messages = []Message{}
+	m.selected = -1
 	m.lastTokenCount = 0
 	m.lastSpeed = 0
 	m.updateViewport()
 }
 
-// InjectSystemMessage adds a system message to the chat history.
-func (m *Model) InjectSystemMessage(content string) {
+// InjectSystemMessage adds a system message to the chat history and returns
+// its ID for ReplaceSystemMessage.
+func (m *Model) InjectSystemMessage(content string) int {
+	m.lastMsgID++
 	m.messages = append(m.messages, Message{
 		Role:    "system",
 		Content: content,
 		Time:    time.Now(),
+		id:      m.lastMsgID,
 	})
 	m.updateViewport()
+	return m.lastMsgID
+}
+
+// ReplaceSystemMessage rewrites the content of the system message with the
+// given ID. Returns false, changing nothing, if the message is gone (e.g. the
+// chat was cleared or another conversation loaded since it was injected).
+func (m *Model) ReplaceSystemMessage(id int, content string) bool {
+	for i := range m.messages {
+		if id != 0 && m.messages[i].id == id {
+			m.messages[i].Content = content
+			m.messages[i].Time = time.Now()
+			m.updateViewport()
+			return true
+		}
+	}
+	return false
 }
 
 // -- System prompt --
@@ -520,7 +726,7 @@ func (m *Model) SetPreferredModel(name string) {
 // RetryLast re-sends the last user message. Removes the last assistant response
 // if it immediately follows the last user message, then re-triggers streaming.
 func (m *Model) RetryLast() tea.Cmd {
-	if m.streaming || len(m.messages) == 0 {
+	if m.streaming || m.compare != nil || len(m.messages) == 0 {
 		return nil
 	}
 
@@ -567,9 +773,9 @@ func (m Model) LastAssistantMessage() string {
 
 // CancelStreaming stops the current stream.
 func (m *Model) CancelStreaming() {
-	if activeStream != nil && activeStream.cancel != nil {
-		activeStream.cancel()
-		activeStream = nil
+	if m.stream != nil {
+		m.stream.cancel()
+		m.stream = nil
 	}
 	m.streaming = false
 	if m.streamBuf.Len() > 0 {
@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -54,6 +55,26 @@ func TestInjectSystemMessage(t *testing.T) {
 	}
 }
 
+func TestReTheme_PreservesMessages(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m.SetSystemPrompt("be brief")
+	m.InjectSystemMessage("Hello system")
+
+	light := theme.HecateLight()
+	m.ReTheme(light, light.ComputeStyles())
+
+	if len(m.Messages()) != 1 || m.Messages()[0].Content != "Hello system" {
+		t.Errorf("messages not preserved across theme switch: %+v", m.Messages())
+	}
+	if m.GetSystemPrompt() != "be brief" {
+		t.Errorf("GetSystemPrompt() = %q, want %q", m.GetSystemPrompt(), "be brief")
+	}
+	if m.theme != light {
+		t.Error("theme was not updated")
+	}
+}
+
 func TestClearMessages(t *testing.T) {
 	th := theme.HecateDark()
 	s := th.ComputeStyles()
@@ -71,6 +92,36 @@ func TestClearMessages(t *testing.T) {
 	}
 }
 
+func TestReplaceSystemMessage(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	id := m.InjectSystemMessage("card v1")
+	m.InjectSystemMessage("later note")
+	if !m.ReplaceSystemMessage(id, "card v2") {
+		t.Fatal("ReplaceSystemMessage should find the card by ID")
+	}
+	if got := m.Messages(); got[0].Content != "card v2" || got[1].Content != "later note" {
+		t.Errorf("messages = %+v, want only the card rewritten", got)
+	}
+
+	// Once the card is gone its ID matches nothing, even a message that
+	// now sits at the card's old position.
+	m.ClearMessages()
+	m.InjectSystemMessage("unrelated")
+	if m.ReplaceSystemMessage(id, "card v3") {
+		t.Error("ReplaceSystemMessage matched a message that replaced the card")
+	}
+	if got := m.Messages()[0].Content; got != "unrelated" {
+		t.Errorf("content = %q, want the unrelated message untouched", got)
+	}
+
+	m.LoadMessages([]Message{{Role: "system", Content: "loaded"}})
+	if m.ReplaceSystemMessage(0, "x") || m.Messages()[0].Content != "loaded" {
+		t.Error("a loaded message without an ID was rewritten")
+	}
+}
+
 func TestLoadMessages(t *testing.T) {
 	th := theme.HecateDark()
 	s := th.ComputeStyles()
@@ -243,3 +294,98 @@ func TestPreferredModel(t *testing.T) {
 		t.Errorf("preferredModel = %q, want %q", m.preferredModel, "claude-3-opus")
 	}
 }
+
+func TestCycleInputHeight(t *testing.T) {
+	m := newTestModel(nil)
+
+	want := []int{3, 8, 1}
+	for _, w := range want {
+		m.CycleInputHeight()
+		if got := m.InputRows(); got != w {
+			t.Fatalf("InputRows() after cycle = %d, want %d", got, w)
+		}
+	}
+}
+
+func TestPins(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	if m.PinLastResponse() {
+		t.Error("PinLastResponse() with no messages should return false")
+	}
+
+	m.Pin("API spec v2")
+	m.LoadMessages([]Message{{Role: "assistant", Content: "use POST"}})
+	if !m.PinLastResponse() {
+		t.Fatal("PinLastResponse() should pin the assistant message")
+	}
+
+	msgs := m.pinnedMessages()
+	if len(msgs) != 2 || !strings.Contains(msgs[1].Content, "use POST") {
+		t.Fatalf("pinnedMessages() = %+v", msgs)
+	}
+
+	if m.Unpin(3) {
+		t.Error("Unpin(3) should fail with two pins")
+	}
+	if !m.Unpin(1) || len(m.Pins()) != 1 || m.Pins()[0] != "use POST" {
+		t.Errorf("after Unpin(1) pins = %v", m.Pins())
+	}
+}
+
+func TestMessageAt(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m.SetSize(80, 40)
+	m.LoadMessages([]Message{
+		{Role: "user", Content: "first"},
+		{Role: "system", Content: "note"},
+	})
+	m.GotoTop()
+
+	if got := m.MessageAt(0); got != 0 {
+		t.Errorf("MessageAt(0) = %d, want 0", got)
+	}
+	last := m.msgSpans[len(m.msgSpans)-1]
+	if got := m.MessageAt(last.start); got != 1 {
+		t.Errorf("MessageAt(%d) = %d, want 1", last.start, got)
+	}
+	if got := m.MessageAt(last.start - 1); got != -1 {
+		t.Errorf("gap row should not map to a message, got %d", got)
+	}
+
+	m.SelectMessage(1)
+	if msg, ok := m.SelectedMessage(); !ok || msg.Content != "note" {
+		t.Errorf("SelectedMessage() = %+v, %v", msg, ok)
+	}
+	m.ClearMessages()
+	if _, ok := m.SelectedMessage(); ok {
+		t.Error("ClearMessages should drop the selection")
+	}
+}
+
+func TestFollow_OffKeepsScrollPosition(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m.SetSize(80, 10)
+	for i := 0; i < 30; i++ {
+		m.InjectSystemMessage("line")
+	}
+	if !m.Following() {
+		t.Fatal("follow should be on by default")
+	}
+
+	m.ToggleFollow()
+	m.GotoTop()
+	m.InjectSystemMessage("new")
+	if m.viewport.YOffset != 0 {
+		t.Errorf("YOffset = %d with follow off, want 0", m.viewport.YOffset)
+	}
+
+	m.ToggleFollow()
+	m.InjectSystemMessage("newer")
+	if !m.viewport.AtBottom() {
+		t.Error("follow on should keep the viewport at the bottom")
+	}
+}
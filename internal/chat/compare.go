@@ -0,0 +1,320 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/llm"
+)
+
+// MaxCompareStreams caps how many models a comparison streams at once;
+// the rest wait for a free slot.
+const MaxCompareStreams = 3
+
+// compareEntry is one model's side of a comparison.
+type compareEntry struct {
+	model    string
+	stream   *streamState
+	buf      strings.Builder
+	started  bool
+	done     bool
+	err      error
+	tokens   int
+	duration time.Duration
+}
+
+// compareRun streams the last prompt to several models side by side. Its
+// results live in one system message that is rewritten as chunks arrive.
+type compareRun struct {
+	seq     int
+	history []llm.Message
+	entries []*compareEntry
+	msgID   int
+}
+
+type compareChunkMsg struct {
+	seq, idx int
+	content  string
+}
+
+type compareContinueMsg struct {
+	seq, idx int
+}
+
+type compareDoneMsg struct {
+	seq, idx int
+	err      error
+}
+
+// IsComparing reports whether a model comparison is in flight.
+func (m Model) IsComparing() bool {
+	return m.compare != nil
+}
+
+// Compare streams the last user message to each of models, rendering the
+// answers under one header per model.
+func (m *Model) Compare(models []string) tea.Cmd {
+	if m.streaming || m.compare != nil {
+		m.InjectSystemMessage("Wait for the current response to finish before comparing.")
+		return nil
+	}
+
+	lastUser := -1
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].Role == "user" {
+			lastUser = i
+			break
+		}
+	}
+	if lastUser == -1 {
+		m.InjectSystemMessage("Nothing to compare — send a message first.")
+		return nil
+	}
+
+	var entries []*compareEntry
+	var unknown []string
+	seen := make(map[string]bool)
+	for _, name := range models {
+		model, ok := m.findModel(name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		if seen[model.Name] {
+			continue
+		}
+		seen[model.Name] = true
+		entries = append(entries, &compareEntry{model: model.Name})
+	}
+	if len(unknown) > 0 {
+		m.InjectSystemMessage("Model not found: " + strings.Join(unknown, ", "))
+		return nil
+	}
+	if len(entries) < 2 {
+		m.InjectSystemMessage("Compare needs at least two different models.")
+		return nil
+	}
+
+	m.compareSeq++
+	m.compare = &compareRun{
+		seq:     m.compareSeq,
+		history: m.llmMessages(m.messages[:lastUser+1], nil),
+		entries: entries,
+	}
+	m.compare.msgID = m.InjectSystemMessage("")
+
+	var cmds []tea.Cmd
+	for i := range entries {
+		if i == MaxCompareStreams {
+			break
+		}
+		cmds = append(cmds, m.startCompareStream(i))
+	}
+	m.renderCompare()
+	return tea.Batch(cmds...)
+}
+
+// findModel resolves name the way SwitchModel does: exact match first,
+// then case-insensitive prefix.
+func (m Model) findModel(name string) (llm.Model, bool) {
+	for _, model := range m.models {
+		if strings.EqualFold(model.Name, name) {
+			return model, true
+		}
+	}
+	for _, model := range m.models {
+		if strings.HasPrefix(strings.ToLower(model.Name), strings.ToLower(name)) {
+			return model, true
+		}
+	}
+	return llm.Model{}, false
+}
+
+// startCompareStream opens the stream for entry idx and starts polling it.
+func (m *Model) startCompareStream(idx int) tea.Cmd {
+	run := m.compare
+	e := run.entries[idx]
+	ctx, cancel := context.WithCancel(context.Background())
+	respChan, errChan := m.client.ChatStream(ctx, llm.ChatRequest{
+		Model:    e.model,
+		Messages: run.history,
+		Stream:   true,
+	})
+	e.stream = &streamState{
+		ctx:      ctx,
+		cancel:   cancel,
+		respChan: respChan,
+		errChan:  errChan,
+		start:    time.Now(),
+	}
+	e.started = true
+	return pollCompareCmd(run.seq, idx, e.stream)
+}
+
+func pollCompareCmd(seq, idx int, st *streamState) tea.Cmd {
+	return func() tea.Msg { return pollCompare(seq, idx, st) }
+}
+
+// pollCompare reads the next event from one comparison stream. Tool calls
+// are not offered to compared models, so only text and completion matter.
+func pollCompare(seq, idx int, st *streamState) tea.Msg {
+	select {
+	case resp, ok := <-st.respChan:
+		if !ok {
+			select {
+			case err, eOk := <-st.errChan:
+				if eOk && err != nil && err != context.Canceled {
+					return compareDoneMsg{seq: seq, idx: idx, err: err}
+				}
+			default:
+			}
+			return compareDoneMsg{seq: seq, idx: idx}
+		}
+		if resp.EvalCount > 0 {
+			st.totalTokens = resp.EvalCount
+		}
+		if resp.Done {
+			return compareDoneMsg{seq: seq, idx: idx}
+		}
+		return compareChunkMsg{seq: seq, idx: idx, content: chunkContent(resp)}
+
+	case err, ok := <-st.errChan:
+		if !ok {
+			return compareContinueMsg{seq: seq, idx: idx}
+		}
+		if err != nil && err != context.Canceled {
+			return compareDoneMsg{seq: seq, idx: idx, err: err}
+		}
+		return compareDoneMsg{seq: seq, idx: idx}
+
+	default:
+		return compareContinueMsg{seq: seq, idx: idx}
+	}
+}
+
+// compareEntryFor returns the entry a comparison message belongs to, or nil
+// when it is left over from a cancelled or finished run.
+func (m Model) compareEntryFor(seq, idx int) *compareEntry {
+	if m.compare == nil || m.compare.seq != seq || idx >= len(m.compare.entries) {
+		return nil
+	}
+	return m.compare.entries[idx]
+}
+
+// updateCompare applies one comparison stream event.
+func (m *Model) updateCompare(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case compareChunkMsg:
+		e := m.compareEntryFor(msg.seq, msg.idx)
+		if e == nil {
+			return nil
+		}
+		if msg.content != "" {
+			e.buf.WriteString(msg.content)
+			m.renderCompare()
+		}
+		return pollCompareCmd(msg.seq, msg.idx, e.stream)
+
+	case compareContinueMsg:
+		e := m.compareEntryFor(msg.seq, msg.idx)
+		if e == nil {
+			return nil
+		}
+		st := e.stream
+		return tea.Tick(10*time.Millisecond, func(time.Time) tea.Msg {
+			return pollCompare(msg.seq, msg.idx, st)
+		})
+
+	case compareDoneMsg:
+		e := m.compareEntryFor(msg.seq, msg.idx)
+		if e == nil {
+			return nil
+		}
+		e.done = true
+		e.err = msg.err
+		e.tokens = e.stream.totalTokens
+		e.duration = time.Since(e.stream.start)
+		e.stream.cancel()
+
+		var cmd tea.Cmd
+		for i, next := range m.compare.entries {
+			if !next.started {
+				cmd = m.startCompareStream(i)
+				break
+			}
+		}
+		m.renderCompare()
+		if m.compareFinished() {
+			m.compare = nil
+		}
+		return cmd
+	}
+	return nil
+}
+
+func (m Model) compareFinished() bool {
+	for _, e := range m.compare.entries {
+		if !e.done {
+			return false
+		}
+	}
+	return true
+}
+
+// CancelCompare stops every in-flight comparison stream, keeping what
+// each model produced so far.
+func (m *Model) CancelCompare() {
+	if m.compare == nil {
+		return
+	}
+	for _, e := range m.compare.entries {
+		if e.done {
+			continue
+		}
+		if e.stream != nil {
+			e.stream.cancel()
+			e.duration = time.Since(e.stream.start)
+			e.tokens = e.stream.totalTokens
+		}
+		e.done = true
+		e.err = context.Canceled
+	}
+	m.renderCompare()
+	m.compare = nil
+}
+
+// renderCompare rewrites the comparison's system message from the entries.
+func (m *Model) renderCompare() {
+	run := m.compare
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Comparing %d models", len(run.entries)))
+	for _, e := range run.entries {
+		b.WriteString("\n\n── " + e.model + " ")
+		switch {
+		case e.err == context.Canceled:
+			b.WriteString("(cancelled)")
+		case e.err != nil:
+			b.WriteString("(error: " + e.err.Error() + ")")
+		case e.done:
+			speed := 0.0
+			if e.duration > 0 {
+				speed = float64(e.tokens) / e.duration.Seconds()
+			}
+			b.WriteString(fmt.Sprintf("(%d tokens, %.1f tok/s, %.1fs)", e.tokens, speed, e.duration.Seconds()))
+		case e.started:
+			b.WriteString("(streaming…)")
+		default:
+			b.WriteString("(queued)")
+		}
+		b.WriteString("\n")
+		visible, _ := StripThinkTags(e.buf.String())
+		b.WriteString(strings.TrimSpace(visible))
+	}
+	if !m.ReplaceSystemMessage(run.msgID, b.String()) {
+		// The chat was cleared under us; carry on in a fresh message.
+		run.msgID = m.InjectSystemMessage(b.String())
+	}
+}
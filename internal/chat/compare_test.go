@@ -0,0 +1,82 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/llm"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestCompare_CollectsEachModel(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	id := m.InjectSystemMessage("")
+
+	st1, resp1, _ := fakeStream()
+	st2, resp2, _ := fakeStream()
+	m.compare = &compareRun{
+		seq:   1,
+		msgID: id,
+		entries: []*compareEntry{
+			{model: "llama3", stream: st1, started: true},
+			{model: "qwen", stream: st2, started: true},
+		},
+	}
+
+	resp1 <- llm.ChatResponse{Content: "alpha"}
+	resp2 <- llm.ChatResponse{Message: &llm.Message{Content: "beta"}}
+	resp1 <- llm.ChatResponse{Done: true, EvalCount: 7}
+	close(resp2)
+
+	for _, idx := range []int{0, 1, 0, 1} {
+		m, _ = m.Update(pollCompare(1, idx, m.compare.entries[idx].stream))
+	}
+
+	if m.IsComparing() {
+		t.Fatal("comparison should be finished once every model is done")
+	}
+	got := m.Messages()[0].Content
+	for _, want := range []string{"llama3", "alpha", "7 tokens", "qwen", "beta"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("comparison output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestCompare_StaleRunIgnored(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	m, cmd := m.Update(compareChunkMsg{seq: 3, idx: 0, content: "late"})
+	if cmd != nil {
+		t.Error("chunk from a finished run should not keep polling")
+	}
+	if len(m.Messages()) != 0 {
+		t.Errorf("stale chunk changed messages: %+v", m.Messages())
+	}
+}
+
+func TestCancelCompare(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	id := m.InjectSystemMessage("")
+
+	st, _, _ := fakeStream()
+	m.compare = &compareRun{
+		seq:     1,
+		msgID:   id,
+		entries: []*compareEntry{{model: "llama3", stream: st, started: true}, {model: "qwen"}},
+	}
+	m.CancelCompare()
+
+	if m.IsComparing() {
+		t.Error("IsComparing() after cancel = true")
+	}
+	if st.ctx.Err() == nil {
+		t.Error("in-flight stream was not cancelled")
+	}
+	if got := m.Messages()[0].Content; strings.Count(got, "(cancelled)") != 2 {
+		t.Errorf("want both models marked cancelled:\n%s", got)
+	}
+}
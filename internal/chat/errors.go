@@ -0,0 +1,102 @@
+package chat
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ErrorKind groups errors that share a remedy.
+type ErrorKind int
+
+const (
+	ErrorUnknown ErrorKind = iota
+	ErrorConnection
+	ErrorAuth
+	ErrorRateLimit
+	ErrorBilling
+	ErrorModelNotFound
+	ErrorContextLength
+	ErrorTimeout
+)
+
+// ErrorInfo is a classified error: what went wrong, what to do about it,
+// and whether simply retrying may help. Raw keeps the original text.
+type ErrorInfo struct {
+	Kind      ErrorKind
+	Title     string
+	Hint      string
+	Retryable bool
+	Raw       string
+}
+
+// errorRule maps error text fragments to a classification. Rules are
+// checked in order, so more specific fragments come first.
+type errorRule struct {
+	fragments []string
+	info      ErrorInfo
+}
+
+var errorRules = []errorRule{
+	{[]string{"context length", "context_length", "maximum context", "too many tokens", "prompt is too long"},
+		ErrorInfo{Kind: ErrorContextLength, Title: "Conversation too long for the model", Hint: "Start a /new conversation or /unpin context"}},
+	{[]string{"insufficient", "credit", "billing", "quota", "payment required", "402"},
+		ErrorInfo{Kind: ErrorBilling, Title: "Provider account out of credit", Hint: "Add credits or raise the limit at the provider's dashboard"}},
+	{[]string{"rate limit", "rate_limit", "too many requests", "overloaded", "429"},
+		ErrorInfo{Kind: ErrorRateLimit, Title: "Rate limited by the provider", Hint: "Wait a moment before retrying", Retryable: true}},
+	{[]string{"token rejected by daemon", "daemon requires a token"},
+		ErrorInfo{Kind: ErrorAuth, Title: "Daemon rejected the connection token", Hint: "Check HECATE_TOKEN or connection.token in the config"}},
+	{[]string{"unauthorized", "invalid api key", "invalid x-api-key", "authentication", "permission denied", "forbidden", "401", "403"},
+		ErrorInfo{Kind: ErrorAuth, Title: "Provider rejected the credentials", Hint: "Check the key with /provider test <name>"}},
+	{[]string{"model not found", "unknown model", "no such model", "model_not_found", "try pulling", "no models available"},
+		ErrorInfo{Kind: ErrorModelNotFound, Title: "Model not available", Hint: "Try /models --refresh or pick another with /model"}},
+	{[]string{"timeout", "timed out", "deadline exceeded"},
+		ErrorInfo{Kind: ErrorTimeout, Title: "Request timed out", Hint: "The model may be loading; retry in a moment", Retryable: true}},
+	{[]string{"connection refused", "no such file or directory", "connection reset", "broken pipe", "dial ", "eof"},
+		ErrorInfo{Kind: ErrorConnection, Title: "Lost connection to the daemon", Hint: "Check that hecate is running with /health", Retryable: true}},
+}
+
+// ClassifyError matches err against known failure patterns.
+func ClassifyError(err error) ErrorInfo {
+	if err == nil {
+		return ErrorInfo{}
+	}
+	raw := err.Error()
+	lower := strings.ToLower(raw)
+	for _, rule := range errorRules {
+		for _, f := range rule.fragments {
+			if strings.Contains(lower, f) {
+				info := rule.info
+				info.Raw = raw
+				return info
+			}
+		}
+	}
+	return ErrorInfo{Kind: ErrorUnknown, Title: "Request failed", Retryable: true, Raw: raw}
+}
+
+// LastErrorInfo classifies the last error; Kind is ErrorUnknown and Raw
+// empty when there is none.
+func (m Model) LastErrorInfo() ErrorInfo {
+	return ClassifyError(m.err)
+}
+
+// renderError draws the error card: title and raw text, then the remedy
+// and the retry key when retrying may help.
+func (m Model) renderError() string {
+	info := ClassifyError(m.err)
+	muted := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
+
+	line := m.styles.Error.Render("  ✗ "+info.Title) + muted.Render("  "+info.Raw)
+	var hints []string
+	if info.Hint != "" {
+		hints = append(hints, info.Hint)
+	}
+	if info.Retryable {
+		hints = append(hints, "r to retry")
+	}
+	if len(hints) > 0 {
+		line += "\n" + muted.Render("    "+strings.Join(hints, " · "))
+	}
+	return line
+}
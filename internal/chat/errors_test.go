@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err       error
+		kind      ErrorKind
+		retryable bool
+	}{
+		{errors.New("dial unix /run/hecate/daemon.sock: connect: connection refused"), ErrorConnection, true},
+		{errors.New("HTTP 401: invalid x-api-key"), ErrorAuth, false},
+		{errors.New("429 Too Many Requests"), ErrorRateLimit, true},
+		{errors.New("Your credit balance is too low"), ErrorBilling, false},
+		{errors.New(`model "llama9" not found, try pulling it first`), ErrorModelNotFound, false},
+		{errors.New("model not found: llama9"), ErrorModelNotFound, false},
+		{errors.New("prompt is too long: 210000 tokens > 200000 maximum"), ErrorContextLength, false},
+		{fmt.Errorf("stream: %w", context.DeadlineExceeded), ErrorTimeout, true},
+		{errors.New("something odd"), ErrorUnknown, true},
+	}
+	for _, tt := range tests {
+		info := ClassifyError(tt.err)
+		if info.Kind != tt.kind {
+			t.Errorf("ClassifyError(%q).Kind = %d, want %d", tt.err, info.Kind, tt.kind)
+		}
+		if info.Retryable != tt.retryable {
+			t.Errorf("ClassifyError(%q).Retryable = %v, want %v", tt.err, info.Retryable, tt.retryable)
+		}
+		if info.Raw != tt.err.Error() {
+			t.Errorf("ClassifyError(%q).Raw = %q", tt.err, info.Raw)
+		}
+	}
+}
+
+func TestViewError_ShowsHintAndRaw(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	if m.ViewError() != "" {
+		t.Fatal("ViewError() without an error should be empty")
+	}
+
+	m.err = errors.New("429 Too Many Requests")
+	view := m.ViewError()
+	for _, want := range []string{"Rate limited", "429 Too Many Requests", "r to retry"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("ViewError() missing %q:\n%s", want, view)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"os"
+	"strings"
+)
+
+// Glyphs is the set of decorative characters the chat renders. Call sites
+// go through ActiveGlyphs so every glyph switches to ASCII together.
+type Glyphs struct {
+	Spinner   []string
+	User      string
+	Assistant string
+	Tool      string
+	OK        string
+	Fail      string
+	Cursor    string
+}
+
+// UnicodeGlyphs uses braille spinner frames and symbol labels.
+var UnicodeGlyphs = Glyphs{
+	Spinner:   []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	User:      "▸",
+	Assistant: "◆",
+	Tool:      "⚙️",
+	OK:        "✓",
+	Fail:      "✗",
+	Cursor:    "▊",
+}
+
+// ASCIIGlyphs is the fallback for terminals without good Unicode support.
+var ASCIIGlyphs = Glyphs{
+	Spinner:   []string{"|", "/", "-", "\\"},
+	User:      ">",
+	Assistant: "*",
+	Tool:      "[tool]",
+	OK:        "[ok]",
+	Fail:      "[x]",
+	Cursor:    "_",
+}
+
+// ActiveGlyphs is the glyph set in use, chosen once at startup.
+var ActiveGlyphs = detectGlyphs()
+
+// detectGlyphs picks Unicode unless the locale or terminal rules it out.
+// HECATE_ASCII=1 forces the ASCII set; HECATE_ASCII=0 forces Unicode.
+func detectGlyphs() Glyphs {
+	switch os.Getenv("HECATE_ASCII") {
+	case "1", "true":
+		return ASCIIGlyphs
+	case "0", "false":
+		return UnicodeGlyphs
+	}
+
+	switch os.Getenv("TERM") {
+	case "linux", "dumb":
+		return ASCIIGlyphs
+	}
+
+	// The first locale variable that is set wins, as in setlocale(3)
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(key); v != "" {
+			v = strings.ToLower(v)
+			if strings.Contains(v, "utf-8") || strings.Contains(v, "utf8") {
+				return UnicodeGlyphs
+			}
+			return ASCIIGlyphs
+		}
+	}
+	return UnicodeGlyphs
+}
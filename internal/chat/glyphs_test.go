@@ -0,0 +1,31 @@
+package chat
+
+import "testing"
+
+func TestDetectGlyphs(t *testing.T) {
+	tests := []struct {
+		name  string
+		ascii string
+		term  string
+		lang  string
+		want  string
+	}{
+		{"utf8 locale", "", "xterm-256color", "en_US.UTF-8", UnicodeGlyphs.Assistant},
+		{"c locale", "", "xterm-256color", "C", ASCIIGlyphs.Assistant},
+		{"linux console", "", "linux", "en_US.UTF-8", ASCIIGlyphs.Assistant},
+		{"force ascii", "1", "xterm-256color", "en_US.UTF-8", ASCIIGlyphs.Assistant},
+		{"force unicode", "0", "linux", "C", UnicodeGlyphs.Assistant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HECATE_ASCII", tt.ascii)
+			t.Setenv("TERM", tt.term)
+			t.Setenv("LC_ALL", "")
+			t.Setenv("LC_CTYPE", "")
+			t.Setenv("LANG", tt.lang)
+			if got := detectGlyphs().Assistant; got != tt.want {
+				t.Errorf("detectGlyphs().Assistant = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
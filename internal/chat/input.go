@@ -21,7 +21,7 @@ func (m *Model) SetInputVisible(visible bool) {
 // SendCurrentInput sends the current textarea content as a user message.
 func (m *Model) SendCurrentInput() tea.Cmd {
 	content := strings.TrimSpace(m.input.Value())
-	if content == "" || m.streaming {
+	if content == "" || m.streaming || m.compare != nil {
 		return nil
 	}
 
@@ -31,6 +31,7 @@ func (m *Model) SendCurrentInput() tea.Cmd {
 		Time:    time.Now(),
 	})
 	m.input.Reset()
+	m.ResetInputHeight()
 	m.streaming = true
 	m.streamBuf.Reset()
 	m.streamStart = time.Now()
@@ -47,6 +48,32 @@ func (m *Model) SendCurrentInput() tea.Cmd {
 	)
 }
 
+// InputHeights are the textarea sizes CycleInputHeight steps through.
+var InputHeights = []int{1, 3, 8}
+
+// CycleInputHeight grows the input to the next size, wrapping back to one
+// row. The caller must re-size the chat area afterwards.
+func (m *Model) CycleInputHeight() {
+	next := InputHeights[0]
+	for i, h := range InputHeights {
+		if h == m.InputRows() && i+1 < len(InputHeights) {
+			next = InputHeights[i+1]
+			break
+		}
+	}
+	m.input.SetHeight(next)
+}
+
+// ResetInputHeight shrinks the input back to a single row.
+func (m *Model) ResetInputHeight() {
+	m.input.SetHeight(InputHeights[0])
+}
+
+// InputRows returns the number of visible input rows.
+func (m Model) InputRows() int {
+	return m.input.Height()
+}
+
 // InsertNewline adds a newline at the cursor position in the input.
 func (m *Model) InsertNewline() {
 	m.input.InsertString("\n")
@@ -66,3 +93,13 @@ func (m Model) InputValue() string {
 func (m *Model) SetInputValue(v string) {
 	m.input.SetValue(v)
 }
+
+// InputOnFirstLine reports whether the cursor is on the input's first line.
+func (m Model) InputOnFirstLine() bool {
+	return m.input.Line() == 0
+}
+
+// InputOnLastLine reports whether the cursor is on the input's last line.
+func (m *Model) InputOnLastLine() bool {
+	return m.input.Line() >= m.input.LineCount()-1
+}
@@ -1,6 +1,11 @@
 package chat
 
-import "strings"
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/llm"
+)
 
 // SwitchModel switches the active model by name.
 func (m *Model) SwitchModel(name string) {
@@ -8,16 +13,120 @@ func (m *Model) SwitchModel(name string) {
 		if strings.EqualFold(model.Name, name) || strings.HasPrefix(strings.ToLower(model.Name), strings.ToLower(name)) {
 			m.activeModel = i
 			m.InjectSystemMessage("Switched to model: " + model.Name)
+			m.noteToolsChange(m.syncToolsToModel())
 			return
 		}
 	}
 	m.InjectSystemMessage("Model not found: " + name)
 }
 
+// HasModel reports whether a model with exactly this name is loaded.
+func (m Model) HasModel(name string) bool {
+	for _, model := range m.models {
+		if strings.EqualFold(model.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// RefreshModels re-queries the daemon for models and reports what changed.
+func (m Model) RefreshModels() tea.Cmd {
+	return m.refreshModels("")
+}
+
+// RefreshAndSwitch re-queries models, then activates name. Used when a model
+// picked elsewhere isn't in the cached list yet.
+func (m Model) RefreshAndSwitch(name string) tea.Cmd {
+	return m.refreshModels(name)
+}
+
+func (m Model) refreshModels(switchTo string) tea.Cmd {
+	c := m.client
+	return func() tea.Msg {
+		models, err := c.ListModels()
+		return modelsMsg{models: models, err: err, refresh: true, switchTo: switchTo}
+	}
+}
+
+// applyRefresh swaps in a refreshed model list, keeping the active model by
+// name when it is still available.
+func (m *Model) applyRefresh(msg modelsMsg) {
+	if msg.err != nil {
+		m.InjectSystemMessage("Failed to refresh models: " + msg.err.Error())
+		return
+	}
+
+	active := m.ActiveModelName()
+	added, removed := diffModels(m.models, msg.models)
+	m.models = msg.models
+	m.err = nil
+	m.activeModel = 0
+	kept := false
+	for i, model := range m.models {
+		if model.Name == active {
+			m.activeModel = i
+			kept = true
+			break
+		}
+	}
+	toolsChanged := m.syncToolsToModel()
+
+	var b strings.Builder
+	switch {
+	case len(added) == 0 && len(removed) == 0:
+		b.WriteString("Models unchanged (" + itoa(len(m.models)) + " available).")
+	default:
+		b.WriteString("Models refreshed (" + itoa(len(m.models)) + " available).")
+		if len(added) > 0 {
+			b.WriteString("\n  + " + strings.Join(added, ", "))
+		}
+		if len(removed) > 0 {
+			b.WriteString("\n  - " + strings.Join(removed, ", "))
+		}
+	}
+	if active != "" && !kept && msg.switchTo == "" {
+		b.WriteString("\n" + active + " is no longer available")
+		if name := m.ActiveModelName(); name != "" {
+			b.WriteString("; switched to " + name)
+		}
+		b.WriteString(".")
+	}
+	if msg.switchTo == "" {
+		m.InjectSystemMessage(b.String())
+		m.noteToolsChange(toolsChanged)
+		return
+	}
+	m.SwitchModel(msg.switchTo)
+}
+
+// diffModels returns model names present only in next (added) and only in
+// prev (removed), in list order.
+func diffModels(prev, next []llm.Model) (added, removed []string) {
+	before := make(map[string]bool, len(prev))
+	for _, model := range prev {
+		before[model.Name] = true
+	}
+	after := make(map[string]bool, len(next))
+	for _, model := range next {
+		after[model.Name] = true
+		if !before[model.Name] {
+			added = append(added, model.Name)
+		}
+	}
+	for _, model := range prev {
+		if !after[model.Name] {
+			removed = append(removed, model.Name)
+		}
+	}
+	return added, removed
+}
+
 // CycleModel cycles to the next available model.
 func (m *Model) CycleModel() {
 	if len(m.models) > 0 {
 		m.activeModel = (m.activeModel + 1) % len(m.models)
+		m.noteToolsChange(m.syncToolsToModel())
 	}
 }
 
@@ -25,6 +134,7 @@ func (m *Model) CycleModel() {
 func (m *Model) CycleModelReverse() {
 	if len(m.models) > 0 {
 		m.activeModel = (m.activeModel - 1 + len(m.models)) % len(m.models)
+		m.noteToolsChange(m.syncToolsToModel())
 	}
 }
 
@@ -52,11 +162,42 @@ func (m Model) ActiveModelProvider() string {
 
 // IsPaidProvider returns true if the active model uses a commercial provider.
 func (m Model) IsPaidProvider() bool {
-	provider := m.ActiveModelProvider()
-	switch provider {
-	case "anthropic", "openai", "google", "groq", "together":
-		return true
-	default:
+	return llm.IsPaidProvider(m.ActiveModelProvider())
+}
+
+// activeModelInfo returns the active model, if any.
+func (m Model) activeModelInfo() (llm.Model, bool) {
+	if m.activeModel < len(m.models) {
+		return m.models[m.activeModel], true
+	}
+	return llm.Model{}, false
+}
+
+// syncToolsToModel turns function calling on or off to match the active
+// model, unless the user set it explicitly. Models with unknown capabilities
+// keep tools off. Returns whether the setting changed.
+func (m *Model) syncToolsToModel() bool {
+	if !m.toolsAuto {
 		return false
 	}
+	model, ok := m.activeModelInfo()
+	if !ok {
+		return false
+	}
+	supported, known := model.SupportsTools()
+	was := m.toolsEnabled
+	m.toolsEnabled = known && supported
+	return was != m.toolsEnabled
+}
+
+// noteToolsChange tells the user tools flipped because of a model change.
+func (m *Model) noteToolsChange(changed bool) {
+	if !changed {
+		return
+	}
+	if m.toolsEnabled {
+		m.InjectSystemMessage("Function calling on — " + m.ActiveModelName() + " supports tools. (/fn off to override)")
+	} else {
+		m.InjectSystemMessage("Function calling off — " + m.ActiveModelName() + " isn't known to support tools. (/fn on to override)")
+	}
 }
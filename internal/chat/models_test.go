@@ -149,3 +149,63 @@ func TestActiveModelProvider_Empty(t *testing.T) {
 		t.Errorf("ActiveModelProvider() with no models = %q, want empty", got)
 	}
 }
+
+func TestApplyRefresh_KeepsActiveByName(t *testing.T) {
+	m := newTestModel(testModels)
+	m.SwitchModel("claude-3-opus")
+
+	m.applyRefresh(modelsMsg{refresh: true, models: []llm.Model{
+		{Name: "claude-3-opus", Provider: "anthropic"},
+		{Name: "qwen2:7b", Provider: "ollama"},
+	}})
+
+	if got := m.ActiveModelName(); got != "claude-3-opus" {
+		t.Errorf("ActiveModelName() after refresh = %q, want %q", got, "claude-3-opus")
+	}
+}
+
+func TestDiffModels(t *testing.T) {
+	next := []llm.Model{{Name: "gpt-4o"}, {Name: "qwen2:7b"}}
+	added, removed := diffModels(testModels, next)
+
+	if len(added) != 1 || added[0] != "qwen2:7b" {
+		t.Errorf("added = %v, want [qwen2:7b]", added)
+	}
+	if len(removed) != 2 || removed[0] != "llama3:latest" || removed[1] != "claude-3-opus" {
+		t.Errorf("removed = %v, want [llama3:latest claude-3-opus]", removed)
+	}
+}
+
+func TestToolsFollowActiveModel(t *testing.T) {
+	m := newTestModel([]llm.Model{
+		{Name: "llama3:latest", Provider: "ollama", Capabilities: []string{"completion"}},
+		{Name: "qwen3:8b", Provider: "ollama", Capabilities: []string{"completion", "tools"}},
+		{Name: "mystery"},
+	})
+
+	m.SwitchModel("qwen3:8b")
+	if m.toolsEnabled {
+		t.Error("tools should stay off until the user turns them on or picks auto")
+	}
+
+	m.SetToolsAuto()
+	if !m.toolsEnabled {
+		t.Error("tools should be on for a tool-capable model")
+	}
+	m.SwitchModel("mystery")
+	if m.toolsEnabled {
+		t.Error("tools should be off when capabilities aren't reported")
+	}
+
+	m.models = append(m.models, llm.Model{Name: "claude-3-opus", Provider: "anthropic"})
+	m.SwitchModel("claude-3-opus")
+	if m.toolsEnabled {
+		t.Error("a hosted provider without reported capabilities should keep tools off")
+	}
+
+	m.EnableTools(true)
+	m.SwitchModel("llama3:latest")
+	if !m.toolsEnabled {
+		t.Error("an explicit /fn on should survive a model switch")
+	}
+}
@@ -0,0 +1,95 @@
+package chat
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/llm"
+)
+
+// pinPreviewLines caps how much of each pin is shown above the chat.
+const pinPreviewLines = 3
+
+// Pin adds text to the pinned context.
+func (m *Model) Pin(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	m.pins = append(m.pins, text)
+	m.updateViewportPreserveScroll()
+}
+
+// PinLastResponse pins the most recent assistant message. It returns false
+// when there is nothing to pin.
+func (m *Model) PinLastResponse() bool {
+	for i := len(m.messages) - 1; i >= 0; i-- {
+		if m.messages[i].Role == "assistant" && strings.TrimSpace(m.messages[i].Content) != "" {
+			m.Pin(m.messages[i].Content)
+			return true
+		}
+	}
+	return false
+}
+
+// Unpin removes the n-th pin (1-based). It returns false if n is out of range.
+func (m *Model) Unpin(n int) bool {
+	if n < 1 || n > len(m.pins) {
+		return false
+	}
+	m.pins = append(m.pins[:n-1], m.pins[n:]...)
+	m.updateViewportPreserveScroll()
+	return true
+}
+
+// SetPins replaces the pinned context (for loading saved conversations).
+func (m *Model) SetPins(pins []string) {
+	m.pins = append([]string(nil), pins...)
+	m.updateViewportPreserveScroll()
+}
+
+// Pins returns the pinned context.
+func (m Model) Pins() []string {
+	return m.pins
+}
+
+// pinnedMessages returns the pins as system messages for the LLM request.
+func (m Model) pinnedMessages() []llm.Message {
+	msgs := make([]llm.Message, 0, len(m.pins))
+	for _, p := range m.pins {
+		msgs = append(msgs, llm.Message{
+			Role:    llm.RoleSystem,
+			Content: "Pinned context (always keep in mind):\n" + p,
+		})
+	}
+	return msgs
+}
+
+// renderPins draws the pinned context as a single block above the
+// conversation, previewing each pin rather than repeating it in full.
+func (m Model) renderPins(width int) string {
+	if len(m.pins) == 0 {
+		return ""
+	}
+	muted := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
+	var lines []string
+	for i, p := range m.pins {
+		preview := strings.Split(p, "\n")
+		more := ""
+		if len(preview) > pinPreviewLines {
+			more = muted.Render("  … " + strconv.Itoa(len(preview)-pinPreviewLines) + " more lines")
+			preview = preview[:pinPreviewLines]
+		}
+		lines = append(lines, "📌 "+muted.Render(strconv.Itoa(i+1)+".")+" "+strings.Join(preview, "\n   "))
+		if more != "" {
+			lines = append(lines, more)
+		}
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, false, false, true).
+		BorderForeground(m.theme.Accent).
+		PaddingLeft(1).
+		Width(width).
+		Render(strings.Join(lines, "\n"))
+}
@@ -2,7 +2,6 @@ package chat
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
@@ -21,8 +20,17 @@ func (m Model) ViewInput() string {
 	return m.input.View()
 }
 
-// ViewStats renders the stats line (streaming status or post-completion stats).
+// ViewStats renders the stats line (streaming status or post-completion
+// stats), noting when follow is off.
 func (m Model) ViewStats() string {
+	stats := m.viewStats()
+	if !m.follow {
+		stats += lipgloss.NewStyle().Foreground(m.theme.TextMuted).Render("  follow off (F)")
+	}
+	return stats
+}
+
+func (m Model) viewStats() string {
 	if m.streaming {
 		// Show model name, elapsed time, and cancel hint (thinking animation is now in chat)
 		subtleStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
@@ -35,28 +43,48 @@ func (m Model) ViewStats() string {
 		cancelHint := subtleStyle.Render("  (Esc to cancel)")
 		return modelPart + elapsedPart + cancelHint
 	}
+	if m.compare != nil {
+		subtleStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
+		return subtleStyle.Render(fmt.Sprintf("  comparing %d models  (Esc to cancel)", len(m.compare.entries)))
+	}
 	if m.lastTokenCount > 0 {
 		return m.renderStats()
 	}
 	return ""
 }
 
-// ViewError renders any error.
+// ViewError renders any error as a card with its likely remedy.
 func (m Model) ViewError() string {
 	if m.err != nil {
-		return m.styles.Error.Render("  " + m.err.Error())
+		return m.renderError()
 	}
 	return ""
 }
 
 func (m Model) renderStats() string {
-	durationPart := lipgloss.NewStyle().Foreground(m.theme.TextMuted).
-		Render(fmt.Sprintf("  %0.1fs", m.lastDuration.Seconds()))
-	return "  " + FormatTokens(m.lastTokenCount, m.theme) + "  " + FormatSpeed(m.lastSpeed, m.theme) + durationPart
+	muted := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
+	durationPart := muted.Render(fmt.Sprintf("  %0.1fs", m.lastDuration.Seconds()))
+	costPart := ""
+	if turn, ok := m.lastTurn(); ok && turn.Paid() && turn.Priced {
+		costPart = lipgloss.NewStyle().Foreground(m.theme.Warning).
+			Render("  ~"+FormatCost(turn.Cost)) +
+			muted.Render(" (session ~"+FormatCost(m.SessionCost())+")")
+	}
+	return "  " + FormatTokens(m.lastTokenCount, m.theme) + "  " + FormatSpeed(m.lastSpeed, m.theme) + durationPart + costPart
 }
 
-func (m Model) renderMessages() string {
-	if len(m.messages) == 0 {
+// FormatCost renders a dollar amount, with more precision for small sums.
+func FormatCost(cost float64) string {
+	if cost < 0.01 {
+		return fmt.Sprintf("$%.4f", cost)
+	}
+	return fmt.Sprintf("$%.2f", cost)
+}
+
+func (m Model) renderMessages() (string, []msgSpan) {
+	m.renders.trim(len(m.messages))
+	if len(m.messages) == 0 && len(m.pins) == 0 {
+		m.renders.setDrawn(0, m.viewport.Height)
 		welcome := WelcomeArt(m.theme)
 		return lipgloss.Place(
 			m.viewport.Width,
@@ -64,77 +92,119 @@ func (m Model) renderMessages() string {
 			lipgloss.Center,
 			lipgloss.Center,
 			welcome,
-		)
+		), nil
+	}
+
+	if m.Compact() {
+		return m.renderMessagesCompact()
 	}
 
-	var parts []string
+	var parts []msgPart
 	bubbleWidth := m.viewport.Width - 8
 	if bubbleWidth < 30 {
 		bubbleWidth = 30
 	}
 
-	timeStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted)
+	if pinned := m.renderPins(bubbleWidth); pinned != "" {
+		parts = append(parts, msgPart{-1, pinned})
+	}
 
-	for _, msg := range m.messages {
-		timestamp := ""
+	now := time.Now()
+	for i, msg := range m.messages {
+		stamp := ""
 		if !msg.Time.IsZero() {
-			timestamp = timeStyle.Render(" " + msg.Time.Format("15:04"))
+			stamp = formatTimestamp(msg.Time, now, m.timestampStyle)
 		}
+		key := m.renderKey(msg, stamp, bubbleWidth, false)
+		blocks, ok := m.renders.get(i, key)
+		if !ok {
+			blocks = m.renderMessage(msg, stamp, bubbleWidth)
+			m.renders.put(i, key, blocks)
+		}
+		for _, text := range blocks {
+			parts = append(parts, msgPart{i, text})
+		}
+	}
 
-		switch msg.Role {
-		case "user":
-			// User messages: just the bullet + content, no header line
-			bullet := m.styles.UserLabel.Render("▸ ")
-			bubble := m.styles.UserBubble.Render(msg.Content) + timestamp
-			parts = append(parts, bullet+bubble)
-
-		case "assistant":
-			label := m.styles.AssistantLabel.Render("◆ Hecate") + timestamp
-
-			// Show think block indicator if present
-			if msg.ThinkContent != "" {
-				thinkStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted).Italic(true)
-				if m.thinkExpanded {
-					thinkHeader := thinkStyle.Render("▼ Thinking")
-					thinkBody := thinkStyle.Render(msg.ThinkContent)
-					thinkBubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(thinkBody)
-					parts = append(parts, label+"\n"+thinkHeader+"\n"+thinkBubble)
-					// Render visible content below the think block
-					if msg.Content != "" {
-						rendered := RenderMarkdown(msg.Content, m.theme, bubbleWidth-4)
-						bubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(rendered)
-						parts = append(parts, bubble)
-					}
-					continue
-				}
+	return m.joinParts(parts)
+}
+
+// renderMessage renders one message as the blocks joinParts lays out.
+func (m Model) renderMessage(msg Message, stamp string, bubbleWidth int) []string {
+	timestamp := ""
+	if stamp != "" {
+		timestamp = lipgloss.NewStyle().Foreground(m.theme.TextMuted).Render(" " + stamp)
+	}
+
+	switch msg.Role {
+	case "user":
+		// User messages: just the bullet + content, no header line
+		bullet := m.styles.UserLabel.Render(ActiveGlyphs.User + " ")
+		bubble := m.styles.UserBubble.Render(msg.Content) + timestamp
+		return []string{bullet + bubble}
+
+	case "assistant":
+		label := m.styles.AssistantLabel.Render(ActiveGlyphs.Assistant+" Hecate") + timestamp
+
+		// Show think block indicator if present
+		if msg.ThinkContent != "" {
+			var blocks []string
+			thinkStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted).Italic(true)
+			if m.thinkExpanded {
+				thinkHeader := thinkStyle.Render("▼ Thinking")
+				thinkBody := thinkStyle.Render(msg.ThinkContent)
+				thinkBubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(thinkBody)
+				blocks = append(blocks, label+"\n"+thinkHeader+"\n"+thinkBubble)
+			} else {
 				// Collapsed: show indicator before message
 				thinkIndicator := thinkStyle.Render("▶ Thinking... (t to expand)")
-				parts = append(parts, label+"\n"+thinkIndicator)
-				if msg.Content != "" {
-					rendered := RenderMarkdown(msg.Content, m.theme, bubbleWidth-4)
-					bubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(rendered)
-					parts = append(parts, bubble)
-				}
-				continue
+				blocks = append(blocks, label+"\n"+thinkIndicator)
+			}
+			// Render visible content below the think block
+			if msg.Content != "" {
+				rendered := RenderMarkdown(msg.Content, m.theme, bubbleWidth-4)
+				blocks = append(blocks, m.styles.AssistantBubble.Width(bubbleWidth).Render(rendered))
 			}
+			return blocks
+		}
 
-			rendered := RenderMarkdown(msg.Content, m.theme, bubbleWidth-4)
-			bubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(rendered)
-			parts = append(parts, label+"\n"+bubble)
+		rendered := RenderMarkdown(msg.Content, m.theme, bubbleWidth-4)
+		bubble := m.styles.AssistantBubble.Width(bubbleWidth).Render(rendered)
+		return []string{label + "\n" + bubble}
 
-		case "system":
-			bubble := m.styles.SystemBubble.Width(bubbleWidth).Render(msg.Content)
-			parts = append(parts, bubble)
-		}
+	case "system":
+		return []string{m.styles.SystemBubble.Width(bubbleWidth).Render(msg.Content)}
 	}
-
-	return strings.Join(parts, "\n\n")
+	return nil
 }
 
 func (m *Model) updateViewport() {
-	content := m.renderMessages()
+	m.aimDraw()
+	content, spans := m.renderMessages()
+	m.msgSpans = spans
+	m.showContent(content)
+}
+
+// aimDraw points the next render at where showContent will leave the
+// viewport: the bottom when following, the current offset otherwise.
+func (m *Model) aimDraw() {
+	if m.follow {
+		m.drawTop = -1
+	} else {
+		m.drawTop = m.viewport.YOffset
+	}
+}
+
+// showContent replaces the viewport content, following it to the bottom
+// when follow is on and keeping the scroll offset otherwise.
+func (m *Model) showContent(content string) {
+	offset := m.viewport.YOffset
 	m.viewport.SetContent(content)
-	m.viewport.GotoBottom()
+	if m.follow {
+		m.viewport.GotoBottom()
+	} else {
+		m.viewport.SetYOffset(offset)
+	}
 }
 
 func (m *Model) updateViewportPreserveScroll() {
@@ -147,7 +217,12 @@ func (m *Model) updateViewportPreserveScroll() {
 	}
 	atBottom := m.viewport.AtBottom()
 
-	content := m.renderMessages()
+	m.drawTop = oldOffset
+	if atBottom {
+		m.drawTop = -1
+	}
+	content, spans := m.renderMessages()
+	m.msgSpans = spans
 	m.viewport.SetContent(content)
 
 	// Restore scroll position
@@ -157,13 +232,77 @@ func (m *Model) updateViewportPreserveScroll() {
 		newTotal := m.viewport.TotalLineCount()
 		newOffset := int(scrollPercent * float64(newTotal))
 		m.viewport.SetYOffset(newOffset)
+		m.ensureDrawn()
+	}
+}
+
+// renderMessagesCompact renders messages edge to edge for narrow terminals:
+// glyph-only labels inline with content, no borders, no timestamps.
+func (m Model) renderMessagesCompact() (string, []msgSpan) {
+	width := m.viewport.Width
+	var parts []msgPart
+	if pinned := m.renderPins(width); pinned != "" {
+		parts = append(parts, msgPart{-1, pinned})
+	}
+	for i, msg := range m.messages {
+		// Leave room for the selection bar
+		w := width
+		if i == m.selected {
+			w--
+		}
+		key := m.renderKey(msg, "", w, true)
+		blocks, ok := m.renders.get(i, key)
+		if !ok {
+			blocks = m.renderMessageCompact(msg, w)
+			m.renders.put(i, key, blocks)
+		}
+		for _, text := range blocks {
+			parts = append(parts, msgPart{i, text})
+		}
+	}
+	return m.joinParts(parts)
+}
+
+// renderMessageCompact renders one message for the compact layout.
+func (m Model) renderMessageCompact(msg Message, w int) []string {
+	switch msg.Role {
+	case "user":
+		label := m.styles.UserLabel.Render(ActiveGlyphs.User + " ")
+		return []string{label + m.styles.UserBubble.Width(w-2).Render(msg.Content)}
+	case "assistant":
+		label := m.styles.AssistantLabel.Render(ActiveGlyphs.Assistant + " ")
+		content := msg.Content
+		if msg.ThinkContent != "" && m.thinkExpanded {
+			thinkStyle := lipgloss.NewStyle().Foreground(m.theme.TextMuted).Italic(true)
+			content = thinkStyle.Render(msg.ThinkContent) + "\n" + content
+		}
+		rendered := RenderMarkdown(content, m.theme, w-2)
+		return []string{label + m.styles.AssistantBubble.Width(w-2).Render(rendered)}
+	case "system":
+		style := lipgloss.NewStyle().Foreground(m.theme.SystemBubbleFg).Width(w)
+		return []string{style.Render(msg.Content)}
 	}
+	return nil
+}
+
+// assistantLabel is the label shown above a streaming assistant response.
+func (m Model) assistantLabel() string {
+	if m.Compact() {
+		return m.styles.AssistantLabel.Render(ActiveGlyphs.Assistant)
+	}
+	return m.styles.AssistantLabel.Render(ActiveGlyphs.Assistant + " Hecate")
 }
 
 func (m *Model) updateStreamingMessage() {
-	content := m.renderMessages()
+	m.aimDraw()
+	content, spans := m.renderMessages()
+	m.msgSpans = spans
 	// Always show assistant label when streaming
-	content += "\n\n" + m.styles.AssistantLabel.Render("◆ Hecate") + "\n"
+	content += "\n\n" + m.assistantLabel() + "\n"
+	streamWidth := m.viewport.Width - 8
+	if m.Compact() {
+		streamWidth = m.viewport.Width
+	}
 	if m.streamBuf.Len() > 0 {
 		// Strip think tags from display during streaming
 		bufText := m.streamBuf.String()
@@ -181,19 +320,18 @@ func (m *Model) updateStreamingMessage() {
 			}
 		}
 		// Show streamed content with cursor
-		bubble := m.styles.AssistantBubble.Width(m.viewport.Width - 8).Render(visible + "▊")
+		bubble := m.styles.AssistantBubble.Width(streamWidth).Render(visible + ActiveGlyphs.Cursor)
 		content += bubble
 	} else {
 		// Show thinking animation in the chat area while waiting for content
-		frame := ThinkingFrames[m.thinkingFrame]
-		sparkle := Sparkles[m.thinkingFrame%len(Sparkles)]
+		frame := ThinkingFrames[m.thinkingFrame%len(ThinkingFrames)]
+		spinner := ActiveGlyphs.Spinner[m.thinkingFrame%len(ActiveGlyphs.Spinner)]
 		thinkingStyle := lipgloss.NewStyle().Foreground(m.theme.StreamingColor)
-		thinking := thinkingStyle.Render(sparkle + " " + frame + " " + sparkle)
-		bubble := m.styles.AssistantBubble.Width(m.viewport.Width - 8).Render(thinking)
+		thinking := thinkingStyle.Render(spinner + " " + frame)
+		bubble := m.styles.AssistantBubble.Width(streamWidth).Render(thinking)
 		content += bubble
 	}
-	m.viewport.SetContent(content)
-	m.viewport.GotoBottom()
+	m.showContent(content)
 }
 
 func (m *Model) resize() {
@@ -205,15 +343,21 @@ func (m *Model) resize() {
 
 	// Dynamic horizontal padding based on terminal width
 	hPadding := 4
-	if m.width < 80 {
-		hPadding = 2
-	} else if m.width < 60 {
-		hPadding = 1
+	minWidth := 40
+	if m.Compact() {
+		hPadding = 0
+		minWidth = 20
+		m.viewport.Style = lipgloss.NewStyle()
+	} else {
+		if m.width < 80 {
+			hPadding = 2
+		}
+		m.viewport.Style = lipgloss.NewStyle().Padding(0, 1)
 	}
 
 	vpWidth := m.width - hPadding
-	if vpWidth < 40 {
-		vpWidth = 40
+	if vpWidth < minWidth {
+		vpWidth = minWidth
 	}
 
 	m.viewport.Width = vpWidth
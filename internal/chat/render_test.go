@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// longConversation builds a model holding n alternating user and
+// markdown-heavy assistant messages.
+func longConversation(n int) Model {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+	m.SetSize(120, 40)
+	msgs := make([]Message, 0, n)
+	for i := 0; i < n; i++ {
+		if i%2 == 0 {
+			msgs = append(msgs, Message{Role: "user", Content: fmt.Sprintf("Question %d about **markdown**?", i), Time: time.Now()})
+			continue
+		}
+		msgs = append(msgs, Message{
+			Role:    "assistant",
+			Content: fmt.Sprintf("## Answer %d\n\nSome *text* with `code`.\n\n- one\n- two\n\n```go\nfunc f() int { return %d }\n```", i, i),
+			Time:    time.Now(),
+		})
+	}
+	m.LoadMessages(msgs)
+	return m
+}
+
+func TestRenderCache_ReusesAndInvalidates(t *testing.T) {
+	m := longConversation(4)
+	first, _ := m.renderMessages()
+	if len(m.renders.entries) != 4 {
+		t.Fatalf("cached %d messages, want 4", len(m.renders.entries))
+	}
+
+	// Poison one entry: a hit must return it unchanged.
+	m.renders.entries[1].blocks = []string{"cached"}
+	if again, _ := m.renderMessages(); again == first {
+		t.Error("unchanged message was re-rendered instead of read from cache")
+	}
+
+	// A width change invalidates every entry.
+	m.SetSize(90, 40)
+	if m.renders.entries[1].blocks[0] == "cached" {
+		t.Error("width change should re-render cached messages")
+	}
+
+	m.ClearMessages()
+	if len(m.renders.entries) != 0 {
+		t.Errorf("cache kept %d entries after clear", len(m.renders.entries))
+	}
+}
+
+func TestRenderMessages_DrawsOnlyNearViewport(t *testing.T) {
+	m := longConversation(200)
+	content, spans := m.renderMessages()
+	lines := strings.Split(content, "\n")
+	if got, want := len(lines), spans[len(spans)-1].end; got != want {
+		t.Fatalf("content has %d lines, spans end at %d", got, want)
+	}
+	if strings.Contains(content, "Question 0 ") {
+		t.Error("first message drawn while viewport is at the bottom")
+	}
+	if !strings.Contains(content, "Question 198 ") {
+		t.Error("last message not drawn while viewport is at the bottom")
+	}
+
+	// Scrolling to the top draws the start and keeps the height.
+	m.GotoTop()
+	top := m.viewport.View()
+	if !strings.Contains(top, "Question 0 ") {
+		t.Error("first message not drawn after scrolling to the top")
+	}
+	if got := m.viewport.TotalLineCount(); got != len(lines) {
+		t.Errorf("total lines = %d after scrolling, want %d", got, len(lines))
+	}
+}
+
+func BenchmarkRenderMessages500(b *testing.B) {
+	m := longConversation(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.renderMessages()
+	}
+}
+
+func BenchmarkRenderMessages500Uncached(b *testing.B) {
+	m := longConversation(500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.renders.entries = nil
+		m.renderMessages()
+	}
+}
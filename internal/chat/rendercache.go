@@ -0,0 +1,84 @@
+package chat
+
+import "github.com/hecate-social/hecate-tui/internal/theme"
+
+// renderKey is everything that shapes a message's rendered blocks. A cached
+// render is reused only while its key is unchanged, so edits, resizes,
+// theme switches and think toggles all re-render naturally.
+type renderKey struct {
+	role, content, think string
+	stamp                string
+	width                int
+	expanded             bool
+	compact              bool
+	theme                *theme.Theme
+	styles               *theme.Styles
+}
+
+// renderKey builds the cache key for msg drawn at width.
+func (m Model) renderKey(msg Message, stamp string, width int, compact bool) renderKey {
+	return renderKey{
+		role:     msg.Role,
+		content:  msg.Content,
+		think:    msg.ThinkContent,
+		stamp:    stamp,
+		width:    width,
+		expanded: m.thinkExpanded,
+		compact:  compact,
+		theme:    m.theme,
+		styles:   m.styles,
+	}
+}
+
+type renderEntry struct {
+	key    renderKey
+	blocks []string
+}
+
+// renderCache memoizes each message's rendered blocks by message index,
+// and remembers which content lines the last render actually drew. It is
+// shared by copies of a Model, like streamBuf.
+type renderCache struct {
+	entries []renderEntry
+
+	drawnFrom, drawnTo int
+}
+
+// get returns the cached blocks for message i if they were rendered
+// under key. A nil cache never hits.
+func (c *renderCache) get(i int, key renderKey) ([]string, bool) {
+	if c == nil || i >= len(c.entries) || c.entries[i].blocks == nil || c.entries[i].key != key {
+		return nil, false
+	}
+	return c.entries[i].blocks, true
+}
+
+// put stores the blocks rendered for message i.
+func (c *renderCache) put(i int, key renderKey, blocks []string) {
+	if c == nil || blocks == nil {
+		return
+	}
+	for len(c.entries) <= i {
+		c.entries = append(c.entries, renderEntry{})
+	}
+	c.entries[i] = renderEntry{key: key, blocks: blocks}
+}
+
+// trim drops entries for messages past n, e.g. after the chat is cleared.
+func (c *renderCache) trim(n int) {
+	if c != nil && len(c.entries) > n {
+		c.entries = c.entries[:n]
+	}
+}
+
+// setDrawn records the content lines [lo, hi) the last render drew.
+func (c *renderCache) setDrawn(lo, hi int) {
+	if c != nil {
+		c.drawnFrom, c.drawnTo = lo, hi
+	}
+}
+
+// covers reports whether content lines [lo, hi) were drawn last render.
+func (c *renderCache) covers(lo, hi int) bool {
+	return c == nil || (lo >= c.drawnFrom && hi <= c.drawnTo)
+}
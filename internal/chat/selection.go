@@ -0,0 +1,133 @@
+package chat
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// msgPart is a rendered block of the chat and the message it belongs to
+// (-1 for blocks that aren't messages, like the pin list).
+type msgPart struct {
+	msg  int
+	text string
+}
+
+// msgSpan is the range of content lines [start, end) a message occupies.
+type msgSpan struct {
+	msg        int
+	start, end int
+}
+
+// joinParts joins rendered blocks with blank lines, marks the selected
+// message with a bar and records where each message landed. Only blocks
+// near the visible window are drawn; the rest become blank lines of the
+// same height, so scrolling and the line count stay exact while long
+// conversations skip building text nobody can see.
+func (m Model) joinParts(parts []msgPart) (string, []msgSpan) {
+	// First pass: lay every block out to find the total height.
+	heights := make([]int, len(parts))
+	var spans []msgSpan
+	line := 0
+	for n, p := range parts {
+		if n > 0 {
+			line++ // the blank line between blocks
+		}
+		heights[n] = strings.Count(p.text, "\n") + 1
+		if p.msg >= 0 {
+			// Consecutive blocks of one message (e.g. think + body) merge.
+			if k := len(spans) - 1; k >= 0 && spans[k].msg == p.msg {
+				spans[k].end = line + heights[n]
+			} else {
+				spans = append(spans, msgSpan{msg: p.msg, start: line, end: line + heights[n]})
+			}
+		}
+		line += heights[n]
+	}
+	lo, hi := m.drawWindow(line)
+	m.renders.setDrawn(lo, hi)
+
+	// Second pass: draw the blocks that overlap the window.
+	bar := lipgloss.NewStyle().Foreground(m.theme.Accent).Render("▌")
+	var b strings.Builder
+	line = 0
+	for n, p := range parts {
+		if n > 0 {
+			b.WriteString("\n\n")
+			line++
+		}
+		if line+heights[n] <= lo || line >= hi {
+			b.WriteString(strings.Repeat("\n", heights[n]-1))
+			line += heights[n]
+			continue
+		}
+		text := p.text
+		if p.msg >= 0 && p.msg == m.selected {
+			text = bar + strings.ReplaceAll(text, "\n", "\n"+bar)
+		}
+		b.WriteString(text)
+		line += heights[n]
+	}
+	return b.String(), spans
+}
+
+// drawWindow returns the content lines [lo, hi) worth drawing for content
+// total lines tall: the screen the viewport will show plus one screen of
+// margin either side. drawTop picks that screen; -1 means the bottom.
+func (m Model) drawWindow(total int) (lo, hi int) {
+	h := m.viewport.Height
+	top := m.drawTop
+	if top < 0 {
+		top = total - h
+	}
+	return top - h, top + 2*h
+}
+
+// ensureDrawn re-renders around the current scroll offset once scrolling
+// has moved the viewport past the drawn window.
+func (m *Model) ensureDrawn() {
+	offset := m.viewport.YOffset
+	if m.renders.covers(offset, offset+m.viewport.Height) {
+		return
+	}
+	m.drawTop = offset
+	content, spans := m.renderMessages()
+	m.msgSpans = spans
+	m.viewport.SetContent(content)
+	m.viewport.SetYOffset(offset)
+}
+
+// MessageAt returns the index of the message shown at the given row of the
+// chat viewport, or -1 if the row is blank or outside any message.
+func (m Model) MessageAt(row int) int {
+	if row < 0 || row >= m.viewport.Height {
+		return -1
+	}
+	line := m.viewport.YOffset + row
+	for _, sp := range m.msgSpans {
+		if line >= sp.start && line < sp.end {
+			return sp.msg
+		}
+	}
+	return -1
+}
+
+// SelectMessage highlights message i; -1 clears the selection.
+func (m *Model) SelectMessage(i int) {
+	if i < -1 || i >= len(m.messages) {
+		i = -1
+	}
+	if i == m.selected {
+		return
+	}
+	m.selected = i
+	m.updateViewportPreserveScroll()
+}
+
+// SelectedMessage returns the highlighted message, if any.
+func (m Model) SelectedMessage() (Message, bool) {
+	if m.selected < 0 || m.selected >= len(m.messages) {
+		return Message{}, false
+	}
+	return m.messages[m.selected], true
+}
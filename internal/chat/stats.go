@@ -0,0 +1,97 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/llm"
+)
+
+// TurnStats records one completed assistant turn.
+type TurnStats struct {
+	Model        string
+	Provider     string
+	Tokens       int // completion tokens
+	PromptTokens int // 0 when the daemon doesn't report it
+	Duration     time.Duration
+
+	// Estimated cost; Priced is false for paid turns with no known price.
+	Cost   float64
+	Priced bool
+}
+
+// Paid reports whether the turn ran on a commercial provider.
+func (t TurnStats) Paid() bool {
+	return llm.IsPaidProvider(t.Provider)
+}
+
+// Speed returns the turn's tokens per second, or 0 if unknown.
+func (t TurnStats) Speed() float64 {
+	if t.Duration <= 0 {
+		return 0
+	}
+	return float64(t.Tokens) / t.Duration.Seconds()
+}
+
+// SessionStats summarises the session for /stats.
+type SessionStats struct {
+	TotalTokens int
+	Roles       map[string]int // message count by role
+	Turns       []TurnStats
+}
+
+// recordTurn appends the finished turn to the session's stats.
+func (m *Model) recordTurn(msg streamDoneMsg) {
+	turn := TurnStats{
+		Model:        m.ActiveModelName(),
+		Provider:     m.ActiveModelProvider(),
+		Tokens:       msg.totalTokens,
+		PromptTokens: msg.promptTokens,
+		Duration:     msg.duration,
+	}
+	if turn.Paid() {
+		if price, ok := llm.LookupPrice(m.priceOverrides, turn.Provider, turn.Model); ok {
+			turn.Cost = price.Cost(turn.PromptTokens, turn.Tokens)
+			turn.Priced = true
+		}
+	}
+	m.turns = append(m.turns, turn)
+}
+
+// SetPriceOverrides sets user prices that take precedence over
+// llm.DefaultPrices when estimating turn costs.
+func (m *Model) SetPriceOverrides(prices map[string]llm.Price) {
+	m.priceOverrides = prices
+}
+
+// lastTurn returns the most recent turn, if any.
+func (m Model) lastTurn() (TurnStats, bool) {
+	if len(m.turns) == 0 {
+		return TurnStats{}, false
+	}
+	return m.turns[len(m.turns)-1], true
+}
+
+// SessionCost returns the estimated spend of all priced turns.
+func (m Model) SessionCost() float64 {
+	var total float64
+	for _, t := range m.turns {
+		total += t.Cost
+	}
+	return total
+}
+
+// SessionStats returns the session's token totals, message counts and
+// per-turn stats.
+func (m Model) SessionStats() SessionStats {
+	roles := make(map[string]int)
+	for _, msg := range m.messages {
+		roles[msg.Role]++
+	}
+	turns := make([]TurnStats, len(m.turns))
+	copy(turns, m.turns)
+	return SessionStats{
+		TotalTokens: m.sessionTokenCount,
+		Roles:       roles,
+		Turns:       turns,
+	}
+}
@@ -24,89 +24,99 @@ func debugf(format string, args ...any) {
 	}
 }
 
+// streamState is one in-flight LLM response. Each chat Model owns its
+// current stream, and every stream message carries the stream it came
+// from so a Model ignores messages from streams it no longer owns.
 type streamState struct {
-	ctx         context.Context
-	cancel      context.CancelFunc
-	respChan    <-chan llm.ChatResponse
-	errChan     <-chan error
-	start       time.Time
-	totalTokens int
+	ctx          context.Context
+	cancel       context.CancelFunc
+	respChan     <-chan llm.ChatResponse
+	errChan      <-chan error
+	start        time.Time
+	totalTokens  int
+	promptTokens int
 }
 
-var activeStream *streamState
-
 func (m *Model) sendMessage() tea.Cmd {
 	return m.sendMessageWithToolResults(nil)
 }
 
+// sendMessageWithToolResults opens a stream for the next assistant turn and
+// starts polling it.
 func (m *Model) sendMessageWithToolResults(toolResults []llm.ToolResult) tea.Cmd {
-	return func() tea.Msg {
-		debugf("sendMessageWithToolResults: toolResults=%d messages=%d", len(toolResults), len(m.messages))
-		if len(m.models) == 0 {
+	debugf("sendMessageWithToolResults: toolResults=%d messages=%d", len(toolResults), len(m.messages))
+	if len(m.models) == 0 {
+		return func() tea.Msg {
 			return streamErrorMsg{err: fmt.Errorf("no models available")}
 		}
+	}
 
-		modelName := m.models[m.activeModel].Name
-		ctx, cancel := context.WithCancel(context.Background())
-
-		// Convert our messages to llm.Message
-		var llmMsgs []llm.Message
+	modelName := m.models[m.activeModel].Name
+	ctx, cancel := context.WithCancel(context.Background())
 
-		// Prepend system prompt if set
-		if m.systemPrompt != "" {
-			llmMsgs = append(llmMsgs, llm.Message{
-				Role:    llm.RoleSystem,
-				Content: m.systemPrompt,
-			})
-		}
+	req := llm.ChatRequest{
+		Model:    modelName,
+		Messages: m.llmMessages(m.messages, toolResults),
+		Stream:   true,
+	}
 
-		for _, msg := range m.messages {
-			if msg.Role == "system" {
-				continue // Don't send system messages to LLM
-			}
-			lm := llm.Message{
-				Role:    llm.Role(msg.Role),
-				Content: msg.Content,
-			}
-			if len(msg.ToolCalls) > 0 {
-				lm.ToolCalls = msg.ToolCalls
-			}
-			llmMsgs = append(llmMsgs, lm)
-		}
+	// Add tool schemas if tools are enabled
+	if m.toolsEnabled && m.toolExecutor != nil {
+		req.Tools = m.buildToolSchemas()
+	}
 
-		// Add tool results if any
-		for _, result := range toolResults {
-			llmMsgs = append(llmMsgs, llm.Message{
-				Role:       llm.RoleTool,
-				Content:    result.Content,
-				ToolCallID: result.ToolCallID,
-			})
-		}
+	start := time.Now()
+	respChan, errChan := m.client.ChatStream(ctx, req)
 
-		req := llm.ChatRequest{
-			Model:    modelName,
-			Messages: llmMsgs,
-			Stream:   true,
-		}
+	m.stream = &streamState{
+		ctx:      ctx,
+		cancel:   cancel,
+		respChan: respChan,
+		errChan:  errChan,
+		start:    start,
+	}
 
-		// Add tool schemas if tools are enabled
-		if m.toolsEnabled && m.toolExecutor != nil {
-			req.Tools = m.buildToolSchemas()
-		}
+	return pollStreamCmd(m.stream)
+}
 
-		start := time.Now()
-		respChan, errChan := m.client.ChatStream(ctx, req)
+// llmMessages converts msgs to the request history: system prompt, pins,
+// the conversation without system notes, then any tool results.
+func (m *Model) llmMessages(msgs []Message, toolResults []llm.ToolResult) []llm.Message {
+	// Convert our messages to llm.Message
+	var llmMsgs []llm.Message
+
+	// Prepend system prompt if set
+	if m.systemPrompt != "" {
+		llmMsgs = append(llmMsgs, llm.Message{
+			Role:    llm.RoleSystem,
+			Content: m.systemPrompt,
+		})
+	}
+	llmMsgs = append(llmMsgs, m.pinnedMessages()...)
 
-		activeStream = &streamState{
-			ctx:      ctx,
-			cancel:   cancel,
-			respChan: respChan,
-			errChan:  errChan,
-			start:    start,
+	for _, msg := range msgs {
+		if msg.Role == "system" {
+			continue // Don't send system messages to LLM
 		}
+		lm := llm.Message{
+			Role:    llm.Role(msg.Role),
+			Content: msg.Content,
+		}
+		if len(msg.ToolCalls) > 0 {
+			lm.ToolCalls = msg.ToolCalls
+		}
+		llmMsgs = append(llmMsgs, lm)
+	}
 
-		return pollStreamCmd()
+	// Add tool results if any
+	for _, result := range toolResults {
+		llmMsgs = append(llmMsgs, llm.Message{
+			Role:       llm.RoleTool,
+			Content:    result.Content,
+			ToolCallID: result.ToolCallID,
+		})
 	}
+	return llmMsgs
 }
 
 // buildToolSchemas converts the tool registry to LLM tool schemas.
@@ -139,88 +149,94 @@ func (m *Model) buildToolSchemas() []llm.ToolSchema {
 	return schemas
 }
 
-func pollStreamCmd() tea.Msg {
-	if activeStream == nil {
-		debugf("pollStreamCmd: activeStream is nil")
-		// Return no-op instead of streamDoneMsg so stale poll ticks during
-		// tool execution don't kill the streaming state.
-		return continueStreamMsg{}
-	}
+func pollStreamCmd(st *streamState) tea.Cmd {
+	return func() tea.Msg { return pollStream(st) }
+}
 
+// pollStream reads the next event from st without blocking. It never
+// touches the Model; Update drops the stream once it reports an end.
+func pollStream(st *streamState) tea.Msg {
 	select {
-	case resp, ok := <-activeStream.respChan:
+	case resp, ok := <-st.respChan:
 		if !ok {
-			debugf("pollStreamCmd: respChan closed")
-			duration := time.Since(activeStream.start)
-			tokens := activeStream.totalTokens
+			debugf("pollStream: respChan closed")
+			duration := time.Since(st.start)
+			tokens := st.totalTokens
+			prompt := st.promptTokens
 			// Check errChan for a buffered error before reporting "channel closed".
 			// This fixes a race where Go's select picks respChan closure over errChan.
 			select {
-			case err, eOk := <-activeStream.errChan:
+			case err, eOk := <-st.errChan:
 				if eOk && err != nil && err != context.Canceled {
-					activeStream = nil
-					return streamErrorMsg{err: err}
+					return streamErrorMsg{stream: st, err: err}
 				}
 			default:
 			}
-			activeStream = nil
-			return streamDoneMsg{totalTokens: tokens, duration: duration, reason: "stream completed"}
+			return streamDoneMsg{stream: st, totalTokens: tokens, promptTokens: prompt, duration: duration, reason: "stream completed"}
 		}
 		// Debug: dump the raw response
 		raw, _ := json.Marshal(resp)
-		debugf("pollStreamCmd: got chunk: %s", string(raw))
-		debugf("pollStreamCmd: Message=%v ToolUse=%v Done=%v", resp.Message != nil, resp.ToolUse != nil, resp.Done)
+		debugf("pollStream: got chunk: %s", string(raw))
+		debugf("pollStream: Message=%v ToolUse=%v Done=%v", resp.Message != nil, resp.ToolUse != nil, resp.Done)
 		if resp.Message != nil {
-			debugf("pollStreamCmd: Message.ToolCalls=%d Content=%q", len(resp.Message.ToolCalls), resp.Message.Content)
+			debugf("pollStream: Message.ToolCalls=%d Content=%q", len(resp.Message.ToolCalls), resp.Message.Content)
 		}
 
 		if resp.EvalCount > 0 {
-			activeStream.totalTokens = resp.EvalCount
+			st.totalTokens = resp.EvalCount
+		}
+		if resp.PromptEvalCount > 0 {
+			st.promptTokens = resp.PromptEvalCount
 		}
 
 		// Check for tool use in the response (Anthropic streaming format)
 		if resp.ToolUse != nil {
-			debugf("pollStreamCmd: ToolUse detected (Anthropic)")
-			// Clear activeStream so stale poll ticks don't read remaining chunks
-			activeStream = nil
-			return toolUseCompleteMsg{call: *resp.ToolUse}
+			debugf("pollStream: ToolUse detected (Anthropic)")
+			return toolUseCompleteMsg{stream: st, call: *resp.ToolUse}
 		}
 
 		// Check for tool calls in message (Ollama/OpenAI format).
 		// Ollama sends tool_calls in a done:false chunk, so check regardless of Done.
 		if resp.Message != nil && len(resp.Message.ToolCalls) > 0 {
-			debugf("pollStreamCmd: ToolCalls detected in Message: %+v", resp.Message.ToolCalls[0])
-			// Clear activeStream so stale poll ticks don't read remaining chunks
-			activeStream = nil
-			return toolUseCompleteMsg{call: resp.Message.ToolCalls[0]}
+			debugf("pollStream: ToolCalls detected in Message: %+v", resp.Message.ToolCalls[0])
+			return toolUseCompleteMsg{stream: st, call: resp.Message.ToolCalls[0]}
 		}
 
 		if resp.Done {
-			duration := time.Since(activeStream.start)
-			tokens := activeStream.totalTokens
-			activeStream = nil
-			debugf("pollStreamCmd: Done=true, tokens=%d duration=%v", tokens, duration)
-			return streamDoneMsg{totalTokens: tokens, duration: duration, reason: "resp.Done=true"}
+			duration := time.Since(st.start)
+			tokens := st.totalTokens
+			prompt := st.promptTokens
+			debugf("pollStream: Done=true, tokens=%d duration=%v", tokens, duration)
+			return streamDoneMsg{stream: st, totalTokens: tokens, promptTokens: prompt, duration: duration, reason: "resp.Done=true"}
 		}
-		debugf("pollStreamCmd: returning streamChunkMsg")
-		return streamChunkMsg{chunk: resp}
+		debugf("pollStream: returning streamChunkMsg")
+		return streamChunkMsg{stream: st, chunk: resp}
 
-	case err, ok := <-activeStream.errChan:
+	case err, ok := <-st.errChan:
 		if !ok {
 			// errChan closed without error - stream ended normally, keep polling respChan
-			return continueStreamMsg{}
+			return continueStreamMsg{stream: st}
 		}
-		duration := time.Since(activeStream.start)
-		tokens := activeStream.totalTokens
-		activeStream = nil
+		duration := time.Since(st.start)
+		tokens := st.totalTokens
+		prompt := st.promptTokens
 		if err != nil && err != context.Canceled {
-			return streamErrorMsg{err: err}
+			return streamErrorMsg{stream: st, err: err}
 		}
-		return streamDoneMsg{totalTokens: tokens, duration: duration, reason: fmt.Sprintf("errChan: %v", err)}
+		return streamDoneMsg{stream: st, totalTokens: tokens, promptTokens: prompt, duration: duration, reason: fmt.Sprintf("errChan: %v", err)}
 
 	default:
-		return continueStreamMsg{}
+		return continueStreamMsg{stream: st}
+	}
+}
+
+// chunkContent returns a chunk's text in either the nested
+// (Message.Content) or flat (Content) format.
+func chunkContent(resp llm.ChatResponse) string {
+	if resp.Message != nil && resp.Message.Content != "" {
+		return resp.Message.Content
 	}
+	return resp.Content
 }
 
 func (m Model) thinkingTick() tea.Cmd {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/hecate-social/hecate-tui/internal/llm"
 	"github.com/hecate-social/hecate-tui/internal/llmtools"
@@ -118,13 +119,71 @@ func TestBuildToolSchemas_NoRequired(t *testing.T) {
 	}
 }
 
-func TestPollStreamCmd_NilActiveStream(t *testing.T) {
-	activeStream = nil
+// fakeStream returns a stream state fed by the returned channels.
+func fakeStream() (*streamState, chan llm.ChatResponse, chan error) {
+	respChan := make(chan llm.ChatResponse, 10)
+	errChan := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &streamState{
+		ctx:      ctx,
+		cancel:   cancel,
+		respChan: respChan,
+		errChan:  errChan,
+		start:    time.Now(),
+	}, respChan, errChan
+}
+
+func TestPollStream_NothingReady(t *testing.T) {
+	st, _, _ := fakeStream()
 
-	msg := pollStreamCmd()
-	_, ok := msg.(continueStreamMsg)
+	msg, ok := pollStream(st).(continueStreamMsg)
 	if !ok {
-		t.Fatalf("pollStreamCmd() with nil activeStream = %T, want continueStreamMsg", msg)
+		t.Fatalf("pollStream() with no data = %T, want continueStreamMsg", msg)
+	}
+	if msg.stream != st {
+		t.Error("continueStreamMsg should carry the polled stream")
+	}
+}
+
+func TestStreaming_ModelsStreamIndependently(t *testing.T) {
+	th := theme.HecateDark()
+	a := New(nil, th, th.ComputeStyles())
+	b := New(nil, th, th.ComputeStyles())
+
+	stA, respA, _ := fakeStream()
+	stB, respB, _ := fakeStream()
+	a.stream, a.streaming = stA, true
+	b.stream, b.streaming = stB, true
+
+	respA <- llm.ChatResponse{Content: "from a"}
+	respB <- llm.ChatResponse{Content: "from b"}
+	msgA := pollStream(stA)
+	msgB := pollStream(stB)
+
+	// Each model only takes chunks from its own stream.
+	a, _ = a.Update(msgB)
+	a, _ = a.Update(msgA)
+	b, _ = b.Update(msgA)
+	b, _ = b.Update(msgB)
+	if got := a.streamBuf.String(); got != "from a" {
+		t.Errorf("a buffered %q, want %q", got, "from a")
+	}
+	if got := b.streamBuf.String(); got != "from b" {
+		t.Errorf("b buffered %q, want %q", got, "from b")
+	}
+
+	// Finishing a's stream leaves b streaming.
+	close(respA)
+	a, _ = a.Update(pollStream(stA))
+	b, _ = b.Update(pollStream(stA))
+	if a.IsStreaming() {
+		t.Error("a should be done after its stream closed")
+	}
+	if !b.IsStreaming() {
+		t.Error("b stopped streaming when a's stream closed")
+	}
+	if a.LastAssistantMessage() != "from a" {
+		t.Errorf("a's reply = %q, want %q", a.LastAssistantMessage(), "from a")
 	}
 }
 
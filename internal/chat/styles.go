@@ -5,9 +5,6 @@ import (
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
-// Sparkles for streaming animation
-var Sparkles = []string{"✦", "✧", "★", "☆", "✦"}
-
 // ThinkingFrames for thinking animation
 var ThinkingFrames = []string{
 	"Channeling",
@@ -0,0 +1,94 @@
+package chat
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TimestampStyle selects how message times are shown in the chat.
+type TimestampStyle int
+
+const (
+	TimestampTime     TimestampStyle = iota // "15:04"
+	TimestampDateTime                       // "2006-01-02 15:04"
+	TimestampRelative                       // "3m ago", "yesterday"
+)
+
+// relativeRefresh is how often relative timestamps are redrawn.
+const relativeRefresh = 30 * time.Second
+
+// ParseTimestampStyle maps the [ui] timestamp_format setting ("time",
+// "datetime" or "relative") to a style, defaulting to time-only.
+func ParseTimestampStyle(s string) TimestampStyle {
+	switch s {
+	case "datetime":
+		return TimestampDateTime
+	case "relative":
+		return TimestampRelative
+	default:
+		return TimestampTime
+	}
+}
+
+// timestampTickMsg redraws relative timestamps. gen ties it to the loop
+// Init started.
+type timestampTickMsg struct{ gen int }
+
+// SetTimestampStyle changes how message times are shown.
+func (m *Model) SetTimestampStyle(style TimestampStyle) {
+	m.timestampStyle = style
+	m.updateViewportPreserveScroll()
+}
+
+// timestampTick schedules the next redraw of relative timestamps. Other
+// styles never change, so they need no tick.
+func (m Model) timestampTick() tea.Cmd {
+	if m.timestampStyle != TimestampRelative {
+		return nil
+	}
+	gen := m.timestampGen
+	return tea.Tick(relativeRefresh, func(time.Time) tea.Msg {
+		return timestampTickMsg{gen: gen}
+	})
+}
+
+// formatTimestamp renders t in style, relative to now where needed.
+func formatTimestamp(t, now time.Time, style TimestampStyle) string {
+	switch style {
+	case TimestampDateTime:
+		return t.Format("2006-01-02 15:04")
+	case TimestampRelative:
+		return relativeTime(t, now)
+	default:
+		return t.Format("15:04")
+	}
+}
+
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := now.Date()
+	sameDay := y1 == y2 && m1 == m2 && d1 == d2
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case sameDay:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	}
+	yesterday := now.AddDate(0, 0, -1)
+	y3, m3, d3 := yesterday.Date()
+	switch {
+	case y1 == y3 && m1 == m3 && d1 == d3:
+		return "yesterday " + t.Format("15:04")
+	case d < 7*24*time.Hour:
+		return t.Format("Mon 15:04")
+	case y1 == y2:
+		return t.Format("Jan 2")
+	default:
+		return t.Format("Jan 2 2006")
+	}
+}
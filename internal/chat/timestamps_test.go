@@ -0,0 +1,60 @@
+package chat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	now := time.Date(2026, 3, 12, 15, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name  string
+		t     time.Time
+		style TimestampStyle
+		want  string
+	}{
+		{"time only", now.Add(-2 * time.Hour), TimestampTime, "13:30"},
+		{"date and time", now.Add(-2 * time.Hour), TimestampDateTime, "2026-03-12 13:30"},
+		{"just now", now.Add(-20 * time.Second), TimestampRelative, "just now"},
+		{"minutes", now.Add(-3 * time.Minute), TimestampRelative, "3m ago"},
+		{"hours", now.Add(-5 * time.Hour), TimestampRelative, "5h ago"},
+		{"yesterday", time.Date(2026, 3, 11, 22, 15, 0, 0, time.Local), TimestampRelative, "yesterday 22:15"},
+		{"this week", time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local), TimestampRelative, "Mon 08:00"},
+		{"this year", time.Date(2026, 1, 20, 8, 0, 0, 0, time.Local), TimestampRelative, "Jan 20"},
+		{"older", time.Date(2025, 12, 1, 8, 0, 0, 0, time.Local), TimestampRelative, "Dec 1 2025"},
+	}
+	for _, tt := range tests {
+		if got := formatTimestamp(tt.t, now, tt.style); got != tt.want {
+			t.Errorf("%s: formatTimestamp() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimestampStyle(t *testing.T) {
+	if ParseTimestampStyle("relative") != TimestampRelative {
+		t.Error("relative not parsed")
+	}
+	if ParseTimestampStyle("datetime") != TimestampDateTime {
+		t.Error("datetime not parsed")
+	}
+	if ParseTimestampStyle("") != TimestampTime || ParseTimestampStyle("bogus") != TimestampTime {
+		t.Error("unknown formats should fall back to time-only")
+	}
+}
+
+func TestTimestampTick_StaleLoopRetired(t *testing.T) {
+	m := newTestModel(nil)
+	m.timestampStyle = TimestampRelative
+	m.Init()
+	stale := m.timestampGen
+	// Re-entering the studio runs Init again
+	m.Init()
+
+	if _, cmd := m.Update(timestampTickMsg{gen: stale}); cmd != nil {
+		t.Error("a tick from the earlier loop should not reschedule")
+	}
+	if _, cmd := m.Update(timestampTickMsg{gen: m.timestampGen}); cmd == nil {
+		t.Error("the current loop should keep ticking")
+	}
+}
@@ -30,15 +30,12 @@ func (m *Model) handleToolUseComplete(call llm.ToolCall) tea.Cmd {
 	registry := m.toolExecutor.Registry()
 	tool, _, ok := registry.Get(call.Name)
 	if !ok {
-		return func() tea.Msg {
-			return toolExecutionResultMsg{
-				result: llm.ToolResult{
-					ToolCallID: call.ID,
-					Content:    fmt.Sprintf("Unknown tool: %s", call.Name),
-					IsError:    true,
-				},
-			}
-		}
+		return m.rejectToolCall(call, llmtools.AuditUnknown, fmt.Sprintf("Unknown tool: %s", call.Name))
+	}
+
+	// Reject paths outside the sandbox before asking for approval
+	if err := m.toolExecutor.CheckSandbox(asToolCall(call)); err != nil {
+		return m.rejectToolCall(call, llmtools.AuditSandbox, err.Error())
 	}
 
 	// Check permissions
@@ -54,30 +51,66 @@ func (m *Model) handleToolUseComplete(call llm.ToolCall) tea.Cmd {
 
 	switch perm {
 	case llmtools.PermissionDeny:
-		return func() tea.Msg {
-			return toolExecutionResultMsg{
-				result: llm.ToolResult{
-					ToolCallID: call.ID,
-					Content:    fmt.Sprintf("Tool '%s' execution denied by policy", call.Name),
-					IsError:    true,
-				},
-			}
-		}
+		return m.rejectToolCall(call, llmtools.AuditPolicy, fmt.Sprintf("Tool '%s' execution denied by policy", call.Name))
 
 	case llmtools.PermissionAsk:
 		// Store pending call and request approval
 		m.pendingToolCall = &call
-		return func() tea.Msg {
+		m.approvalSeq++
+		request := func() tea.Msg {
 			return toolApprovalRequestMsg{tool: tool, call: call}
 		}
+		if m.approvalTimeout <= 0 {
+			return request
+		}
+		seq := m.approvalSeq
+		return tea.Batch(request, tea.Tick(m.approvalTimeout, func(time.Time) tea.Msg {
+			return approvalTimeoutMsg{seq: seq}
+		}))
 
 	default: // PermissionAllow
-		return m.executeToolCall(call)
+		decision := llmtools.AuditAllowed
+		if permissions.SessionGranted(call.Name) {
+			decision = llmtools.AuditSession
+		}
+		return m.executeToolCall(call, decision)
 	}
 }
 
-// executeToolCall runs a tool and returns the result message.
-func (m *Model) executeToolCall(call llm.ToolCall) tea.Cmd {
+// asToolCall converts a streamed tool call for the llmtools executor.
+func asToolCall(call llm.ToolCall) llmtools.ToolCall {
+	return llmtools.ToolCall{
+		ID:        call.ID,
+		Name:      call.Name,
+		Arguments: call.Arguments,
+	}
+}
+
+// rejectToolCall audits a call that will not run and returns its error
+// result.
+func (m *Model) rejectToolCall(call llm.ToolCall, decision, reason string) tea.Cmd {
+	executor := m.toolExecutor
+	return func() tea.Msg {
+		if executor != nil {
+			executor.Audit(asToolCall(call), decision, time.Time{}, llmtools.ToolResult{
+				ToolCallID: call.ID,
+				Content:    reason,
+				IsError:    true,
+			})
+		}
+		return toolExecutionResultMsg{
+			result: llm.ToolResult{
+				ToolCallID: call.ID,
+				Content:    reason,
+				IsError:    true,
+			},
+		}
+	}
+}
+
+// executeToolCall runs a tool and returns the result message. decision is
+// how the call was authorized, for the audit log.
+func (m *Model) executeToolCall(call llm.ToolCall, decision string) tea.Cmd {
 	m.executingTool = true
 
 	// Show that we're executing the tool
@@ -94,18 +127,15 @@ func (m *Model) executeToolCall(call llm.ToolCall) tea.Cmd {
 			}
 		}
 
-		// Convert llm.ToolCall to llmtools.ToolCall
-		toolCall := llmtools.ToolCall{
-			ID:        call.ID,
-			Name:      call.Name,
-			Arguments: call.Arguments,
-		}
+		toolCall := asToolCall(call)
 
 		// Execute the tool
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		started := time.Now()
 		result := m.toolExecutor.Registry().Execute(ctx, toolCall)
+		m.toolExecutor.Audit(toolCall, decision, started, result)
 
 		return toolExecutionResultMsg{
 			result: llm.ToolResult{
@@ -122,23 +152,24 @@ func (m *Model) handleApprovalResponse(msg toolApprovalResponseMsg) tea.Cmd {
 	m.pendingToolCall = nil
 
 	if !msg.approved {
-		return func() tea.Msg {
-			return toolExecutionResultMsg{
-				result: llm.ToolResult{
-					ToolCallID: msg.call.ID,
-					Content:    "Tool execution denied by user",
-					IsError:    true,
-				},
-			}
+		if msg.denyForSession && m.toolExecutor != nil {
+			m.toolExecutor.Permissions().DisableTool(msg.call.Name)
 		}
+		if msg.timedOut {
+			// Nobody answered; keep that apart from an explicit denial
+			return m.rejectToolCall(msg.call, llmtools.AuditTimeout, "Tool approval timed out; execution denied")
+		}
+		return m.rejectToolCall(msg.call, llmtools.AuditDenied, "Tool execution denied by user")
 	}
 
 	// Grant session permission if requested
+	decision := llmtools.AuditApproved
 	if msg.grantForSession && m.toolExecutor != nil {
 		m.toolExecutor.Permissions().GrantForSession(msg.call.Name)
+		decision = llmtools.AuditSession
 	}
 
-	return m.executeToolCall(msg.call)
+	return m.executeToolCall(msg.call, decision)
 }
 
 // continueWithToolResults sends tool results back to the LLM to continue.
@@ -179,7 +210,7 @@ func (m *Model) showToolExecution(call llm.ToolCall) {
 		}
 	}
 
-	content := fmt.Sprintf("⚙️ Executing: %s", call.Name)
+	content := fmt.Sprintf("%s Executing: %s", ActiveGlyphs.Tool, call.Name)
 	if argsPreview != "" {
 		content += fmt.Sprintf("\n   Args: %s", argsPreview)
 	}
@@ -194,9 +225,9 @@ func (m *Model) showToolExecution(call llm.ToolCall) {
 
 // showToolResult displays the result of a tool execution.
 func (m *Model) showToolResult(result llm.ToolResult) {
-	status := "✓"
+	status := ActiveGlyphs.OK
 	if result.IsError {
-		status = "✗"
+		status = ActiveGlyphs.Fail
 	}
 
 	// Truncate long results for display
@@ -246,6 +277,43 @@ func (m *Model) DenyToolCall() tea.Cmd {
 	}
 }
 
+// SetApprovalTimeout sets how long a tool approval may stay pending before it
+// is denied automatically. Zero or negative disables the timeout.
+func (m *Model) SetApprovalTimeout(d time.Duration) {
+	m.approvalTimeout = d
+}
+
+// handleApprovalTimeout denies the pending call if it is still the one the
+// timer was started for; any decision in the meantime cancels the timeout.
+func (m *Model) handleApprovalTimeout(msg approvalTimeoutMsg) tea.Cmd {
+	if m.pendingToolCall == nil || msg.seq != m.approvalSeq {
+		return nil
+	}
+	m.InjectSystemMessage("Tool approval timed out.")
+	return m.handleApprovalResponse(toolApprovalResponseMsg{
+		approved: false,
+		timedOut: true,
+		call:     *m.pendingToolCall,
+	})
+}
+
+// DenyToolCallForSession denies the pending tool call and disables the tool
+// for the rest of the session.
+func (m *Model) DenyToolCallForSession() tea.Cmd {
+	if m.pendingToolCall == nil {
+		return nil
+	}
+
+	call := *m.pendingToolCall
+	return func() tea.Msg {
+		return toolApprovalResponseMsg{
+			approved:       false,
+			denyForSession: true,
+			call:           call,
+		}
+	}
+}
+
 // ContinueAfterToolResult signals to continue the conversation after a tool result.
 func (m *Model) ContinueAfterToolResult() tea.Cmd {
 	return func() tea.Msg {
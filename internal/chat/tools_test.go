@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/llm"
 	"github.com/hecate-social/hecate-tui/internal/llmtools"
 	"github.com/hecate-social/hecate-tui/internal/theme"
@@ -237,10 +238,14 @@ func TestHandleToolUseComplete_RequiresApproval(t *testing.T) {
 		t.Fatal("handleToolUseComplete with approval-required tool should return non-nil cmd")
 	}
 
-	msg := cmd()
-	_, ok := msg.(toolApprovalRequestMsg)
-	if !ok {
-		t.Fatalf("cmd() = %T, want toolApprovalRequestMsg", msg)
+	// The request is batched with the auto-deny timer
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("cmd() = %T, want tea.BatchMsg of request and timeout", cmd())
+	}
+	msg := batch[0]()
+	if _, ok := msg.(toolApprovalRequestMsg); !ok {
+		t.Fatalf("batch[0]() = %T, want toolApprovalRequestMsg", msg)
 	}
 
 	if m.pendingToolCall == nil {
@@ -251,6 +256,41 @@ func TestHandleToolUseComplete_RequiresApproval(t *testing.T) {
 	}
 }
 
+func TestApprovalTimeout_DeniesPending(t *testing.T) {
+	m := newTestModelWithTools()
+	m.handleToolUseComplete(llm.ToolCall{ID: "call_1", Name: "read_file"})
+
+	cmd := m.handleApprovalTimeout(approvalTimeoutMsg{seq: m.approvalSeq})
+	if cmd == nil {
+		t.Fatal("timeout on a pending approval should deny it")
+	}
+	if m.pendingToolCall != nil {
+		t.Error("pendingToolCall should be cleared after timeout")
+	}
+	result, ok := cmd().(toolExecutionResultMsg)
+	if !ok || !result.result.IsError {
+		t.Fatalf("timeout should produce a denied tool result, got %T", cmd())
+	}
+	if result.result.Content == "Tool execution denied by user" {
+		t.Error("a timed-out approval should not read as an explicit denial")
+	}
+}
+
+func TestApprovalTimeout_StaleTimerIgnored(t *testing.T) {
+	m := newTestModelWithTools()
+	m.handleToolUseComplete(llm.ToolCall{ID: "call_1", Name: "read_file"})
+	staleSeq := m.approvalSeq
+	m.handleApprovalResponse(toolApprovalResponseMsg{approved: false, call: *m.pendingToolCall})
+	m.handleToolUseComplete(llm.ToolCall{ID: "call_2", Name: "read_file"})
+
+	if cmd := m.handleApprovalTimeout(approvalTimeoutMsg{seq: staleSeq}); cmd != nil {
+		t.Error("timer from an answered approval should not deny the next one")
+	}
+	if m.pendingToolCall == nil || m.pendingToolCall.ID != "call_2" {
+		t.Error("second approval should still be pending")
+	}
+}
+
 func TestContinueAfterToolResult(t *testing.T) {
 	m := newTestModelWithTools()
 	cmd := m.ContinueAfterToolResult()
@@ -373,3 +413,63 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestHandleToolUseComplete_OutsideSandbox(t *testing.T) {
+	m := newTestModelWithTools()
+	root := t.TempDir()
+	m.toolExecutor.SetRoot(root)
+
+	inside := llm.ToolCall{ID: "call_1", Name: "read_file", Arguments: json.RawMessage(`{"path":"` + root + `/sub/../notes.md"}`)}
+	if err := m.toolExecutor.CheckSandbox(llmtools.ToolCall{Name: inside.Name, Arguments: inside.Arguments}); err != nil {
+		t.Errorf("path inside the sandbox rejected: %v", err)
+	}
+
+	call := llm.ToolCall{ID: "call_2", Name: "read_file", Arguments: json.RawMessage(`{"path":"` + root + `/../secret"}`)}
+	msg := m.handleToolUseComplete(call)()
+	result, ok := msg.(toolExecutionResultMsg)
+	if !ok {
+		t.Fatalf("cmd() = %T, want toolExecutionResultMsg", msg)
+	}
+	if !result.result.IsError {
+		t.Error("escaping path should be rejected")
+	}
+	if m.pendingToolCall != nil {
+		t.Error("rejected call should not wait for approval")
+	}
+}
+
+func TestToolCalls_Audited(t *testing.T) {
+	m := newTestModelWithTools()
+	var got []llmtools.AuditEntry
+	m.toolExecutor.SetAuditor(func(e llmtools.AuditEntry) { got = append(got, e) })
+	m.toolExecutor.Permissions().SetToolPermission("echo", llmtools.PermissionAllow)
+
+	// Allowed by policy and executed
+	m.handleToolUseComplete(llm.ToolCall{ID: "call_1", Name: "echo", Arguments: json.RawMessage(`{"message": "hi"}`)})()
+
+	// Denied by the user after asking
+	m.handleApprovalResponse(toolApprovalResponseMsg{
+		approved: false,
+		call:     llm.ToolCall{ID: "call_2", Name: "read_file"},
+	})()
+
+	// Nobody answered before the approval timeout
+	m.handleApprovalResponse(toolApprovalResponseMsg{
+		approved: false,
+		timedOut: true,
+		call:     llm.ToolCall{ID: "call_3", Name: "read_file"},
+	})()
+
+	if len(got) != 3 {
+		t.Fatalf("audited %d calls, want 3", len(got))
+	}
+	if got[0].Tool != "echo" || got[0].Decision != llmtools.AuditAllowed || !got[0].OK || got[0].Args != `{"message":"hi"}` {
+		t.Errorf("allowed call audited as %+v", got[0])
+	}
+	if got[1].Decision != llmtools.AuditDenied || got[1].OK {
+		t.Errorf("denied call audited as %+v", got[1])
+	}
+	if got[2].Decision != llmtools.AuditTimeout || got[2].Error == got[1].Error {
+		t.Errorf("timed-out call audited as %+v", got[2])
+	}
+}
@@ -0,0 +1,21 @@
+package client
+
+import "os"
+
+// Actor returns the identity recorded as the author of venture and
+// department actions. HECATE_ACTOR overrides the default "user@hostname",
+// which is useful on shared machines.
+func Actor() string {
+	if actor := os.Getenv("HECATE_ACTOR"); actor != "" {
+		return actor
+	}
+	user := os.Getenv("USER")
+	if user == "" {
+		user = "unknown"
+	}
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "localhost"
+	}
+	return user + "@" + hostname
+}
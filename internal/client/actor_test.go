@@ -0,0 +1,21 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestActor_Override(t *testing.T) {
+	t.Setenv("HECATE_ACTOR", "ops-bot")
+	if got := Actor(); got != "ops-bot" {
+		t.Errorf("Actor() = %q, want %q", got, "ops-bot")
+	}
+}
+
+func TestActor_Default(t *testing.T) {
+	t.Setenv("HECATE_ACTOR", "")
+	t.Setenv("USER", "alice")
+	if got := Actor(); !strings.HasPrefix(got, "alice@") {
+		t.Errorf("Actor() = %q, want alice@<host>", got)
+	}
+}
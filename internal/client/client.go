@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -15,17 +16,28 @@ import (
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
-	transport  *http.Transport // nil for default TCP, set for Unix socket
+	transport  *http.Transport // nil for default TCP, set for Unix socket or custom TLS
 	socketPath string          // Unix socket path (empty for TCP)
+	insecure   bool            // TLS certificate verification disabled
+	token      string          // bearer token sent over TCP ("" = none)
+	timeout    time.Duration   // limit for one-shot requests
+	ctx        context.Context // cancels in-flight requests (nil = never)
 }
 
+// DefaultTimeout bounds one-shot requests, so a hung daemon surfaces as an
+// error instead of freezing whatever is waiting on it. It matches the
+// limit the client has always had.
+const DefaultTimeout = 10 * time.Second
+
+// SlowTimeout bounds calls that wait on an LLM provider or the mesh.
+const SlowTimeout = 60 * time.Second
+
 // New creates a new hecate client using TCP
 func New(baseURL string) *Client {
 	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		baseURL:    baseURL,
+		httpClient: &http.Client{},
+		timeout:    DefaultTimeout,
 	}
 }
 
@@ -41,16 +53,16 @@ func NewWithSocket(socketPath string) *Client {
 	return &Client{
 		baseURL: "http://localhost",
 		httpClient: &http.Client{
-			Timeout:   10 * time.Second,
 			Transport: transport,
 		},
 		transport:  transport,
 		socketPath: socketPath,
+		timeout:    DefaultTimeout,
 	}
 }
 
 // Transport returns the underlying http.Transport (for SSE streaming reuse).
-// Returns nil for default TCP clients.
+// Returns nil for default TCP clients without custom TLS.
 func (c *Client) Transport() *http.Transport {
 	return c.transport
 }
@@ -61,6 +73,61 @@ func (c *Client) SocketPath() string {
 	return c.socketPath
 }
 
+// SetTimeout changes how long one-shot requests may take. Zero or less
+// keeps the current limit.
+func (c *Client) SetTimeout(d time.Duration) {
+	if d > 0 {
+		c.timeout = d
+	}
+}
+
+// WithContext returns a copy of the client whose requests are cancelled
+// along with ctx, on top of the usual timeout.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	cp := *c
+	cp.ctx = ctx
+	return &cp
+}
+
+// SetToken sets the bearer token attached to every request. Unix socket
+// clients never send it; the socket is trusted locally.
+func (c *Client) SetToken(token string) {
+	if c.socketPath == "" {
+		c.token = token
+	}
+}
+
+// Token returns the bearer token the client sends, or "" when none.
+func (c *Client) Token() string {
+	return c.token
+}
+
+// ErrTokenRejected is returned when the daemon answers 401 Unauthorized.
+var ErrTokenRejected = errors.New("token rejected by daemon (401 Unauthorized)")
+
+// ErrTokenRequired is returned on a 401 when no token is configured.
+var ErrTokenRequired = errors.New("daemon requires a token (401 Unauthorized); set HECATE_TOKEN")
+
+// Authorize adds the bearer token to req, if the client has one. Requests
+// built outside Client's own methods (streams, arcade calls) must call it
+// too, or a daemon that requires a token answers 401.
+func (c *Client) Authorize(req *http.Request) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+}
+
+// CheckAuth turns a 401 response into a clear token error.
+func (c *Client) CheckAuth(resp *http.Response) error {
+	if resp.StatusCode != http.StatusUnauthorized {
+		return nil
+	}
+	if c.token == "" {
+		return ErrTokenRequired
+	}
+	return ErrTokenRejected
+}
+
 // BaseURL returns the base URL used by this client.
 func (c *Client) BaseURL() string {
 	return c.baseURL
@@ -75,10 +142,49 @@ type Response struct {
 
 // Health represents the health check response
 type Health struct {
-	Status        string `json:"status"`
-	Ready         bool   `json:"ready"`
-	UptimeSeconds int    `json:"uptime_seconds"`
-	Version       string `json:"version"`
+	Status        string                 `json:"status"`
+	Ready         bool                   `json:"ready"`
+	UptimeSeconds int                    `json:"uptime_seconds"`
+	Version       string                 `json:"version"`
+	Checks        map[string]HealthCheck `json:"checks,omitempty"`
+	Mesh          *MeshHealth            `json:"mesh,omitempty"`
+}
+
+// HealthCheck is the state of a single daemon subsystem.
+type HealthCheck struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// MeshHealth describes the daemon's mesh connectivity.
+type MeshHealth struct {
+	Connected bool `json:"connected"`
+	Peers     int  `json:"peers"`
+}
+
+// WorstStatus returns the most severe status across the daemon and its
+// component checks, so a single failing subsystem shows up in the header.
+func (h *Health) WorstStatus() string {
+	worst := h.Status
+	for _, check := range h.Checks {
+		if healthSeverity(check.Status) > healthSeverity(worst) {
+			worst = check.Status
+		}
+	}
+	return worst
+}
+
+// healthSeverity ranks a status string: 0 healthy, 1 degraded, 2 failing.
+func healthSeverity(status string) int {
+	switch status {
+	case "healthy", "ok", "":
+		return 0
+	case "degraded", "starting":
+		return 1
+	default:
+		return 2
+	}
 }
 
 // Identity represents the current agent identity
@@ -86,6 +192,8 @@ type Identity struct {
 	Identity  string `json:"identity"`
 	PublicKey string `json:"public_key"`
 	CreatedAt string `json:"created_at"`
+	Realm     string `json:"realm,omitempty"`
+	NodeID    string `json:"node_id,omitempty"`
 }
 
 // Capability represents a discovered capability
@@ -114,6 +222,9 @@ type Subscription struct {
 	SubscriptionID string `json:"subscription_id"`
 	ServiceMRI     string `json:"service_mri"`
 	SubscribedAt   string `json:"subscribed_at"`
+	Topic          string `json:"topic,omitempty"`
+	DeliveryCount  int    `json:"delivery_count,omitempty"`
+	LastMessageAt  string `json:"last_message_at,omitempty"`
 }
 
 // GetHealth checks daemon health
@@ -135,6 +246,26 @@ func (c *Client) GetHealth() (*Health, error) {
 	return &health, nil
 }
 
+// GetHealthDetail returns the full health payload including component checks
+// and mesh connectivity. Heavier than GetHealth, so only fetched on demand.
+func (c *Client) GetHealthDetail() (*Health, error) {
+	resp, err := c.get("/health?detail=true")
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Ok {
+		return nil, fmt.Errorf("health check failed: %s", resp.Error)
+	}
+
+	var health Health
+	if err := json.Unmarshal(resp.Result, &health); err != nil {
+		return nil, fmt.Errorf("failed to parse health response: %w", err)
+	}
+
+	return &health, nil
+}
+
 // GetIdentity returns the current agent identity
 func (c *Client) GetIdentity() (*Identity, error) {
 	resp, err := c.get("/identity")
@@ -168,7 +299,7 @@ func (c *Client) DiscoverCapabilities(realm, tag string, limit int) ([]Capabilit
 		reqBody["limit"] = limit
 	}
 
-	resp, err := c.post("/capabilities/discover", reqBody)
+	resp, err := c.postSlow("/capabilities/discover", reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +329,7 @@ func (c *Client) ListProcedures() ([]Procedure, error) {
 
 // PairingStatus represents the pairing status response
 type PairingStatus struct {
-	Status     string `json:"status"`     // "idle", "waiting", "paired", "error"
+	Status     string `json:"status"`     // "idle", "waiting", "approved", "paired", "expired", "error"
 	Code       string `json:"code"`       // Pairing code to enter on realm
 	ExpiresAt  string `json:"expires_at"` // When the pairing session expires
 	RealmURL   string `json:"realm_url"`  // URL to complete pairing
@@ -278,25 +409,23 @@ func (c *Client) ListSubscriptions() ([]Subscription, error) {
 	return result.Subscriptions, nil
 }
 
-// get performs a GET request
-func (c *Client) get(path string) (*Response, error) {
-	req, err := http.NewRequest("GET", c.baseURL+path, nil)
+// Unsubscribe removes an active subscription by ID
+func (c *Client) Unsubscribe(subscriptionID string) error {
+	resp, err := c.post("/subscriptions/"+subscriptionID+"/remove", nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return err
 	}
 
-	httpResp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+	if !resp.Ok {
+		return fmt.Errorf("unsubscribe failed: %s", resp.Error)
 	}
-	defer func() { _ = httpResp.Body.Close() }()
 
-	body, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+	return nil
+}
 
-	return parseResponse(body)
+// get performs a GET request
+func (c *Client) get(path string) (*Response, error) {
+	return c.do("GET", path, nil, c.timeout)
 }
 
 // parseResponse handles both wrapped {"ok": true, "result": {...}} and
@@ -360,6 +489,21 @@ func parseResponse(body []byte) (*Response, error) {
 
 // post performs a POST request with JSON body
 func (c *Client) post(path string, body interface{}) (*Response, error) {
+	return c.do("POST", path, body, c.timeout)
+}
+
+// postSlow is post for calls that wait on an LLM provider or the mesh.
+func (c *Client) postSlow(path string, body interface{}) (*Response, error) {
+	timeout := SlowTimeout
+	if c.timeout > timeout {
+		timeout = c.timeout
+	}
+	return c.do("POST", path, body, timeout)
+}
+
+// do sends one request and parses the reply, giving up after timeout or
+// when the client's context is cancelled.
+func (c *Client) do(method, path string, body interface{}, timeout time.Duration) (*Response, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -369,22 +513,56 @@ func (c *Client) post(path string, body interface{}) (*Response, error) {
 		reqBody = bytes.NewReader(jsonBody)
 	}
 
-	req, err := http.NewRequest("POST", c.baseURL+path, reqBody)
+	parent := c.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	if method == "POST" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	c.Authorize(req)
 
 	httpResp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(ctx, timeout, err)
 	}
 	defer func() { _ = httpResp.Body.Close() }()
+	if err := c.CheckAuth(httpResp); err != nil {
+		return nil, err
+	}
 
 	respBody, err := io.ReadAll(httpResp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, requestError(ctx, timeout, fmt.Errorf("failed to read response: %w", err))
 	}
 
 	return parseResponse(respBody)
 }
+
+// ErrUnreachable matches errors for requests the daemon never answered,
+// as opposed to requests it answered with a failure.
+var ErrUnreachable = errors.New("daemon unreachable")
+
+// unreachableError wraps a transport failure so it matches ErrUnreachable
+// while keeping its own message and cause.
+type unreachableError struct{ err error }
+
+func (e unreachableError) Error() string        { return e.err.Error() }
+func (e unreachableError) Unwrap() error        { return e.err }
+func (e unreachableError) Is(target error) bool { return target == ErrUnreachable }
+
+// requestError explains a failed request, naming the timeout when that is
+// what ended it.
+func requestError(ctx context.Context, timeout time.Duration, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return unreachableError{fmt.Errorf("daemon did not respond within %s: %w", timeout, context.DeadlineExceeded)}
+	}
+	return unreachableError{fmt.Errorf("request failed: %w", err)}
+}
@@ -1,10 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -193,6 +199,52 @@ func TestListSubscriptions(t *testing.T) {
 	}
 }
 
+func TestUnsubscribe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/subscriptions/sub-123/remove" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(Response{Ok: true})
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if err := c.Unsubscribe("sub-123"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestSubscriptionEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/subscriptions/stream" {
+			t.Errorf("Expected path '/subscriptions/stream', got '%s'", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(": keepalive\n\n" +
+			"data: {\"subscription_id\":\"sub-1\",\"topic\":\"weather\",\"payload\":{\"temp\":21}}\n\n" +
+			"data: not json\n\n" +
+			"data: {\"subscription_id\":\"sub-1\",\"topic\":\"weather\",\"payload\":\"rain\"}\n\n"))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	events, errs := c.SubscriptionEvents(context.Background())
+
+	var got []SubscriptionEvent
+	for evt := range events {
+		got = append(got, evt)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 events, got %d", len(got))
+	}
+	if got[0].Topic != "weather" || string(got[1].Payload) != `"rain"` {
+		t.Errorf("Unexpected events: %+v", got)
+	}
+}
+
 func TestConnectionError(t *testing.T) {
 	c := New("http://localhost:99999") // Invalid port
 	_, err := c.GetHealth()
@@ -200,3 +252,176 @@ func TestConnectionError(t *testing.T) {
 		t.Fatal("Expected connection error, got nil")
 	}
 }
+
+func TestHealthWorstStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		health Health
+		want   string
+	}{
+		{"no checks", Health{Status: "healthy"}, "healthy"},
+		{"all ok", Health{Status: "healthy", Checks: map[string]HealthCheck{"llm": {Status: "ok"}}}, "healthy"},
+		{"degraded component", Health{Status: "healthy", Checks: map[string]HealthCheck{"llm": {Status: "ok"}, "mesh": {Status: "degraded"}}}, "degraded"},
+		{"failing beats degraded", Health{Status: "degraded", Checks: map[string]HealthCheck{"store": {Status: "error"}}}, "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.health.WorstStatus(); got != tt.want {
+				t.Errorf("WorstStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWithTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": true, "result": {"status": "healthy"}}`))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		opts    TLSOptions
+		wantErr bool
+	}{
+		{"untrusted", TLSOptions{}, true},
+		{"custom CA", TLSOptions{CAFile: caFile}, false},
+		{"insecure", TLSOptions{InsecureSkipVerify: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewWithTLS(server.URL, tt.opts)
+			if err != nil {
+				t.Fatalf("NewWithTLS() error = %v", err)
+			}
+			if c.Insecure() != tt.opts.InsecureSkipVerify {
+				t.Errorf("Insecure() = %v, want %v", c.Insecure(), tt.opts.InsecureSkipVerify)
+			}
+			_, err = c.GetHealth()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHealth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// Streams and one-shot requests made outside Client share its TLS settings.
+			for name, hc := range map[string]*http.Client{"HTTPClient": c.HTTPClient(), "StreamHTTPClient": c.StreamHTTPClient()} {
+				resp, err := hc.Get(server.URL + "/api/facts/stream")
+				if err == nil {
+					_ = resp.Body.Close()
+				}
+				if (err != nil) != tt.wantErr {
+					t.Errorf("%s().Get error = %v, wantErr %v", name, err, tt.wantErr)
+				}
+			}
+		})
+	}
+
+	if _, err := NewWithTLS(server.URL, TLSOptions{CAFile: filepath.Join(t.TempDir(), "missing.pem")}); err == nil {
+		t.Error("NewWithTLS() with a missing CA bundle should fail")
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok": true, "result": {"status": "healthy"}}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL)
+	if _, err := c.GetHealth(); !errors.Is(err, ErrTokenRequired) {
+		t.Errorf("GetHealth() without token error = %v, want ErrTokenRequired", err)
+	}
+
+	c.SetToken("wrong")
+	if _, err := c.GetHealth(); !errors.Is(err, ErrTokenRejected) {
+		t.Errorf("GetHealth() with bad token error = %v, want ErrTokenRejected", err)
+	}
+
+	c.SetToken("s3cret")
+	if _, err := c.GetHealth(); err != nil {
+		t.Errorf("GetHealth() with token error = %v", err)
+	}
+	if err := c.CancelPairing(); err != nil {
+		t.Errorf("POST with token error = %v", err)
+	}
+
+	// Socket clients stay unauthenticated.
+	sc := NewWithSocket("/tmp/hecate-test.sock")
+	sc.SetToken("s3cret")
+	if sc.Token() != "" {
+		t.Error("socket client should not keep a token")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := New(server.URL)
+	c.SetTimeout(50 * time.Millisecond)
+	start := time.Now()
+	_, err := c.ListVentures()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ListVentures() error = %v, want a deadline error", err)
+	}
+	if !errors.Is(err, ErrUnreachable) {
+		t.Errorf("timeout error %v should match ErrUnreachable", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("ListVentures() took %v against a hung daemon", elapsed)
+	}
+}
+
+func TestWithContextCancels(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := New(server.URL).WithContext(ctx)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := c.GetHealth(); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetHealth() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestErrUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok": false, "error": "venture not found"}`))
+	}))
+	c := New(server.URL)
+	if _, err := c.GetVentureByID("ven-gone"); err == nil || errors.Is(err, ErrUnreachable) {
+		t.Errorf("answered failure: error = %v, want a non-ErrUnreachable error", err)
+	}
+
+	server.Close()
+	if _, err := c.GetVentureByID("ven-gone"); !errors.Is(err, ErrUnreachable) {
+		t.Errorf("closed daemon: error = %v, want ErrUnreachable", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 type DaemonClient interface {
 	// Health & Identity
 	GetHealth() (*Health, error)
+	GetHealthDetail() (*Health, error)
 	GetIdentity() (*Identity, error)
 
 	// LLM
@@ -24,10 +25,13 @@ type DaemonClient interface {
 	AddProvider(name, pType, apiKey, url string) error
 	RemoveProvider(name string) error
 	ReloadProviders() ([]string, error)
+	TestProvider(name string) (*llm.ProviderTestResult, error)
 
 	// Discovery
 	DiscoverCapabilities(realm, tag string, limit int) ([]Capability, error)
 	ListSubscriptions() ([]Subscription, error)
+	Unsubscribe(subscriptionID string) error
+	SubscriptionEvents(ctx context.Context) (<-chan SubscriptionEvent, <-chan error)
 
 	// RPC
 	RPCCall(procedure string, args interface{}) (*RPCResult, error)
@@ -50,6 +54,8 @@ type DaemonClient interface {
 	// Departments (divisions)
 	ListDepartments(ventureID string) ([]Department, error)
 	GetDepartment(ventureID, departmentID string) (*Department, error)
+	ListDepartmentDesks(ventureID, departmentID string) ([]DepartmentDesk, error)
+	ListDepartmentImplementations(ventureID, departmentID string) ([]DepartmentImplementation, error)
 	DepartmentCommand(path string, body map[string]interface{}) error
 
 	// Pairing
@@ -74,20 +74,11 @@ func (c *Client) ChatStream(ctx context.Context, req llm.ChatRequest) (<-chan ll
 		}
 		httpReq.Header.Set("Content-Type", "application/json")
 		httpReq.Header.Set("Accept", "text/event-stream")
+		c.Authorize(httpReq)
 
 		// Use a client without timeout for streaming
-		// Create transport with no idle timeout for long-running streams
-		streamTransport := &http.Transport{
-			IdleConnTimeout:       0,
-			ResponseHeaderTimeout: 0,
-			ExpectContinueTimeout: 0,
-		}
-		if c.transport != nil {
-			// Reuse socket transport settings for Unix sockets
-			streamTransport.DialContext = c.transport.DialContext
-		}
 		streamClient := &http.Client{
-			Transport: streamTransport,
+			Transport: c.streamTransport(),
 			Timeout:   0, // No timeout for streaming
 		}
 		httpResp, err := streamClient.Do(httpReq)
@@ -97,6 +88,10 @@ func (c *Client) ChatStream(ctx context.Context, req llm.ChatRequest) (<-chan ll
 		}
 		defer func() { _ = httpResp.Body.Close() }()
 
+		if err := c.CheckAuth(httpResp); err != nil {
+			errChan <- err
+			return
+		}
 		if httpResp.StatusCode != http.StatusOK {
 			body, _ := io.ReadAll(httpResp.Body)
 			errChan <- fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode, string(body))
@@ -215,11 +210,34 @@ func (c *Client) ReloadProviders() ([]string, error) {
 	return result.Providers, nil
 }
 
+// TestProvider asks the daemon to make a minimal call with a provider's
+// credentials. A failed probe is reported in the result, not as an error.
+func (c *Client) TestProvider(name string) (*llm.ProviderTestResult, error) {
+	resp, err := c.postSlow("/api/llm/providers/"+name+"/test", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Ok && len(resp.Result) == 0 {
+		return &llm.ProviderTestResult{Error: resp.Error}, nil
+	}
+
+	var result llm.ProviderTestResult
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse provider test response: %w", err)
+	}
+	if !resp.Ok && result.Error == "" {
+		result.Error = resp.Error
+	}
+
+	return &result, nil
+}
+
 // Chat sends a non-streaming chat request
 func (c *Client) Chat(req llm.ChatRequest) (*llm.ChatResponse, error) {
 	req.Stream = false
 
-	resp, err := c.post("/api/llm/chat", req)
+	resp, err := c.postSlow("/api/llm/chat", req)
 	if err != nil {
 		return nil, err
 	}
@@ -21,7 +21,7 @@ func (c *Client) RPCCall(procedure string, args interface{}) (*RPCResult, error)
 		body["args"] = args
 	}
 
-	resp, err := c.post("/api/rpc/call", body)
+	resp, err := c.postSlow("/api/rpc/call", body)
 	if err != nil {
 		return nil, err
 	}
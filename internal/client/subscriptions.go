@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SubscriptionEvent is one message delivered to an active subscription.
+type SubscriptionEvent struct {
+	SubscriptionID string          `json:"subscription_id"`
+	ServiceMRI     string          `json:"service_mri"`
+	Topic          string          `json:"topic"`
+	Payload        json.RawMessage `json:"payload"`
+	ReceivedAt     string          `json:"received_at,omitempty"`
+}
+
+// SubscriptionEvents streams deliveries for all active subscriptions until
+// ctx is cancelled or the connection drops. Both channels are closed when
+// the stream ends; a non-nil error is sent first if it ended abnormally.
+func (c *Client) SubscriptionEvents(ctx context.Context) (<-chan SubscriptionEvent, <-chan error) {
+	eventChan := make(chan SubscriptionEvent, 100)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(eventChan)
+		defer close(errChan)
+
+		httpReq, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/subscriptions/stream", nil)
+		if err != nil {
+			errChan <- fmt.Errorf("failed to create request: %w", err)
+			return
+		}
+		httpReq.Header.Set("Accept", "text/event-stream")
+		c.Authorize(httpReq)
+
+		streamClient := &http.Client{Transport: c.streamTransport()}
+
+		httpResp, err := streamClient.Do(httpReq)
+		if err != nil {
+			errChan <- fmt.Errorf("request failed: %w", err)
+			return
+		}
+		defer func() { _ = httpResp.Body.Close() }()
+
+		if err := c.CheckAuth(httpResp); err != nil {
+			errChan <- err
+			return
+		}
+		if httpResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(httpResp.Body)
+			errChan <- fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode, string(body))
+			return
+		}
+
+		scanner := bufio.NewScanner(httpResp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if data == "" || data == "[DONE]" {
+				continue
+			}
+
+			var evt SubscriptionEvent
+			if err := json.Unmarshal([]byte(data), &evt); err != nil {
+				continue
+			}
+
+			select {
+			case eventChan <- evt:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			errChan <- err
+		}
+	}()
+
+	return eventChan, errChan
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// TLSOptions configures how the client verifies an https:// daemon.
+type TLSOptions struct {
+	// CAFile is a PEM bundle trusted in addition to the system roots,
+	// for daemons with a self-signed or private CA.
+	CAFile string
+
+	// InsecureSkipVerify turns off certificate verification entirely.
+	// Only for testing against a daemon whose certificate can't be trusted.
+	InsecureSkipVerify bool
+}
+
+// TLSOptionsFromEnv returns opts overridden by HECATE_CA_CERT and
+// HECATE_TLS_INSECURE=1, when set.
+func TLSOptionsFromEnv(opts TLSOptions) TLSOptions {
+	if ca := os.Getenv("HECATE_CA_CERT"); ca != "" {
+		opts.CAFile = ca
+	}
+	if os.Getenv("HECATE_TLS_INSECURE") == "1" {
+		opts.InsecureSkipVerify = true
+	}
+	return opts
+}
+
+// NewWithTLS creates a TCP client like New. For https:// URLs it verifies
+// the daemon with opts; other URLs ignore them.
+func NewWithTLS(baseURL string, opts TLSOptions) (*Client, error) {
+	c := New(baseURL)
+	if !strings.HasPrefix(baseURL, "https://") {
+		return c, nil
+	}
+
+	tlsConfig, err := opts.config()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	c.transport = transport
+	c.httpClient = &http.Client{Transport: transport}
+	c.insecure = opts.InsecureSkipVerify
+	return c, nil
+}
+
+// config builds the tls.Config for opts.
+func (o TLSOptions) config() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if o.InsecureSkipVerify {
+		cfg.InsecureSkipVerify = true
+		return cfg, nil
+	}
+	if o.CAFile == "" {
+		return cfg, nil
+	}
+
+	pem, err := os.ReadFile(o.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA bundle %s", o.CAFile)
+	}
+	cfg.RootCAs = pool
+	return cfg, nil
+}
+
+// Insecure reports whether the client skips TLS certificate verification.
+func (c *Client) Insecure() bool {
+	return c.insecure
+}
+
+// HTTPClient returns the client's HTTP client, for one-shot requests to
+// the daemon made outside Client's own methods. It reaches the daemon the
+// same way: over the Unix socket or with the configured TLS settings.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// StreamHTTPClient returns an HTTP client without timeouts for long-lived
+// streams to the daemon, sharing the client's socket dialer or TLS settings.
+func (c *Client) StreamHTTPClient() *http.Client {
+	return &http.Client{Transport: c.streamTransport()}
+}
+
+// streamTransport returns a transport without idle or header timeouts for
+// long-lived streams, sharing the client's socket dialer or TLS settings.
+func (c *Client) streamTransport() *http.Transport {
+	t := &http.Transport{
+		IdleConnTimeout:       0,
+		ResponseHeaderTimeout: 0,
+		ExpectContinueTimeout: 0,
+	}
+	if c.transport != nil {
+		t.DialContext = c.transport.DialContext
+		t.TLSClientConfig = c.transport.TLSClientConfig
+	}
+	return t
+}
@@ -3,7 +3,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 )
 
 // Venture represents a business endeavor in the Hecate system.
@@ -87,21 +86,10 @@ func (c *Client) listVenturesInternal(includeArchived bool) ([]Venture, error) {
 
 // InitiateVenture creates a new venture with the given name and brief.
 func (c *Client) InitiateVenture(name, brief string) (*Venture, error) {
-	// Get user@hostname for initiated_by
-	user := os.Getenv("USER")
-	if user == "" {
-		user = "unknown"
-	}
-	hostname, _ := os.Hostname()
-	if hostname == "" {
-		hostname = "localhost"
-	}
-	initiatedBy := user + "@" + hostname
-
 	body := map[string]interface{}{
 		"name":         name,
 		"brief":        brief,
-		"initiated_by": initiatedBy,
+		"initiated_by": Actor(),
 	}
 	resp, err := c.post("/api/ventures/setup", body)
 	if err != nil {
@@ -1,12 +1,28 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// Results larger than this many pretty-printed lines or bytes open in the
+// JSON viewer instead of the chat.
+const (
+	callInlineLines = 25
+	callInlineBytes = 2000
+)
+
+// ShowJSONMsg tells the app to open a JSON document in the viewer overlay.
+type ShowJSONMsg struct {
+	Title   string
+	Data    []byte
+	Summary string // optional note left in the chat
+}
+
 // CallCmd invokes an RPC procedure on the mesh.
 type CallCmd struct{}
 
@@ -20,75 +36,99 @@ func (c *CallCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			s := ctx.Styles
 			return InjectSystemMsg{
 				Content: s.Error.Render("Usage: /call <procedure-mri> [json-args]") + "\n" +
-					s.Subtle.Render("Example: /call mri:proc:io.macula/echo {\"msg\":\"hello\"}"),
+					s.Subtle.Render("Example: /call mri:proc:io.macula/echo {\"msg\":\"hello\"}") + "\n" +
+					s.Subtle.Render("Omit the args to fill them in a form."),
 			}
 		}
 	}
 
 	procedure := args[0]
-	var rpcArgs interface{}
 
-	// Parse optional JSON args
-	if len(args) > 1 {
-		jsonStr := strings.Join(args[1:], " ")
-		if err := json.Unmarshal([]byte(jsonStr), &rpcArgs); err != nil {
-			return func() tea.Msg {
-				return InjectSystemMsg{
-					Content: ctx.Styles.Error.Render("Invalid JSON args: " + err.Error()),
-				}
+	// Without args, prompt for them in a form built from the schema.
+	if len(args) == 1 {
+		return callFormCmd(procedure, ctx)
+	}
+
+	var rpcArgs interface{}
+	jsonStr := strings.Join(args[1:], " ")
+	if err := json.Unmarshal([]byte(jsonStr), &rpcArgs); err != nil {
+		return func() tea.Msg {
+			return InjectSystemMsg{
+				Content: ctx.Styles.Error.Render("Invalid JSON args: " + err.Error()),
 			}
 		}
 	}
 
-	return func() tea.Msg {
-		s := ctx.Styles
+	return CallProcedure(procedure, rpcArgs, ctx)
+}
 
-		result, err := ctx.Client.RPCCall(procedure, rpcArgs)
-		if err != nil {
-			return InjectSystemMsg{
-				Content: s.Error.Render("RPC Error: " + err.Error()),
+// renderCallResult performs the RPC and formats its result for the chat,
+// or for the JSON viewer when it is large.
+func renderCallResult(procedure string, rpcArgs interface{}, ctx *Context) tea.Msg {
+	s := ctx.Styles
+
+	result, err := ctx.Client.RPCCall(procedure, rpcArgs)
+	if err != nil {
+		return InjectSystemMsg{
+			Content: s.Error.Render("RPC Error: " + err.Error()),
+		}
+	}
+
+	if result.Error == "" && len(result.Result) > 0 && json.Valid(result.Result) {
+		var buf bytes.Buffer
+		if json.Indent(&buf, result.Result, "", "  ") == nil &&
+			(buf.Len() > callInlineBytes || strings.Count(buf.String(), "\n")+1 > callInlineLines) {
+			summary := s.CardTitle.Render("RPC Result") + " " + s.CardValue.Render(procedure) +
+				s.Subtle.Render(fmt.Sprintf("  %d bytes", len(result.Result)))
+			if result.Duration != "" {
+				summary += s.Subtle.Render("  " + result.Duration)
+			}
+			return ShowJSONMsg{
+				Title:   "RPC " + procedure,
+				Data:    result.Result,
+				Summary: summary + "\n" + s.Subtle.Render("Opened in viewer (y copies, Esc closes)"),
 			}
 		}
+	}
 
-		var b strings.Builder
-		b.WriteString(s.CardTitle.Render("RPC Result"))
-		b.WriteString("\n\n")
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("RPC Result"))
+	b.WriteString("\n\n")
 
-		b.WriteString(s.CardLabel.Render("Procedure: "))
-		b.WriteString(s.CardValue.Render(procedure))
+	b.WriteString(s.CardLabel.Render("Procedure: "))
+	b.WriteString(s.CardValue.Render(procedure))
+	b.WriteString("\n")
+
+	if result.Duration != "" {
+		b.WriteString(s.CardLabel.Render("Duration: "))
+		b.WriteString(s.Subtle.Render(result.Duration))
 		b.WriteString("\n")
+	}
 
-		if result.Duration != "" {
-			b.WriteString(s.CardLabel.Render("Duration: "))
-			b.WriteString(s.Subtle.Render(result.Duration))
-			b.WriteString("\n")
-		}
+	if result.Error != "" {
+		b.WriteString(s.CardLabel.Render("Error: "))
+		b.WriteString(s.Error.Render(result.Error))
+	} else {
+		b.WriteString("\n")
+		b.WriteString(s.Bold.Render("  Result:"))
+		b.WriteString("\n")
 
-		if result.Error != "" {
-			b.WriteString(s.CardLabel.Render("Error: "))
-			b.WriteString(s.Error.Render(result.Error))
-		} else {
-			b.WriteString("\n")
-			b.WriteString(s.Bold.Render("  Result:"))
-			b.WriteString("\n")
-
-			// Pretty-print JSON result
-			var pretty json.RawMessage
-			if json.Unmarshal(result.Result, &pretty) == nil {
-				formatted, err := json.MarshalIndent(pretty, "  ", "  ")
-				if err == nil {
-					b.WriteString("  ")
-					b.WriteString(s.CardValue.Render(string(formatted)))
-				} else {
-					b.WriteString("  ")
-					b.WriteString(s.CardValue.Render(string(result.Result)))
-				}
+		// Pretty-print JSON result
+		var pretty json.RawMessage
+		if json.Unmarshal(result.Result, &pretty) == nil {
+			formatted, err := json.MarshalIndent(pretty, "  ", "  ")
+			if err == nil {
+				b.WriteString("  ")
+				b.WriteString(s.CardValue.Render(string(formatted)))
 			} else {
 				b.WriteString("  ")
 				b.WriteString(s.CardValue.Render(string(result.Result)))
 			}
+		} else {
+			b.WriteString("  ")
+			b.WriteString(s.CardValue.Render(string(result.Result)))
 		}
-
-		return InjectSystemMsg{Content: b.String()}
 	}
+
+	return InjectSystemMsg{Content: b.String()}
 }
@@ -0,0 +1,226 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CallParam describes one procedure argument from its input schema.
+type CallParam struct {
+	Name        string
+	Type        string // "string", "number", "integer", "boolean", "object", "array"
+	Description string
+	Required    bool
+	Default     string
+	Enum        []string
+}
+
+// CallFormMsg tells the app to collect /call arguments in a form. An empty
+// Params means no schema was found and the args are entered as raw JSON.
+type CallFormMsg struct {
+	Procedure string
+	Params    []CallParam
+}
+
+// CallArgsKey is the form field used when no schema is available.
+const CallArgsKey = "args"
+
+// callFormCmd looks up the procedure's input schema and asks for a form.
+func callFormCmd(procedure string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		msg := CallFormMsg{Procedure: procedure}
+		caps, err := ctx.Client.DiscoverCapabilities("", "", 0)
+		if err != nil {
+			return msg
+		}
+		for _, c := range caps {
+			if c.MRI == procedure || c.DemoProcedure == procedure {
+				msg.Params, _ = parseInputSchema(c.InputSchema)
+				break
+			}
+		}
+		return msg
+	}
+}
+
+// parseInputSchema reads the properties of a JSON Schema object, keeping
+// their declared order.
+func parseInputSchema(schema string) ([]CallParam, error) {
+	if strings.TrimSpace(schema) == "" {
+		return nil, nil
+	}
+	var doc struct {
+		Properties json.RawMessage `json:"properties"`
+		Required   []string        `json:"required"`
+	}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Properties) == 0 {
+		return nil, nil
+	}
+
+	var props map[string]struct {
+		Type        interface{}   `json:"type"`
+		Description string        `json:"description"`
+		Default     interface{}   `json:"default"`
+		Enum        []interface{} `json:"enum"`
+	}
+	if err := json.Unmarshal(doc.Properties, &props); err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool, len(doc.Required))
+	for _, r := range doc.Required {
+		required[r] = true
+	}
+
+	var params []CallParam
+	for _, name := range objectKeys(doc.Properties) {
+		p := props[name]
+		param := CallParam{
+			Name:        name,
+			Type:        schemaType(p.Type),
+			Description: p.Description,
+			Required:    required[name],
+		}
+		if p.Default != nil {
+			param.Default = scalarString(p.Default)
+		}
+		for _, e := range p.Enum {
+			param.Enum = append(param.Enum, scalarString(e))
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// objectKeys returns the keys of a JSON object in document order.
+func objectKeys(data json.RawMessage) []string {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		key, _ := tok.(string)
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			break
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
+
+// schemaType picks the first non-null type from a "type" value, which may
+// be a string or a list.
+func schemaType(t interface{}) string {
+	switch v := t.(type) {
+	case string:
+		return v
+	case []interface{}:
+		for _, x := range v {
+			if s, ok := x.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	return "string"
+}
+
+func scalarString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// BuildCallArgs converts submitted form values into RPC arguments,
+// checking required fields and value types.
+func BuildCallArgs(params []CallParam, values map[string]string) (interface{}, error) {
+	if len(params) == 0 {
+		raw := strings.TrimSpace(values[CallArgsKey])
+		if raw == "" {
+			return nil, nil
+		}
+		var args interface{}
+		if err := json.Unmarshal([]byte(raw), &args); err != nil {
+			return nil, fmt.Errorf("invalid JSON args: %w", err)
+		}
+		return args, nil
+	}
+
+	var missing []string
+	args := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		raw := strings.TrimSpace(values[p.Name])
+		if raw == "" {
+			if p.Required {
+				missing = append(missing, p.Name)
+			}
+			continue
+		}
+		v, err := convertParam(p, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.Name, err)
+		}
+		args[p.Name] = v
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required: %s", strings.Join(missing, ", "))
+	}
+	return args, nil
+}
+
+func convertParam(p CallParam, raw string) (interface{}, error) {
+	switch p.Type {
+	case "integer":
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer")
+		}
+		return n, nil
+	case "number":
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number")
+		}
+		return n, nil
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("expected true or false")
+		}
+		return b, nil
+	case "object", "array":
+		var v interface{}
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, fmt.Errorf("expected JSON %s", p.Type)
+		}
+		return v, nil
+	}
+	return raw, nil
+}
+
+// CallProcedure invokes procedure with args and renders the result.
+func CallProcedure(procedure string, args interface{}, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		return renderCallResult(procedure, args, ctx)
+	}
+}
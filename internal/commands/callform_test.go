@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseInputSchema(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"msg": {"type": "string", "description": "Text to echo"},
+			"count": {"type": ["integer", "null"], "default": 1},
+			"mode": {"type": "string", "enum": ["fast", "slow"]}
+		},
+		"required": ["msg"]
+	}`
+
+	got, err := parseInputSchema(schema)
+	if err != nil {
+		t.Fatalf("parseInputSchema: %v", err)
+	}
+	want := []CallParam{
+		{Name: "msg", Type: "string", Description: "Text to echo", Required: true},
+		{Name: "count", Type: "integer", Default: "1"},
+		{Name: "mode", Type: "string", Enum: []string{"fast", "slow"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if got, err := parseInputSchema(""); err != nil || got != nil {
+		t.Errorf("empty schema: got %v, %v", got, err)
+	}
+}
+
+func TestBuildCallArgs(t *testing.T) {
+	params := []CallParam{
+		{Name: "msg", Type: "string", Required: true},
+		{Name: "count", Type: "integer"},
+		{Name: "loud", Type: "boolean"},
+		{Name: "tags", Type: "array"},
+	}
+
+	tests := []struct {
+		name    string
+		params  []CallParam
+		values  map[string]string
+		want    interface{}
+		wantErr string
+	}{
+		{
+			name:   "converts types",
+			params: params,
+			values: map[string]string{"msg": "hi", "count": "3", "loud": "true", "tags": `["a"]`},
+			want:   map[string]interface{}{"msg": "hi", "count": int64(3), "loud": true, "tags": []interface{}{"a"}},
+		},
+		{
+			name:   "omits empty optional",
+			params: params,
+			values: map[string]string{"msg": "hi", "count": " "},
+			want:   map[string]interface{}{"msg": "hi"},
+		},
+		{name: "missing required", params: params, values: map[string]string{}, wantErr: "missing required: msg"},
+		{name: "bad integer", params: params, values: map[string]string{"msg": "hi", "count": "x"}, wantErr: "count: expected an integer"},
+		{name: "raw json", values: map[string]string{"args": `{"a":1}`}, want: map[string]interface{}{"a": float64(1)}},
+		{name: "raw empty", values: map[string]string{}, want: nil},
+		{name: "raw invalid", values: map[string]string{"args": "{"}, wantErr: "invalid JSON args"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildCallArgs(tt.params, tt.values)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 )
 
 // ChangeDirMsg tells the app to change its working directory.
@@ -47,13 +48,7 @@ func (c *CdCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 
 	// Expand ~ to home directory
-	path := args[0]
-	if strings.HasPrefix(path, "~") {
-		home, err := os.UserHomeDir()
-		if err == nil {
-			path = filepath.Join(home, path[1:])
-		}
-	}
+	path := ui.ExpandHome(args[0])
 
 	// Make absolute
 	absPath, err := filepath.Abs(path)
@@ -91,13 +86,7 @@ func (c *CdCmd) Execute(args []string, ctx *Context) tea.Cmd {
 // completeDirs returns directory completions for the given prefix.
 func (c *CdCmd) completeDirs(prefix string) []string {
 	// Expand ~
-	searchPath := prefix
-	if strings.HasPrefix(searchPath, "~") {
-		home, err := os.UserHomeDir()
-		if err == nil {
-			searchPath = filepath.Join(home, searchPath[1:])
-		}
-	}
+	searchPath := ui.ExpandHome(prefix)
 
 	// If empty, use current directory
 	if searchPath == "" {
@@ -150,3 +139,14 @@ func (c *CdCmd) completeDirs(prefix string) []string {
 
 	return matches
 }
+
+// PwdCmd handles /pwd - show the working directory.
+type PwdCmd struct{}
+
+func (c *PwdCmd) Name() string        { return "pwd" }
+func (c *PwdCmd) Aliases() []string   { return nil }
+func (c *PwdCmd) Description() string { return "Show working directory" }
+
+func (c *PwdCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return (&CdCmd{}).Execute(nil, ctx)
+}
@@ -5,7 +5,9 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/alc"
+	"github.com/hecate-social/hecate-tui/internal/chat"
 	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/llmtools"
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
@@ -31,6 +33,7 @@ type Context struct {
 	Client     client.DaemonClient
 	SocketPath string // Unix socket path (if connected via socket)
 	HTTPUrl    string // HTTP URL (if connected via TCP)
+	AuthToken  string // bearer token sent over TCP ("" = none)
 	Theme      *theme.Theme
 	Styles     *theme.Styles
 	Width      int
@@ -42,21 +45,29 @@ type Context struct {
 
 	// Chat access
 	GetMessages     func() []ChatExportMsg
+	GetSessionStats func() chat.SessionStats
 	GetSystemPrompt func() string
 	SetSystemPrompt func(prompt string)
 
 	// Tool system access
 	GetToolExecutor func() *llmtools.Executor
 	ToolsEnabled    func() bool
+	ToolsAuto       func() bool
 
 	// Config access for personality/roles
 	GetActiveRole    func() string
 	SetActiveRole    func(role string) error
 	GetRoleNames     func() []string
+	GetRoles         func() []config.Role
+	AddRole          func(role config.CustomRole) error
+	RemoveRole       func(name string) error
 	RebuildPrompt    func() string // rebuilds system prompt from config
 
 	// ALC context access
 	GetALCContext func() *alc.State
+
+	// Studios available to /studio, in tab order
+	Studios []StudioInfo
 }
 
 // Ctx returns a background context. Used for tool execution.
@@ -66,9 +77,9 @@ func (c *Context) Ctx() context.Context {
 
 // ChatExportMsg represents a message for export purposes.
 type ChatExportMsg struct {
-	Role    string
-	Content string
-	Time    string
+	Role    string `json:"role"`
+	Content string `json:"content"`
+	Time    string `json:"time,omitempty"`
 }
 
 // ChatMessage represents a message to inject into the chat stream.
@@ -96,14 +107,30 @@ type SetModeMsg struct {
 	Mode int
 }
 
-// NewConversationMsg tells the app to start a new conversation.
-type NewConversationMsg struct{}
+// NewConversationMsg tells the app to start a new conversation. With Fork
+// set, the new conversation starts as a copy of the current one.
+type NewConversationMsg struct {
+	Fork bool
+}
 
 // LoadConversationMsg tells the app to load a specific conversation.
 type LoadConversationMsg struct {
 	ID string
 }
 
+// RecoverConversationMsg tells the app to load a conversation, salvaging
+// what it can from a damaged file. An empty ID means the last one.
+type RecoverConversationMsg struct {
+	ID string
+}
+
+// TagConversationMsg tells the app to add, remove or list tags on the
+// current conversation.
+type TagConversationMsg struct {
+	Action string // "add", "remove" or "list"
+	Tags   []string
+}
+
 // SwitchRoleMsg tells the app to switch to a different ALC role.
 type SwitchRoleMsg struct {
 	Role string // dna, anp, tni, dno
@@ -114,7 +141,7 @@ type SetALCContextMsg struct {
 	Context    alc.Context
 	Venture    *alc.VentureInfo
 	Department *alc.DepartmentInfo
-	Source     string // "manual", "git", "config"
+	Source     string // "manual", "git", "auto" (from .hecate/venture.json)
 }
 
 // ShowFormMsg tells the app to display a form overlay.
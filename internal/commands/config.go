@@ -7,6 +7,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/config"
+	"github.com/hecate-social/hecate-tui/internal/secret"
 )
 
 // ConfigCmd shows current configuration.
@@ -14,13 +15,35 @@ type ConfigCmd struct{}
 
 func (c *ConfigCmd) Name() string        { return "config" }
 func (c *ConfigCmd) Aliases() []string   { return nil }
-func (c *ConfigCmd) Description() string { return "Show current configuration" }
+func (c *ConfigCmd) Description() string { return "Show current configuration (--reveal for secrets)" }
+
+// credentialEnvVars are environment variables that hold provider keys.
+var credentialEnvVars = []string{
+	"ANTHROPIC_API_KEY",
+	"OPENAI_API_KEY",
+	"GOOGLE_API_KEY",
+	"GEMINI_API_KEY",
+	"MISTRAL_API_KEY",
+	"GROQ_API_KEY",
+	"TOGETHER_API_KEY",
+	"HECATE_TOKEN",
+}
 
 func (c *ConfigCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	reveal := len(args) > 0 && args[0] == "--reveal"
+
 	return func() tea.Msg {
 		s := ctx.Styles
 		var b strings.Builder
 
+		// show renders a secret, masked unless --reveal was given.
+		show := func(value string) string {
+			if reveal {
+				return value
+			}
+			return secret.Mask(value)
+		}
+
 		b.WriteString(s.CardTitle.Render("Configuration"))
 		b.WriteString("\n\n")
 
@@ -49,9 +72,21 @@ func (c *ConfigCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		if hecateURL == "" {
 			hecateURL = "http://localhost:4444"
 		}
+		if !reveal {
+			hecateURL = secret.MaskURL(hecateURL)
+		}
 		b.WriteString(s.CardLabel.Render("Daemon URL: "))
 		b.WriteString(s.CardValue.Render(hecateURL))
 		b.WriteString("\n")
+		if ctx.HTTPUrl != "" {
+			auth := "none"
+			if ctx.AuthToken != "" {
+				auth = "bearer " + show(ctx.AuthToken)
+			}
+			b.WriteString(s.CardLabel.Render("Auth: "))
+			b.WriteString(s.CardValue.Render(auth))
+			b.WriteString("\n")
+		}
 
 		// Daemon health
 		health, err := ctx.Client.GetHealth()
@@ -67,6 +102,41 @@ func (c *ConfigCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		}
 		b.WriteString("\n\n")
 
+		// Credentials
+		b.WriteString(s.Bold.Render("Credentials"))
+		b.WriteString("\n")
+		found := false
+		for _, name := range credentialEnvVars {
+			if v := os.Getenv(name); v != "" {
+				b.WriteString(s.CardLabel.Render(name + ": "))
+				b.WriteString(s.CardValue.Render(show(v)))
+				b.WriteString("\n")
+				found = true
+			}
+		}
+		if providers, err := ctx.Client.ListProviders(); err == nil {
+			for name, p := range providers {
+				if p.APIKey == "" {
+					continue
+				}
+				b.WriteString(s.CardLabel.Render(name + " key: "))
+				b.WriteString(s.CardValue.Render(show(p.APIKey)))
+				b.WriteString("\n")
+				found = true
+			}
+		}
+		if !found {
+			b.WriteString(s.Subtle.Render("No API keys in the environment or reported by the daemon"))
+			b.WriteString("\n")
+		} else if reveal {
+			b.WriteString(s.Error.Render("⚠ Secrets shown in full. They are not saved with the conversation."))
+			b.WriteString("\n")
+		} else {
+			b.WriteString(s.Subtle.Render("/config --reveal shows full values"))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+
 		// Theme
 		b.WriteString(s.Bold.Render("Theme"))
 		b.WriteString("\n")
@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/config"
@@ -12,29 +14,49 @@ type NewCmd struct{}
 
 func (c *NewCmd) Name() string        { return "new" }
 func (c *NewCmd) Aliases() []string   { return []string{"n"} }
-func (c *NewCmd) Description() string { return "Start a new conversation" }
+func (c *NewCmd) Description() string { return "Start a new conversation (/new [--fork])" }
 
 func (c *NewCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	fork := false
+	for _, arg := range args {
+		switch arg {
+		case "--fork", "-f":
+			fork = true
+		default:
+			return func() tea.Msg {
+				return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /new [--fork]")}
+			}
+		}
+	}
 	return func() tea.Msg {
-		return NewConversationMsg{}
+		return NewConversationMsg{Fork: fork}
 	}
 }
 
 // HistoryCmd lists saved conversations.
 type HistoryCmd struct{}
 
-func (c *HistoryCmd) Name() string        { return "history" }
-func (c *HistoryCmd) Aliases() []string   { return []string{"hist"} }
-func (c *HistoryCmd) Description() string { return "List saved conversations" }
+func (c *HistoryCmd) Name() string      { return "history" }
+func (c *HistoryCmd) Aliases() []string { return []string{"hist"} }
+func (c *HistoryCmd) Description() string {
+	return "List saved conversations (/history [--tag t] [search])"
+}
 
 func (c *HistoryCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	return func() tea.Msg {
 		s := ctx.Styles
 
+		tag, query := parseHistoryArgs(args)
 		convs := config.ListConversations()
 
 		var b strings.Builder
 		b.WriteString(s.CardTitle.Render("Conversations"))
+		if tag != "" {
+			b.WriteString(s.Subtle.Render("  #" + config.NormalizeTag(tag)))
+		}
+		if query != "" {
+			b.WriteString(s.Subtle.Render("  matching \"" + query + "\""))
+		}
 		b.WriteString("\n\n")
 
 		if len(convs) == 0 {
@@ -42,12 +64,30 @@ func (c *HistoryCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: b.String()}
 		}
 
+		// Keep positions from the full list so /load <number> still works.
+		var matched []int
+		for i, conv := range convs {
+			if tag != "" && !conv.HasTag(tag) {
+				continue
+			}
+			if query != "" && !conv.Matches(query) {
+				continue
+			}
+			matched = append(matched, i)
+		}
+
+		if len(matched) == 0 {
+			b.WriteString(s.Subtle.Render("No conversations match."))
+			return InjectSystemMsg{Content: b.String()}
+		}
+
 		limit := 10
-		if len(convs) < limit {
-			limit = len(convs)
+		if len(matched) < limit {
+			limit = len(matched)
 		}
 
-		for i := 0; i < limit; i++ {
+		for n := 0; n < limit; n++ {
+			i := matched[n]
 			conv := convs[i]
 			// Index for /load
 			idx := s.Bold.Render(itoa(i+1) + ".")
@@ -59,17 +99,20 @@ func (c *HistoryCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			if conv.Model != "" {
 				meta += s.Subtle.Render("  " + conv.Model)
 			}
+			if len(conv.Tags) > 0 {
+				meta += s.Subtle.Render("  #" + strings.Join(conv.Tags, " #"))
+			}
 			b.WriteString(idx + " " + title + meta)
 			b.WriteString("\n")
 			b.WriteString(s.Subtle.Render("     ID: " + conv.ID))
-			if i < limit-1 {
+			if n < limit-1 {
 				b.WriteString("\n")
 			}
 		}
 
-		if len(convs) > limit {
+		if len(matched) > limit {
 			b.WriteString("\n")
-			b.WriteString(s.Subtle.Render("  ..." + itoa(len(convs)-limit) + " more"))
+			b.WriteString(s.Subtle.Render("  ..." + itoa(len(matched)-limit) + " more"))
 		}
 
 		b.WriteString("\n\n")
@@ -79,6 +122,59 @@ func (c *HistoryCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 }
 
+// parseHistoryArgs splits /history args into a tag filter and search query.
+func parseHistoryArgs(args []string) (tag, query string) {
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case (args[i] == "--tag" || args[i] == "-t") && i+1 < len(args):
+			tag = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], "--tag="):
+			tag = strings.TrimPrefix(args[i], "--tag=")
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	return tag, strings.Join(rest, " ")
+}
+
+// TagCmd manages tags on the current conversation.
+type TagCmd struct{}
+
+func (c *TagCmd) Name() string        { return "tag" }
+func (c *TagCmd) Aliases() []string   { return nil }
+func (c *TagCmd) Description() string { return "Tag this conversation (/tag [add|remove] <tag>)" }
+
+func (c *TagCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		if len(args) == 0 || args[0] == "list" {
+			return TagConversationMsg{Action: "list"}
+		}
+
+		action := args[0]
+		switch action {
+		case "add", "remove", "rm":
+		default:
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /tag add <tag> | /tag remove <tag> | /tag list")}
+		}
+		if action == "rm" {
+			action = "remove"
+		}
+
+		var tags []string
+		for _, t := range args[1:] {
+			if t = config.NormalizeTag(t); t != "" {
+				tags = append(tags, t)
+			}
+		}
+		if len(tags) == 0 {
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /tag " + action + " <tag>")}
+		}
+		return TagConversationMsg{Action: action, Tags: tags}
+	}
+}
+
 // LoadCmd loads a saved conversation.
 type LoadCmd struct{}
 
@@ -111,20 +207,48 @@ func (c *LoadCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 }
 
-// DeleteCmd removes a saved conversation.
+// RecoverCmd loads the last conversation, or a given one, even when its
+// file is too large for startup or partly damaged.
+type RecoverCmd struct{}
+
+func (c *RecoverCmd) Name() string      { return "recover" }
+func (c *RecoverCmd) Aliases() []string { return nil }
+func (c *RecoverCmd) Description() string {
+	return "Recover the last conversation (/recover [id])"
+}
+
+func (c *RecoverCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	id := ""
+	if len(args) > 0 {
+		id = args[0]
+	}
+	return func() tea.Msg {
+		return RecoverConversationMsg{ID: id}
+	}
+}
+
+// DeleteCmd removes saved conversations.
 type DeleteCmd struct{}
 
-func (c *DeleteCmd) Name() string        { return "delete" }
-func (c *DeleteCmd) Aliases() []string   { return []string{"del"} }
-func (c *DeleteCmd) Description() string { return "Delete a saved conversation (/delete <id|number>)" }
+func (c *DeleteCmd) Name() string      { return "delete" }
+func (c *DeleteCmd) Aliases() []string { return []string{"del"} }
+func (c *DeleteCmd) Description() string {
+	return "Delete conversations (/delete <id|number> | --older-than 30d | --all)"
+}
 
 func (c *DeleteCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	if len(args) == 0 {
 		return func() tea.Msg {
-			return InjectSystemMsg{Content: "Usage: /delete <id> or /delete <number>\nUse /history to see available conversations."}
+			return InjectSystemMsg{Content: "Usage: /delete <id> or /delete <number>\n" +
+				"       /delete --older-than <30d|2w|12h> or /delete --all\n" +
+				"Use /history to see available conversations."}
 		}
 	}
 
+	if strings.HasPrefix(args[0], "--") {
+		return c.bulkDelete(args, ctx)
+	}
+
 	target := args[0]
 
 	// Check if it's a numeric index
@@ -146,6 +270,87 @@ func (c *DeleteCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 }
 
+// bulkDelete handles --all and --older-than. Without --yes it only reports
+// what would be removed.
+func (c *DeleteCmd) bulkDelete(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		var all, confirmed bool
+		var age time.Duration
+		for i := 0; i < len(args); i++ {
+			switch args[i] {
+			case "--all":
+				all = true
+			case "--yes", "-y":
+				confirmed = true
+			case "--older-than":
+				if i+1 >= len(args) {
+					return InjectSystemMsg{Content: s.Error.Render("--older-than needs a duration, e.g. 30d")}
+				}
+				d, err := parseAge(args[i+1])
+				if err != nil {
+					return InjectSystemMsg{Content: s.Error.Render(err.Error())}
+				}
+				age = d
+				i++
+			default:
+				return InjectSystemMsg{Content: s.Error.Render("Unknown option: " + args[i])}
+			}
+		}
+		if !all && age == 0 {
+			return InjectSystemMsg{Content: s.Error.Render("Use --all or --older-than <duration>")}
+		}
+
+		var ids []string
+		cutoff := time.Now().Add(-age)
+		for _, m := range config.ListConversationMeta() {
+			if all || m.CreatedAt.Before(cutoff) {
+				ids = append(ids, m.ID)
+			}
+		}
+
+		if len(ids) == 0 {
+			return InjectSystemMsg{Content: s.Subtle.Render("No conversations to delete.")}
+		}
+
+		if !confirmed {
+			return InjectSystemMsg{Content: s.StatusWarning.Render("This will permanently delete "+itoa(len(ids))+" conversation(s).") +
+				"\n" + s.Subtle.Render("Re-run with --yes to confirm: /delete "+strings.Join(args, " ")+" --yes")}
+		}
+
+		n, err := config.DeleteConversations(ids)
+		msg := s.StatusOK.Render("Deleted " + itoa(n) + " conversation(s).")
+		if err != nil {
+			msg += "\n" + s.Error.Render("Some deletions failed: "+err.Error())
+		}
+		return InjectSystemMsg{Content: msg}
+	}
+}
+
+// parseAge parses durations like "30d", "2w" or "12h".
+func parseAge(s string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		n := parseIndex(s[:len(s)-1])
+		if n <= 0 {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * unit, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q (use e.g. 30d, 2w, 12h)", s)
+	}
+	return d, nil
+}
+
 func parseIndex(s string) int {
 	n := 0
 	for _, c := range s {
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"30d", 30 * 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"12h", 12 * time.Hour, false},
+		{"0d", 0, true},
+		{"xd", 0, true},
+		{"-5h", 0, true},
+		{"soon", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseAge(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseAge(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseAge(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewCmdFork(t *testing.T) {
+	tests := []struct {
+		args []string
+		fork bool
+	}{
+		{nil, false},
+		{[]string{"--fork"}, true},
+		{[]string{"-f"}, true},
+	}
+	for _, tt := range tests {
+		msg, ok := (&NewCmd{}).Execute(tt.args, &Context{})().(NewConversationMsg)
+		if !ok {
+			t.Fatalf("/new %v did not start a conversation", tt.args)
+		}
+		if msg.Fork != tt.fork {
+			t.Errorf("/new %v Fork = %v, want %v", tt.args, msg.Fork, tt.fork)
+		}
+	}
+}
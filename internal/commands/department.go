@@ -1,21 +1,92 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 )
 
 // DepartmentCmd handles all /department subcommands for bounded context management.
-type DepartmentCmd struct{}
+type DepartmentCmd struct {
+	// dryRun is set on a per-invocation copy when --dry-run is passed.
+	// Handlers print the request they would send instead of dispatching it.
+	dryRun bool
+}
 
 func (c *DepartmentCmd) Name() string        { return "department" }
 func (c *DepartmentCmd) Aliases() []string   { return []string{"dept", "div", "division", "alc", "lifecycle", "lc"} }
 func (c *DepartmentCmd) Description() string { return "Manage departments (divisions)" }
 
+// departmentActions lists the verbs accepted after a division ID.
+var departmentActions = []string{
+	"design", "finding", "term", "transition", "dossier", "desk", "desks",
+	"plan", "approve", "test", "skeleton", "implement", "verify",
+	"deploy", "monitor", "incident", "resolve", "rescue", "generate", "complete",
+}
+
+// Complete implements Completable for department argument completion.
+func (c *DepartmentCmd) Complete(args []string, ctx *Context) []string {
+	args, _ = extractDryRun(args)
+	subcommands := []string{"init", "dashboard", "tree"}
+
+	if len(args) == 0 {
+		return append(subcommands, c.completeDivisionIDs("", ctx)...)
+	}
+
+	first := strings.ToLower(args[0])
+
+	// Second arg after a division ID is the action verb
+	if len(args) >= 2 {
+		if len(args) > 2 || !strings.HasPrefix(first, "div-") {
+			return nil
+		}
+		return matchPrefix(departmentActions, args[1])
+	}
+
+	// Single arg - complete subcommands and division IDs
+	return append(matchPrefix(subcommands, first), c.completeDivisionIDs(first, ctx)...)
+}
+
+// completeDivisionIDs returns IDs of the active venture's divisions matching the prefix.
+func (c *DepartmentCmd) completeDivisionIDs(prefix string, ctx *Context) []string {
+	ventureID := ventureIDFromContext(ctx)
+	if ventureID == "" || ctx.Client == nil {
+		return nil
+	}
+	divisions, err := ctx.Client.ListDepartments(ventureID)
+	if err != nil {
+		return nil
+	}
+
+	prefix = strings.ToLower(prefix)
+	var matches []string
+	for _, d := range divisions {
+		if strings.HasPrefix(strings.ToLower(d.DepartmentID), prefix) {
+			matches = append(matches, d.DepartmentID)
+		}
+	}
+	return matches
+}
+
+// matchPrefix returns the options starting with prefix, case-insensitively.
+func matchPrefix(options []string, prefix string) []string {
+	prefix = strings.ToLower(prefix)
+	var matches []string
+	for _, opt := range options {
+		if strings.HasPrefix(opt, prefix) {
+			matches = append(matches, opt)
+		}
+	}
+	return matches
+}
+
 // ventureIDFromContext extracts the active venture ID from the ALC context.
 func ventureIDFromContext(ctx *Context) string {
 	if ctx.GetALCContext == nil {
@@ -33,6 +104,85 @@ func divisionCmdPath(ventureID, divisionID, suffix string) string {
 	return "/api/ventures/" + ventureID + "/divisions/" + divisionID + "/" + suffix
 }
 
+// extractDryRun removes a --dry-run flag from args, reporting whether it was present.
+func extractDryRun(args []string) ([]string, bool) {
+	var rest []string
+	found := false
+	for _, arg := range args {
+		if arg == "--dry-run" {
+			found = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, found
+}
+
+// incidentSeverities lists the severities the daemon accepts, lowest first.
+var incidentSeverities = []string{"low", "medium", "high", "critical"}
+
+// defaultIncidentSeverity is used when an incident is reported without --sev.
+const defaultIncidentSeverity = "medium"
+
+// extractSeverity removes a --sev/--severity flag (either "--sev high" or
+// "--sev=high") from args and returns the validated severity, defaulting
+// to medium.
+func extractSeverity(args []string) ([]string, string, error) {
+	var rest []string
+	severity := defaultIncidentSeverity
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(arg, "=")
+		if name != "--sev" && name != "--severity" {
+			rest = append(rest, arg)
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, "", fmt.Errorf("%s needs a value (%s)", name, strings.Join(incidentSeverities, ", "))
+			}
+			i++
+			value = args[i]
+		}
+		value = strings.ToLower(value)
+		valid := false
+		for _, sev := range incidentSeverities {
+			if value == sev {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, "", fmt.Errorf("unknown severity %q (want %s)", value, strings.Join(incidentSeverities, ", "))
+		}
+		severity = value
+	}
+	return rest, severity, nil
+}
+
+// dryRunMsg renders the request a lifecycle command would send, without sending it.
+func dryRunMsg(ctx *Context, path string, body map[string]interface{}) tea.Msg {
+	s := ctx.Styles
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Dry Run"))
+	b.WriteString("\n\n")
+	b.WriteString(s.CardLabel.Render("POST "))
+	b.WriteString(s.CardValue.Render(path))
+	b.WriteString("\n")
+	if len(body) == 0 {
+		b.WriteString(s.Subtle.Render("(no body)"))
+	} else {
+		data, err := json.MarshalIndent(body, "", "  ")
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to encode body: " + err.Error())}
+		}
+		b.WriteString(s.Subtle.Render(string(data)))
+	}
+	b.WriteString("\n\n")
+	b.WriteString(s.Subtle.Render("Nothing was sent to the daemon."))
+	return InjectSystemMsg{Content: b.String()}
+}
+
 // requireVentureMsg returns an error message if no venture is active.
 func requireVentureMsg(ctx *Context) tea.Msg {
 	return InjectSystemMsg{
@@ -41,6 +191,11 @@ func requireVentureMsg(ctx *Context) tea.Msg {
 }
 
 func (c *DepartmentCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	args, dryRun := extractDryRun(args)
+	if dryRun {
+		c = &DepartmentCmd{dryRun: true}
+	}
+
 	if len(args) == 0 {
 		return c.showUsage(ctx)
 	}
@@ -51,6 +206,12 @@ func (c *DepartmentCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	if sub == "init" {
 		return c.initDepartment(args[1:], ctx)
 	}
+	if sub == "dashboard" {
+		return c.dashboard(ctx)
+	}
+	if sub == "tree" {
+		return c.tree(ctx)
+	}
 
 	// Everything else requires a division ID as first arg
 	if !strings.HasPrefix(sub, "div-") {
@@ -79,6 +240,8 @@ func (c *DepartmentCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return c.defineDossier(departmentID, rest, ctx)
 	case "desk":
 		return c.inventoryDesk(departmentID, rest, ctx)
+	case "desks":
+		return c.listDesks(departmentID, ctx)
 	case "plan":
 		return c.phaseAction(departmentID, "plan", rest, ctx)
 	case "approve":
@@ -151,7 +314,9 @@ func (c *DepartmentCmd) showUsage(ctx *Context) tea.Cmd {
 		b.WriteString(section("Getting Started", ""))
 		b.WriteString(row("/dept init <name>", "Discover a new division"))
 		b.WriteString(row("/dept <id>", "Show division status"))
-		b.WriteString(row("/dept <id> transition X", "Move to phase"))
+		b.WriteString(row("/dept dashboard", "Summarize divisions across ventures"))
+		b.WriteString(row("/dept tree", "Show the venture's divisions as a tree"))
+		b.WriteString(row("/dept <id> transition X", "Move to phase (design, plan, ...)"))
 		b.WriteString(row("/dept <id> complete", "Complete current phase"))
 		b.WriteString("\n")
 
@@ -160,6 +325,7 @@ func (c *DepartmentCmd) showUsage(ctx *Context) tea.Cmd {
 		b.WriteString(row("/dept <id> design start", "Begin design"))
 		b.WriteString(row("/dept <id> dossier <name>", "Define aggregate/entity"))
 		b.WriteString(row("/dept <id> desk <n> <t> <did>", "Add desk (vertical slice)"))
+		b.WriteString(row("/dept <id> desks", "List desks and implementation status"))
 		b.WriteString("\n")
 
 		// Plan phase
@@ -185,9 +351,12 @@ func (c *DepartmentCmd) showUsage(ctx *Context) tea.Cmd {
 		// Monitor phase
 		b.WriteString(section("Monitor & Rescue", "Observe and handle incidents"))
 		b.WriteString(row("/dept <id> monitor start", "Begin monitoring"))
-		b.WriteString(row("/dept <id> incident <desc>", "Report incident"))
+		b.WriteString(row("/dept <id> incident <desc>", "Report incident (--sev low|medium|high|critical)"))
 		b.WriteString(row("/dept <id> resolve <iid> <res>", "Resolve incident"))
 		b.WriteString(row("/dept <id> rescue start", "Begin rescue"))
+		b.WriteString("\n")
+
+		b.WriteString(s.Subtle.Render("Add --dry-run to any command to print the request without sending it."))
 
 		return InjectSystemMsg{Content: b.String()}
 	}
@@ -219,6 +388,9 @@ func (c *DepartmentCmd) initDepartment(args []string, ctx *Context) tea.Cmd {
 		}
 
 		path := "/api/ventures/" + ventureID + "/discovery/divisions/discover"
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to discover division: " + err.Error())}
@@ -276,6 +448,9 @@ func (c *DepartmentCmd) showDepartment(departmentID string, ctx *Context) tea.Cm
 		b.WriteString(s.Subtle.Render(formatTimestamp(dept.InitiatedAt)))
 		b.WriteString("\n\n")
 
+		b.WriteString(renderLifecycleProgress(ctx.Theme, s, *dept))
+		b.WriteString("\n\n")
+
 		// Phase-specific counters
 		b.WriteString(s.Bold.Render("  Counters"))
 		b.WriteString("\n")
@@ -316,6 +491,29 @@ func (c *DepartmentCmd) showDepartment(departmentID string, ctx *Context) tea.Cm
 	}
 }
 
+// progressBarWidth is the width of the bars on lifecycle status cards.
+const progressBarWidth = 24
+
+// renderLifecycleProgress draws phase and desk-implementation progress
+// bars for a division, colored by its current phase.
+func renderLifecycleProgress(t *theme.Theme, s *theme.Styles, d client.Department) string {
+	key := divisionPhaseKey(d)
+	color := phaseColor(t, key)
+	step, total := phaseStep(key)
+
+	var b strings.Builder
+	b.WriteString(s.CardLabel.Render("Lifecycle:   "))
+	b.WriteString(ui.ProgressBar(step, total, progressBarWidth, color, t.Border))
+	b.WriteString("\n")
+	b.WriteString(s.CardLabel.Render("Implemented: "))
+	if d.DeskCount == 0 {
+		b.WriteString(s.Subtle.Render("no desks planned"))
+	} else {
+		b.WriteString(ui.ProgressBar(d.ImplementedDeskCount, d.DeskCount, progressBarWidth, color, t.Border))
+	}
+	return b.String()
+}
+
 func (c *DepartmentCmd) phaseAction(departmentID, phase string, args []string, ctx *Context) tea.Cmd {
 	if len(args) == 0 || strings.ToLower(args[0]) != "start" {
 		return func() tea.Msg {
@@ -332,8 +530,12 @@ func (c *DepartmentCmd) phaseAction(departmentID, phase string, args []string, c
 			return requireVentureMsg(ctx)
 		}
 
+		body := map[string]interface{}{"started_by": client.Actor()}
 		path := divisionCmdPath(ventureID, departmentID, phase+"/start")
-		err := ctx.Client.DepartmentCommand(path, nil)
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
+		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to start " + phase + ": " + err.Error())}
 		}
@@ -367,6 +569,9 @@ func (c *DepartmentCmd) recordFinding(departmentID string, args []string, ctx *C
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "discovery/findings/record")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to record finding: " + err.Error())}
@@ -394,6 +599,9 @@ func (c *DepartmentCmd) defineTerm(departmentID string, args []string, ctx *Cont
 
 		body := map[string]interface{}{"term": term, "definition": definition}
 		path := divisionCmdPath(ventureID, departmentID, "discovery/terms/define")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to define term: " + err.Error())}
@@ -411,7 +619,12 @@ func (c *DepartmentCmd) transition(departmentID string, args []string, ctx *Cont
 		}
 	}
 
-	targetPhase := args[0]
+	targetPhase, err := normalizePhase(args[0])
+	if err != nil {
+		return func() tea.Msg {
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Cannot transition: " + err.Error())}
+		}
+	}
 
 	return func() tea.Msg {
 		s := ctx.Styles
@@ -420,8 +633,14 @@ func (c *DepartmentCmd) transition(departmentID string, args []string, ctx *Cont
 			return requireVentureMsg(ctx)
 		}
 
-		body := map[string]interface{}{"target_phase": targetPhase}
+		body := map[string]interface{}{
+			"target_phase":    targetPhase,
+			"transitioned_by": client.Actor(),
+		}
 		path := divisionCmdPath(ventureID, departmentID, "transition")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to transition: " + err.Error())}
@@ -456,6 +675,9 @@ func (c *DepartmentCmd) defineDossier(departmentID string, args []string, ctx *C
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "design/aggregates/design")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to define dossier: " + err.Error())}
@@ -498,6 +720,9 @@ func (c *DepartmentCmd) inventoryDesk(departmentID string, args []string, ctx *C
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "plan/desks/plan")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to plan desk: " + err.Error())}
@@ -524,6 +749,9 @@ func (c *DepartmentCmd) approvePlan(departmentID string, args []string, ctx *Con
 
 		body := map[string]interface{}{"plan_id": planID}
 		path := divisionCmdPath(ventureID, departmentID, "plan/complete")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to approve plan: " + err.Error())}
@@ -541,6 +769,9 @@ func (c *DepartmentCmd) createSkeleton(departmentID string, ctx *Context) tea.Cm
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "generation/modules/generate")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, nil)
+		}
 		err := ctx.Client.DepartmentCommand(path, nil)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to generate skeleton: " + err.Error())}
@@ -575,6 +806,9 @@ func (c *DepartmentCmd) implementDesk(departmentID string, args []string, ctx *C
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "testing/suites/run")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to implement desk: " + err.Error())}
@@ -606,6 +840,9 @@ func (c *DepartmentCmd) verifyBuild(departmentID string, args []string, ctx *Con
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "testing/results/record")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to verify build: " + err.Error())}
@@ -638,8 +875,12 @@ func (c *DepartmentCmd) deployAction(departmentID string, args []string, ctx *Co
 				return requireVentureMsg(ctx)
 			}
 
+			body := map[string]interface{}{"started_by": client.Actor()}
 			path := divisionCmdPath(ventureID, departmentID, "deployment/start")
-			err := ctx.Client.DepartmentCommand(path, nil)
+			if c.dryRun {
+				return dryRunMsg(ctx, path, body)
+			}
+			err := ctx.Client.DepartmentCommand(path, body)
 			if err != nil {
 				return InjectSystemMsg{Content: s.Error.Render("Failed to start deployment phase: " + err.Error())}
 			}
@@ -679,6 +920,9 @@ func (c *DepartmentCmd) deployAction(departmentID string, args []string, ctx *Co
 			}
 
 			path := divisionCmdPath(ventureID, departmentID, "deployment/releases/deploy")
+			if c.dryRun {
+				return dryRunMsg(ctx, path, body)
+			}
 			err := ctx.Client.DepartmentCommand(path, body)
 			if err != nil {
 				return InjectSystemMsg{Content: s.Error.Render("Failed to record deployment: " + err.Error())}
@@ -697,9 +941,15 @@ func (c *DepartmentCmd) deployAction(departmentID string, args []string, ctx *Co
 }
 
 func (c *DepartmentCmd) reportIncident(departmentID string, args []string, ctx *Context) tea.Cmd {
+	args, severity, err := extractSeverity(args)
+	if err != nil {
+		return func() tea.Msg {
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render(err.Error())}
+		}
+	}
 	if len(args) == 0 {
 		return func() tea.Msg {
-			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /dept <id> incident <description>")}
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /dept <id> incident [--sev low|medium|high|critical] <description>")}
 		}
 	}
 
@@ -712,13 +962,16 @@ func (c *DepartmentCmd) reportIncident(departmentID string, args []string, ctx *
 			return requireVentureMsg(ctx)
 		}
 
-		body := map[string]interface{}{"description": description}
+		body := map[string]interface{}{"description": description, "severity": severity}
 		path := divisionCmdPath(ventureID, departmentID, "monitoring/incidents/raise")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to report incident: " + err.Error())}
 		}
-		return InjectSystemMsg{Content: s.StatusWarning.Render("Incident reported for " + departmentID)}
+		return InjectSystemMsg{Content: s.StatusWarning.Render("Incident (" + severity + ") reported for " + departmentID)}
 	}
 }
 
@@ -745,6 +998,9 @@ func (c *DepartmentCmd) resolveIncident(departmentID string, args []string, ctx
 		}
 
 		path := divisionCmdPath(ventureID, departmentID, "rescue/diagnoses/diagnose")
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
 		err := ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to resolve incident: " + err.Error())}
@@ -767,29 +1023,18 @@ func (c *DepartmentCmd) completePhase(departmentID string, ctx *Context) tea.Cmd
 			return InjectSystemMsg{Content: s.Error.Render("Failed to get division: " + err.Error())}
 		}
 
-		// Map phase to endpoint path segment
-		var phasePath string
-		switch strings.ToLower(department.CurrentPhase) {
-		case "design":
-			phasePath = "design"
-		case "plan":
-			phasePath = "plan"
-		case "generation":
-			phasePath = "generation"
-		case "testing":
-			phasePath = "testing"
-		case "deployment":
-			phasePath = "deployment"
-		case "monitoring":
-			phasePath = "monitoring"
-		case "rescue":
-			phasePath = "rescue"
-		default:
+		// The phase name is also the endpoint path segment
+		phasePath := strings.ToLower(department.CurrentPhase)
+		if !isLifecyclePhase(phasePath) {
 			return InjectSystemMsg{Content: s.Error.Render("Cannot complete phase: " + department.CurrentPhase)}
 		}
 
+		body := map[string]interface{}{"completed_by": client.Actor()}
 		path := divisionCmdPath(ventureID, departmentID, phasePath+"/complete")
-		err = ctx.Client.DepartmentCommand(path, nil)
+		if c.dryRun {
+			return dryRunMsg(ctx, path, body)
+		}
+		err = ctx.Client.DepartmentCommand(path, body)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to complete phase: " + err.Error())}
 		}
@@ -799,28 +1044,11 @@ func (c *DepartmentCmd) completePhase(departmentID string, ctx *Context) tea.Cmd
 
 // formatDepartmentPhase returns a human-readable phase name.
 func formatDepartmentPhase(phase string) string {
-	switch strings.ToLower(phase) {
-	case "design":
-		return "Design"
-	case "plan":
-		return "Plan"
-	case "generation":
-		return "Generation"
-	case "testing":
-		return "Testing"
-	case "deployment":
-		return "Deployment"
-	case "monitoring":
-		return "Monitoring"
-	case "rescue":
-		return "Rescue"
-	case "initiated":
-		return "Initiated"
-	case "completed":
-		return "Completed"
-	default:
-		return phase
+	key := strings.ToLower(phase)
+	if isLifecyclePhase(key) || key == "initiated" || key == "completed" {
+		return strings.ToUpper(key[:1]) + key[1:]
 	}
+	return phase
 }
 
 // formatTimestamp converts a Unix timestamp (milliseconds) to a readable string.
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+)
+
+// dashboardTopDivisions caps the "most unimplemented desks" list.
+const dashboardTopDivisions = 3
+
+// ventureDivision is a division tagged with the venture it belongs to.
+type ventureDivision struct {
+	venture string
+	div     client.Department
+}
+
+// divisionBacklog is a division with desks still to implement.
+type divisionBacklog struct {
+	venture, name string
+	pending       int
+}
+
+// portfolioSummary aggregates divisions across ventures.
+type portfolioSummary struct {
+	divisions int
+	byPhase   map[string]int // canonical phase or "completed"
+	incidents int
+	backlog   []divisionBacklog // most pending desks first
+}
+
+// divisionPhaseKey buckets a division by lifecycle phase, treating any
+// division with a completion time as completed.
+func divisionPhaseKey(d client.Department) string {
+	if d.CompletedAt > 0 {
+		return "completed"
+	}
+	phase := strings.ToLower(d.CurrentPhase)
+	if canonical, err := normalizePhase(phase); err == nil {
+		return canonical
+	}
+	return phase
+}
+
+// summarizeDivisions counts divisions per phase, totals active incidents
+// and ranks divisions by unimplemented desks.
+func summarizeDivisions(divs []ventureDivision) portfolioSummary {
+	sum := portfolioSummary{divisions: len(divs), byPhase: make(map[string]int)}
+	for _, vd := range divs {
+		sum.byPhase[divisionPhaseKey(vd.div)]++
+		sum.incidents += vd.div.ActiveIncidents
+		if pending := vd.div.DeskCount - vd.div.ImplementedDeskCount; pending > 0 {
+			sum.backlog = append(sum.backlog, divisionBacklog{venture: vd.venture, name: vd.div.Name, pending: pending})
+		}
+	}
+	sort.SliceStable(sum.backlog, func(i, j int) bool { return sum.backlog[i].pending > sum.backlog[j].pending })
+	if len(sum.backlog) > dashboardTopDivisions {
+		sum.backlog = sum.backlog[:dashboardTopDivisions]
+	}
+	return sum
+}
+
+// dashboard fetches every division of every venture and renders a
+// portfolio summary card.
+func (c *DepartmentCmd) dashboard(ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		ventures, err := ctx.Client.ListVentures()
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to list ventures: " + err.Error())}
+		}
+		if len(ventures) == 0 {
+			return InjectSystemMsg{Content: s.Subtle.Render("No ventures found. Use /venture init <name> to create one.")}
+		}
+
+		var divs []ventureDivision
+		var failed []string
+		for _, v := range ventures {
+			list, err := ctx.Client.ListDepartments(v.VentureID)
+			if err != nil {
+				failed = append(failed, v.Name)
+				continue
+			}
+			for _, d := range list {
+				divs = append(divs, ventureDivision{venture: v.Name, div: d})
+			}
+		}
+		sum := summarizeDivisions(divs)
+
+		var b strings.Builder
+		b.WriteString(s.CardTitle.Render("Lifecycle Dashboard"))
+		b.WriteString("\n\n")
+		b.WriteString(s.CardLabel.Render("Ventures: "))
+		b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", len(ventures))))
+		b.WriteString("  ")
+		b.WriteString(s.CardLabel.Render("Divisions: "))
+		b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", sum.divisions)))
+		b.WriteString("  ")
+		b.WriteString(s.CardLabel.Render("Incidents: "))
+		if sum.incidents > 0 {
+			b.WriteString(s.StatusError.Render(fmt.Sprintf("%d active", sum.incidents)))
+		} else {
+			b.WriteString(s.StatusOK.Render("none"))
+		}
+		b.WriteString("\n\n")
+
+		b.WriteString(s.Bold.Render("  By Phase"))
+		b.WriteString("\n")
+		for _, phase := range append(append([]string(nil), lifecyclePhases...), "completed") {
+			n := sum.byPhase[phase]
+			label := fmt.Sprintf("%-12s", formatDepartmentPhase(phase))
+			if n == 0 {
+				b.WriteString(s.Subtle.Render("  " + label + "0"))
+			} else {
+				b.WriteString(s.CardLabel.Render("  " + label))
+				b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", n)))
+			}
+			b.WriteString("\n")
+		}
+
+		if len(sum.backlog) > 0 {
+			b.WriteString("\n")
+			b.WriteString(s.Bold.Render("  Most Unimplemented Desks"))
+			b.WriteString("\n")
+			for _, d := range sum.backlog {
+				b.WriteString(s.CardValue.Render("  " + d.name))
+				b.WriteString(s.Subtle.Render(fmt.Sprintf(" (%s)  %d pending", d.venture, d.pending)))
+				b.WriteString("\n")
+			}
+		}
+
+		if len(failed) > 0 {
+			b.WriteString("\n")
+			b.WriteString(s.Error.Render("Could not load divisions for: " + strings.Join(failed, ", ")))
+		}
+
+		return InjectSystemMsg{Content: strings.TrimRight(b.String(), "\n")}
+	}
+}
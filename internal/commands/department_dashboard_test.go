@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/client"
+)
+
+func TestDivisionPhaseKey(t *testing.T) {
+	tests := []struct {
+		div  client.Department
+		want string
+	}{
+		{client.Department{CurrentPhase: "design"}, "design"},
+		{client.Department{CurrentPhase: "dna"}, "design"},
+		{client.Department{CurrentPhase: "Testing"}, "testing"},
+		{client.Department{CurrentPhase: "monitoring", CompletedAt: 1}, "completed"},
+		{client.Department{CurrentPhase: "initiated"}, "initiated"},
+	}
+	for _, tt := range tests {
+		if got := divisionPhaseKey(tt.div); got != tt.want {
+			t.Errorf("divisionPhaseKey(%+v) = %q, want %q", tt.div, got, tt.want)
+		}
+	}
+}
+
+func TestSummarizeDivisions(t *testing.T) {
+	divs := []ventureDivision{
+		{"alpha", client.Department{Name: "billing", CurrentPhase: "design", DeskCount: 4, ImplementedDeskCount: 1}},
+		{"alpha", client.Department{Name: "auth", CurrentPhase: "testing", DeskCount: 6, ImplementedDeskCount: 1, ActiveIncidents: 2}},
+		{"beta", client.Department{Name: "search", CurrentPhase: "testing", DeskCount: 2, ImplementedDeskCount: 2}},
+		{"beta", client.Department{Name: "feeds", CurrentPhase: "plan", DeskCount: 3}},
+		{"beta", client.Department{Name: "mail", CompletedAt: 1, DeskCount: 2, ImplementedDeskCount: 1, ActiveIncidents: 1}},
+	}
+
+	sum := summarizeDivisions(divs)
+	if sum.divisions != 5 {
+		t.Errorf("divisions = %d, want 5", sum.divisions)
+	}
+	if sum.incidents != 3 {
+		t.Errorf("incidents = %d, want 3", sum.incidents)
+	}
+	wantPhases := map[string]int{"design": 1, "testing": 2, "plan": 1, "completed": 1}
+	for phase, n := range wantPhases {
+		if sum.byPhase[phase] != n {
+			t.Errorf("byPhase[%q] = %d, want %d", phase, sum.byPhase[phase], n)
+		}
+	}
+	wantBacklog := []string{"auth", "billing", "feeds"}
+	if len(sum.backlog) != len(wantBacklog) {
+		t.Fatalf("backlog = %+v, want %v", sum.backlog, wantBacklog)
+	}
+	for i, name := range wantBacklog {
+		if sum.backlog[i].name != name {
+			t.Errorf("backlog[%d] = %q, want %q", i, sum.backlog[i].name, name)
+		}
+	}
+}
+
+func TestDepartmentComplete(t *testing.T) {
+	c := &DepartmentCmd{}
+	ctx := &Context{}
+
+	got := c.Complete(nil, ctx)
+	if len(got) != 3 || got[0] != "init" || got[1] != "dashboard" || got[2] != "tree" {
+		t.Errorf("Complete(nil) = %v, want [init dashboard tree]", got)
+	}
+	if got := c.Complete([]string{"da"}, ctx); len(got) != 1 || got[0] != "dashboard" {
+		t.Errorf("Complete(da) = %v, want [dashboard]", got)
+	}
+	got = c.Complete([]string{"div-1", "de"}, ctx)
+	want := []string{"design", "desk", "desks", "deploy"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Complete(div-1 de) = %v, want %v", got, want)
+	}
+	if got := c.Complete([]string{"--dry-run", "div-1", "app"}, ctx); len(got) != 1 || got[0] != "approve" {
+		t.Errorf("Complete with --dry-run = %v, want [approve]", got)
+	}
+	if got := c.Complete([]string{"init", "x"}, ctx); got != nil {
+		t.Errorf("Complete(init x) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// listDesks shows a division's desks with their IDs and whether each has
+// been implemented, so IDs can be copied into implement/verify.
+func (c *DepartmentCmd) listDesks(departmentID string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		ventureID := ventureIDFromContext(ctx)
+		if ventureID == "" {
+			return requireVentureMsg(ctx)
+		}
+
+		desks, err := ctx.Client.ListDepartmentDesks(ventureID, departmentID)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to list desks: " + err.Error())}
+		}
+		if len(desks) == 0 {
+			return InjectSystemMsg{Content: s.Subtle.Render("No desks yet. Use /dept " + departmentID + " desk <name> <type> <dossier_id> to plan one.")}
+		}
+
+		impls, err := ctx.Client.ListDepartmentImplementations(ventureID, departmentID)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to list implementations: " + err.Error())}
+		}
+
+		return InjectSystemMsg{Content: renderDeskList(s, departmentID, desks, impls)}
+	}
+}
+
+// renderDeskList renders one row per desk, marking those with an
+// implementation record as implemented.
+func renderDeskList(s *theme.Styles, departmentID string, desks []client.DepartmentDesk, impls []client.DepartmentImplementation) string {
+	implemented := make(map[string]bool, len(impls))
+	for _, impl := range impls {
+		implemented[impl.DeskID] = true
+	}
+
+	done := 0
+	for _, d := range desks {
+		if implemented[d.DeskID] {
+			done++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Desks: " + departmentID))
+	b.WriteString("\n")
+	b.WriteString(s.Subtle.Render(fmt.Sprintf("%d/%d implemented", done, len(desks))))
+	b.WriteString("\n\n")
+
+	for _, d := range desks {
+		if implemented[d.DeskID] {
+			b.WriteString(s.StatusOK.Render("  ✓ "))
+		} else {
+			b.WriteString(s.Subtle.Render("  ○ "))
+		}
+		b.WriteString(s.CardValue.Render(d.DeskName))
+		if d.DeskType != "" {
+			b.WriteString(s.Subtle.Render(" (" + d.DeskType + ")"))
+		}
+		b.WriteString("\n")
+
+		b.WriteString(s.CardLabel.Render("    ID: "))
+		b.WriteString(s.CardValue.Render(d.DeskID))
+		if d.DossierID != "" {
+			b.WriteString(s.CardLabel.Render("  Dossier: "))
+			b.WriteString(s.CardValue.Render(d.DossierID))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(s.Subtle.Render("Implement with /dept " + departmentID + " implement <desk_id> [notes]"))
+	return b.String()
+}
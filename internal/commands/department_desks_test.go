@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestRenderDeskList(t *testing.T) {
+	s := theme.HecateDark().ComputeStyles()
+	desks := []client.DepartmentDesk{
+		{DeskID: "desk-1", DeskName: "register_user", DeskType: "cmd", DossierID: "dos-1"},
+		{DeskID: "desk-2", DeskName: "user_registered", DeskType: "evt", DossierID: "dos-1"},
+	}
+	impls := []client.DepartmentImplementation{{DeskID: "desk-2"}}
+
+	out := renderDeskList(s, "div-1", desks, impls)
+	if !strings.Contains(out, "1/2 implemented") {
+		t.Errorf("missing progress summary:\n%s", out)
+	}
+	for _, want := range []string{"○ register_user", "✓ user_registered", "ID: desk-1", "Dossier: dos-1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtractSeverity(t *testing.T) {
+	tests := []struct {
+		args     []string
+		rest     string
+		severity string
+		wantErr  bool
+	}{
+		{[]string{"db", "down"}, "db down", "medium", false},
+		{[]string{"--sev", "high", "db", "down"}, "db down", "high", false},
+		{[]string{"db", "down", "--severity=CRITICAL"}, "db down", "critical", false},
+		{[]string{"--sev", "urgent", "db"}, "", "", true},
+		{[]string{"db", "--sev"}, "", "", true},
+	}
+	for _, tt := range tests {
+		rest, sev, err := extractSeverity(tt.args)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("extractSeverity(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if got := strings.Join(rest, " "); got != tt.rest || sev != tt.severity {
+			t.Errorf("extractSeverity(%v) = %q, %q; want %q, %q", tt.args, got, sev, tt.rest, tt.severity)
+		}
+	}
+}
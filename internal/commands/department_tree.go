@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// Box-drawing connectors for the division tree.
+const (
+	treeBranch = "├── "
+	treeLast   = "└── "
+	treePipe   = "│   "
+	treeGap    = "    "
+)
+
+// tree renders the active venture and its divisions as a tree.
+func (c *DepartmentCmd) tree(ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		if ctx.GetALCContext == nil {
+			return requireVentureMsg(ctx)
+		}
+		state := ctx.GetALCContext()
+		if state == nil || state.Venture == nil {
+			return requireVentureMsg(ctx)
+		}
+
+		divs, err := ctx.Client.ListDepartments(state.Venture.ID)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to list divisions: " + err.Error())}
+		}
+
+		name := state.Venture.Name
+		if name == "" {
+			name = state.Venture.ID
+		}
+		return InjectSystemMsg{Content: renderDivisionTree(s, name, divs)}
+	}
+}
+
+// renderDivisionTree draws a venture root with one branch per division,
+// each showing a phase badge and its key counters.
+func renderDivisionTree(s *theme.Styles, venture string, divs []client.Department) string {
+	sorted := append([]client.Department(nil), divs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("◆ " + venture))
+	b.WriteString("\n")
+
+	if len(sorted) == 0 {
+		b.WriteString(s.Subtle.Render(treeLast + "no divisions yet (/dept init <name>)"))
+		return b.String()
+	}
+
+	for i, d := range sorted {
+		connector, indent := treeBranch, treePipe
+		if i == len(sorted)-1 {
+			connector, indent = treeLast, treeGap
+		}
+
+		b.WriteString(s.Subtle.Render(connector))
+		b.WriteString(s.CardValue.Render(d.Name))
+		b.WriteString(" ")
+		b.WriteString(divisionPhaseBadge(s, d))
+		b.WriteString(s.Subtle.Render("  " + d.DepartmentID))
+		b.WriteString("\n")
+
+		b.WriteString(s.Subtle.Render(indent))
+		b.WriteString(s.CardLabel.Render("findings "))
+		b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", d.FindingCount)))
+		b.WriteString(s.Subtle.Render(" · "))
+		b.WriteString(s.CardLabel.Render("desks "))
+		b.WriteString(s.CardValue.Render(fmt.Sprintf("%d/%d", d.ImplementedDeskCount, d.DeskCount)))
+		b.WriteString(s.Subtle.Render(" · "))
+		b.WriteString(s.CardLabel.Render("incidents "))
+		if d.ActiveIncidents > 0 {
+			b.WriteString(s.StatusError.Render(fmt.Sprintf("%d", d.ActiveIncidents)))
+		} else {
+			b.WriteString(s.CardValue.Render("0"))
+		}
+		if i < len(sorted)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// divisionPhaseBadge renders a division's phase as a bracketed badge.
+func divisionPhaseBadge(s *theme.Styles, d client.Department) string {
+	key := divisionPhaseKey(d)
+	badge := "[" + formatDepartmentPhase(key) + "]"
+	switch key {
+	case "completed":
+		return s.StatusOK.Render(badge)
+	case "rescue":
+		return s.StatusError.Render(badge)
+	default:
+		return s.Bold.Render(badge)
+	}
+}
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestRenderDivisionTree(t *testing.T) {
+	s := theme.HecateDark().ComputeStyles()
+	divs := []client.Department{
+		{DepartmentID: "div-2", Name: "search", CurrentPhase: "testing", FindingCount: 5, DeskCount: 4, ImplementedDeskCount: 3, ActiveIncidents: 1},
+		{DepartmentID: "div-1", Name: "auth", CurrentPhase: "design", FindingCount: 2},
+	}
+
+	out := renderDivisionTree(s, "shop", divs)
+	lines := strings.Split(out, "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want 5:\n%s", len(lines), out)
+	}
+	if !strings.Contains(lines[0], "shop") {
+		t.Errorf("root = %q, want venture name", lines[0])
+	}
+	if !strings.Contains(lines[1], treeBranch+"auth") || !strings.Contains(lines[1], "[Design]") {
+		t.Errorf("first branch = %q, want auth with Design badge", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], treePipe) {
+		t.Errorf("counters under non-last branch = %q, want pipe indent", lines[2])
+	}
+	if !strings.Contains(lines[3], treeLast+"search") || !strings.Contains(lines[3], "[Testing]") {
+		t.Errorf("last branch = %q, want search with Testing badge", lines[3])
+	}
+	if !strings.Contains(lines[4], "desks 3/4") || !strings.Contains(lines[4], "incidents 1") {
+		t.Errorf("counters = %q, want desks 3/4 and incidents 1", lines[4])
+	}
+
+	if empty := renderDivisionTree(s, "shop", nil); !strings.Contains(empty, "no divisions") {
+		t.Errorf("empty tree = %q, want placeholder", empty)
+	}
+}
@@ -0,0 +1,202 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/config"
+	"github.com/hecate-social/hecate-tui/internal/textdiff"
+)
+
+// diffSideBySideWidth is the narrowest terminal that gets two columns;
+// narrower ones get a unified diff.
+const diffSideBySideWidth = 100
+
+// DiffCmd compares the assistant responses of two saved conversations.
+type DiffCmd struct{}
+
+func (c *DiffCmd) Name() string      { return "diff" }
+func (c *DiffCmd) Aliases() []string { return nil }
+func (c *DiffCmd) Description() string {
+	return "Compare two saved conversations (/diff <id|n> <id|n>)"
+}
+
+func (c *DiffCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		if len(args) != 2 {
+			return InjectSystemMsg{Content: s.Error.Render("Usage: /diff <id|number> <id|number>") +
+				"\n" + s.Subtle.Render("Use /history to see available conversations.")}
+		}
+
+		a, err := resolveConversation(args[0])
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render(err.Error())}
+		}
+		b, err := resolveConversation(args[1])
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render(err.Error())}
+		}
+
+		return InjectSystemMsg{Content: renderConversationDiff(ctx, a, b)}
+	}
+}
+
+// resolveConversation loads a conversation by ID or /history number.
+func resolveConversation(target string) (config.Conversation, error) {
+	if n := parseIndex(target); n > 0 {
+		convs := config.ListConversations()
+		if n > len(convs) {
+			return config.Conversation{}, fmt.Errorf("conversation #%d not found", n)
+		}
+		return convs[n-1], nil
+	}
+	return config.LoadConversation(target)
+}
+
+// convTurn is one assistant response and the prompt it answered.
+type convTurn struct {
+	prompt, response string
+}
+
+// conversationTurns splits a conversation into turns, one per assistant
+// response.
+func conversationTurns(conv config.Conversation) []convTurn {
+	var turns []convTurn
+	prompt := ""
+	for _, m := range conv.Messages {
+		switch m.Role {
+		case "user":
+			prompt = m.Content
+		case "assistant":
+			turns = append(turns, convTurn{prompt: prompt, response: m.Content})
+		}
+	}
+	return turns
+}
+
+func renderConversationDiff(ctx *Context, a, b config.Conversation) string {
+	s := ctx.Styles
+	ta, tb := conversationTurns(a), conversationTurns(b)
+
+	var out strings.Builder
+	out.WriteString(s.CardTitle.Render("Diff"))
+	out.WriteString("\n")
+	out.WriteString(s.StatusError.Render("- " + a.Title))
+	out.WriteString(s.Subtle.Render("  " + a.ID))
+	out.WriteString("\n")
+	out.WriteString(s.StatusOK.Render("+ " + b.Title))
+	out.WriteString(s.Subtle.Render("  " + b.ID))
+	out.WriteString("\n")
+
+	sideBySide := ctx.Width >= diffSideBySideWidth
+	n := len(ta)
+	if len(tb) > n {
+		n = len(tb)
+	}
+	identical := 0
+	for i := 0; i < n; i++ {
+		out.WriteString("\n")
+		switch {
+		case i >= len(ta):
+			out.WriteString(s.Bold.Render(fmt.Sprintf("Turn %d", i+1)))
+			out.WriteString(s.Subtle.Render("  only in +"))
+			out.WriteString("\n")
+			continue
+		case i >= len(tb):
+			out.WriteString(s.Bold.Render(fmt.Sprintf("Turn %d", i+1)))
+			out.WriteString(s.Subtle.Render("  only in -"))
+			out.WriteString("\n")
+			continue
+		}
+
+		out.WriteString(s.Bold.Render(fmt.Sprintf("Turn %d", i+1)))
+		out.WriteString(s.Subtle.Render("  " + truncateLine(ta[i].prompt, 60)))
+		if ta[i].prompt != tb[i].prompt {
+			out.WriteString(s.StatusWarning.Render("  (prompts differ)"))
+		}
+		out.WriteString("\n")
+
+		if ta[i].response == tb[i].response {
+			identical++
+			out.WriteString(s.Subtle.Render("  identical"))
+			out.WriteString("\n")
+			continue
+		}
+		lines := textdiff.Lines(strings.Split(ta[i].response, "\n"), strings.Split(tb[i].response, "\n"))
+		if sideBySide {
+			out.WriteString(renderSideBySide(ctx, lines))
+		} else {
+			out.WriteString(renderUnified(ctx, lines))
+		}
+	}
+
+	out.WriteString("\n")
+	out.WriteString(s.Subtle.Render(fmt.Sprintf("%d turn(s) compared, %d identical", n, identical)))
+	return out.String()
+}
+
+func renderUnified(ctx *Context, lines []textdiff.Line) string {
+	s := ctx.Styles
+	var b strings.Builder
+	for _, l := range lines {
+		switch l.Op {
+		case textdiff.Delete:
+			b.WriteString(s.StatusError.Render("- " + l.Text))
+		case textdiff.Insert:
+			b.WriteString(s.StatusOK.Render("+ " + l.Text))
+		default:
+			b.WriteString(s.Subtle.Render("  " + l.Text))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func renderSideBySide(ctx *Context, lines []textdiff.Line) string {
+	s := ctx.Styles
+	col := (ctx.Width - 7) / 2 // two gutters and a divider
+	var b strings.Builder
+	for _, r := range textdiff.SideBySide(lines) {
+		left := padRunes(truncateLine(r.Left, col), col)
+		right := truncateLine(r.Right, col)
+		switch {
+		case !r.Changed:
+			b.WriteString(s.Subtle.Render("  " + left + " │ " + right))
+		default:
+			if r.HasLeft {
+				b.WriteString(s.StatusError.Render("- " + left))
+			} else {
+				b.WriteString("  " + left)
+			}
+			b.WriteString(s.Subtle.Render(" │ "))
+			if r.HasRight {
+				b.WriteString(s.StatusOK.Render("+ " + right))
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// truncateLine cuts s to at most width runes, marking the cut.
+func truncateLine(s string, width int) string {
+	s = strings.ReplaceAll(s, "\t", "    ")
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width < 1 {
+		return ""
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
+
+// padRunes right-pads s with spaces to width runes.
+func padRunes(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
@@ -11,20 +11,26 @@ type EditCmd struct{}
 
 func (c *EditCmd) Name() string        { return "edit" }
 func (c *EditCmd) Aliases() []string   { return []string{"e"} }
-func (c *EditCmd) Description() string { return "Open built-in editor (/edit [file])" }
+func (c *EditCmd) Description() string { return "Open built-in editor (/edit [file|--new])" }
 
 // EditFileMsg tells the app to open a file in the editor.
 type EditFileMsg struct {
 	Path string // empty = scratch buffer
+	Last bool   // reopen the last edited file, if any
 }
 
 func (c *EditCmd) Execute(args []string, ctx *Context) tea.Cmd {
-	path := ""
-	if len(args) > 0 {
-		path = strings.Join(args, " ")
+	var msg EditFileMsg
+	switch {
+	case len(args) == 0:
+		msg.Last = true
+	case len(args) == 1 && (args[0] == "--new" || args[0] == "-n"):
+		// scratch buffer
+	default:
+		msg.Path = strings.Join(args, " ")
 	}
 
 	return func() tea.Msg {
-		return EditFileMsg{Path: path}
+		return msg
 	}
 }
@@ -0,0 +1,252 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/editor"
+	"github.com/hecate-social/hecate-tui/internal/secret"
+)
+
+// Export formats supported by /save.
+const (
+	FormatMarkdown = "md"
+	FormatJSON     = "json"
+	FormatHTML     = "html"
+)
+
+// exporters maps each format to its transcript renderer.
+var exporters = map[string]func([]ChatExportMsg, time.Time) ([]byte, error){
+	FormatMarkdown: exportMarkdown,
+	FormatJSON:     exportJSON,
+	FormatHTML:     exportHTML,
+}
+
+// exportFormat resolves the export format from an explicit flag or the
+// filename extension, defaulting to markdown.
+func exportFormat(flag, filename string) (string, error) {
+	if flag != "" {
+		f := normalizeFormat(flag)
+		if _, ok := exporters[f]; !ok {
+			return "", fmt.Errorf("unknown format %q (use md, json or html)", flag)
+		}
+		return f, nil
+	}
+	ext := strings.TrimPrefix(filepath.Ext(filename), ".")
+	if f := normalizeFormat(ext); f != "" {
+		if _, ok := exporters[f]; ok {
+			return f, nil
+		}
+	}
+	return FormatMarkdown, nil
+}
+
+// ansiEscape matches terminal escape sequences left in rendered system
+// messages.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)`)
+
+// redactExport prepares messages for writing to disk: API keys are masked
+// in every message, and system messages keep only their first plain-text
+// line, since they carry rendered command output such as /config --reveal.
+func redactExport(messages []ChatExportMsg) []ChatExportMsg {
+	out := make([]ChatExportMsg, len(messages))
+	for i, msg := range messages {
+		if msg.Role == "system" {
+			msg.Content = firstLine(ansiEscape.ReplaceAllString(msg.Content, ""))
+		}
+		msg.Content = secret.Redact(msg.Content)
+		out[i] = msg
+	}
+	return out
+}
+
+func normalizeFormat(f string) string {
+	switch f = strings.ToLower(f); f {
+	case "markdown":
+		return FormatMarkdown
+	case "htm":
+		return FormatHTML
+	}
+	return f
+}
+
+// exportMarkdown renders the transcript as a markdown document.
+func exportMarkdown(messages []ChatExportMsg, now time.Time) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("# Hecate Chat Transcript\n")
+	fmt.Fprintf(&b, "*Exported: %s*\n\n", now.Format("2006-01-02 15:04:05"))
+	b.WriteString("---\n\n")
+
+	for _, msg := range messages {
+		timestamp := ""
+		if msg.Time != "" {
+			timestamp = " (" + msg.Time + ")"
+		}
+
+		switch msg.Role {
+		case "user":
+			b.WriteString("### You" + timestamp + "\n\n")
+			b.WriteString(msg.Content + "\n\n")
+		case "assistant":
+			b.WriteString("### Hecate" + timestamp + "\n\n")
+			b.WriteString(msg.Content + "\n\n")
+		case "system":
+			b.WriteString("---\n\n")
+			b.WriteString("*System: " + firstLine(msg.Content) + "*\n\n")
+		}
+	}
+
+	b.WriteString("---\n*End of transcript*\n")
+	return []byte(b.String()), nil
+}
+
+// transcriptJSON is the on-disk shape of a JSON export.
+type transcriptJSON struct {
+	Exported string          `json:"exported"`
+	Messages []ChatExportMsg `json:"messages"`
+}
+
+// exportJSON renders the transcript as structured JSON.
+func exportJSON(messages []ChatExportMsg, now time.Time) ([]byte, error) {
+	if messages == nil {
+		messages = []ChatExportMsg{}
+	}
+	data, err := json.MarshalIndent(transcriptJSON{
+		Exported: now.Format(time.RFC3339),
+		Messages: messages,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// parseExportJSON reads back a transcript written by exportJSON.
+func parseExportJSON(data []byte) ([]ChatExportMsg, error) {
+	var t transcriptJSON
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	return t.Messages, nil
+}
+
+const htmlStyle = `body{font-family:system-ui,sans-serif;max-width:52rem;margin:2rem auto;padding:0 1rem;background:#1a1b26;color:#c0caf5;line-height:1.5}
+h1{color:#bb9af7}.meta{color:#565f89;font-size:.9em}
+.msg{margin:1.5rem 0;padding:.75rem 1rem;border-radius:6px;background:#24283b}
+.msg.user{border-left:3px solid #7aa2f7}.msg.assistant{border-left:3px solid #bb9af7}
+.msg.system{background:none;color:#565f89;font-style:italic;padding:.25rem 1rem}
+.role{font-weight:bold;margin-bottom:.5rem}.role .time{font-weight:normal;color:#565f89;font-size:.85em}
+pre{background:#16161e;padding:.75rem;border-radius:4px;overflow-x:auto}
+.text{white-space:pre-wrap}
+.kw{color:#bb9af7}.str{color:#9ece6a}.num{color:#ff9e64}.com{color:#565f89;font-style:italic}`
+
+// exportHTML renders the transcript as a standalone styled HTML page with
+// highlighted code blocks.
+func exportHTML(messages []ChatExportMsg, now time.Time) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>Hecate Chat Transcript</title>\n")
+	b.WriteString("<style>\n" + htmlStyle + "\n</style>\n</head>\n<body>\n")
+	b.WriteString("<h1>Hecate Chat Transcript</h1>\n")
+	fmt.Fprintf(&b, "<p class=\"meta\">Exported: %s</p>\n", now.Format("2006-01-02 15:04:05"))
+
+	for _, msg := range messages {
+		timestamp := ""
+		if msg.Time != "" {
+			timestamp = " <span class=\"time\">" + html.EscapeString(msg.Time) + "</span>"
+		}
+
+		switch msg.Role {
+		case "user", "assistant":
+			name := "You"
+			if msg.Role == "assistant" {
+				name = "Hecate"
+			}
+			fmt.Fprintf(&b, "<div class=\"msg %s\">\n<div class=\"role\">%s%s</div>\n", msg.Role, name, timestamp)
+			b.WriteString(renderHTMLContent(msg.Content))
+			b.WriteString("</div>\n")
+		case "system":
+			fmt.Fprintf(&b, "<div class=\"msg system\">System: %s</div>\n", html.EscapeString(firstLine(msg.Content)))
+		}
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return []byte(b.String()), nil
+}
+
+// renderHTMLContent escapes message text and turns fenced code blocks into
+// highlighted <pre> blocks.
+func renderHTMLContent(content string) string {
+	var b strings.Builder
+	var text, code []string
+	lang := ""
+	inCode := false
+
+	flushText := func() {
+		if len(text) > 0 {
+			b.WriteString("<div class=\"text\">" + html.EscapeString(strings.Join(text, "\n")) + "</div>\n")
+			text = nil
+		}
+	}
+	flushCode := func() {
+		b.WriteString(highlightHTML(code, lang))
+		code = nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			if inCode {
+				flushCode()
+			} else {
+				flushText()
+				lang = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "```"))
+			}
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			code = append(code, line)
+		} else {
+			text = append(text, line)
+		}
+	}
+	if inCode {
+		flushCode()
+	}
+	flushText()
+	return b.String()
+}
+
+// tokenClasses maps highlighter token kinds to CSS classes.
+var tokenClasses = map[editor.TokenKind]string{
+	editor.TokenKeyword: "kw",
+	editor.TokenString:  "str",
+	editor.TokenNumber:  "num",
+	editor.TokenComment: "com",
+}
+
+func highlightHTML(lines []string, lang string) string {
+	h := editor.NewHighlighter(editor.LanguageFromName(lang))
+	var b strings.Builder
+	b.WriteString("<pre><code>")
+	for i, line := range lines {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		for _, tok := range h.Tokens(line) {
+			text := html.EscapeString(tok.Text)
+			if class, ok := tokenClasses[tok.Kind]; ok {
+				fmt.Fprintf(&b, "<span class=\"%s\">%s</span>", class, text)
+			} else {
+				b.WriteString(text)
+			}
+		}
+	}
+	b.WriteString("</code></pre>\n")
+	return b.String()
+}
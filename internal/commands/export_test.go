@@ -0,0 +1,115 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []ChatExportMsg
+	}{
+		{"empty", []ChatExportMsg{}},
+		{"conversation", []ChatExportMsg{
+			{Role: "user", Content: "hello", Time: "10:00"},
+			{Role: "assistant", Content: "hi\n```go\nfunc main() {}\n```", Time: "10:01"},
+			{Role: "system", Content: "Model switched"},
+		}},
+		{"special characters", []ChatExportMsg{
+			{Role: "user", Content: "quotes \" <tags> & unicode ✓\ttab"},
+		}},
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := exportJSON(tt.messages, now)
+			if err != nil {
+				t.Fatalf("exportJSON: %v", err)
+			}
+			got, err := parseExportJSON(data)
+			if err != nil {
+				t.Fatalf("parseExportJSON: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.messages) {
+				t.Errorf("round trip = %#v, want %#v", got, tt.messages)
+			}
+		})
+	}
+}
+
+func TestExportFormat(t *testing.T) {
+	tests := []struct {
+		flag, filename, want string
+		wantErr              bool
+	}{
+		{"", "", FormatMarkdown, false},
+		{"", "chat.json", FormatJSON, false},
+		{"", "chat.HTML", FormatHTML, false},
+		{"", "chat.txt", FormatMarkdown, false},
+		{"json", "chat.md", FormatJSON, false},
+		{"markdown", "", FormatMarkdown, false},
+		{"pdf", "", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := exportFormat(tt.flag, tt.filename)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("exportFormat(%q, %q) err = %v", tt.flag, tt.filename, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("exportFormat(%q, %q) = %q, want %q", tt.flag, tt.filename, got, tt.want)
+		}
+	}
+}
+
+func TestExportHTMLEscapesAndHighlights(t *testing.T) {
+	out, err := exportHTML([]ChatExportMsg{
+		{Role: "user", Content: "<script>alert(1)</script>"},
+		{Role: "assistant", Content: "```go\nreturn \"x\"\n```"},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := string(out)
+	if strings.Contains(page, "<script>") {
+		t.Error("message content was not escaped")
+	}
+	if !strings.Contains(page, `<span class="kw">return</span>`) {
+		t.Error("code block keyword was not highlighted")
+	}
+}
+
+func TestRedactExport(t *testing.T) {
+	key := "sk-ant-REDACTED"
+	messages := []ChatExportMsg{
+		{Role: "user", Content: "my key is " + key},
+		{Role: "system", Content: "\x1b[1mConfiguration\x1b[0m\nAnthropic key: " + key},
+	}
+	got := redactExport(messages)
+
+	if strings.Contains(got[0].Content, key) {
+		t.Errorf("user message kept the key: %q", got[0].Content)
+	}
+	if got[1].Content != "Configuration" {
+		t.Errorf("system message = %q, want its plain first line", got[1].Content)
+	}
+	if messages[0].Content != "my key is "+key {
+		t.Error("redactExport modified its input")
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for format, export := range exporters {
+		data, err := export(got, now)
+		if err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		if strings.Contains(string(data), key) || strings.Contains(string(data), "\x1b") {
+			t.Errorf("%s export leaks the key or escapes:\n%s", format, data)
+		}
+	}
+}
@@ -4,21 +4,30 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/config"
 )
 
-// FindCmd searches through current chat messages.
+// FindCmd searches through current chat messages, or saved
+// conversations with --all.
 type FindCmd struct{}
 
 func (c *FindCmd) Name() string        { return "find" }
 func (c *FindCmd) Aliases() []string   { return []string{"search", "f"} }
-func (c *FindCmd) Description() string { return "Search chat messages (/find <term>)" }
+func (c *FindCmd) Description() string { return "Search chat messages (/find [--all] <term>)" }
 
 func (c *FindCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	all := len(args) > 0 && (args[0] == "--all" || args[0] == "-a")
+	if all {
+		args = args[1:]
+	}
 	if len(args) == 0 {
 		return func() tea.Msg {
-			return InjectSystemMsg{Content: "Usage: /find <search term>"}
+			return InjectSystemMsg{Content: "Usage: /find [--all] <search term>"}
 		}
 	}
+	if all {
+		return c.findSaved(strings.Join(args, " "), ctx)
+	}
 
 	term := strings.Join(args, " ")
 
@@ -84,3 +93,62 @@ func (c *FindCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
+
+// findSaved searches the titles and messages of saved conversations.
+func (c *FindCmd) findSaved(term string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		convs := config.ListConversations()
+
+		var b strings.Builder
+		b.WriteString(s.CardTitle.Render("Search all conversations: " + term))
+		b.WriteString("\n\n")
+
+		// Keep positions from the full list so /load <number> still works.
+		var matched []int
+		for i, conv := range convs {
+			if conv.Matches(term) {
+				matched = append(matched, i)
+			}
+		}
+
+		if len(matched) == 0 {
+			b.WriteString(s.Subtle.Render("No saved conversations match."))
+			return InjectSystemMsg{Content: b.String()}
+		}
+
+		limit := 10
+		if len(matched) < limit {
+			limit = len(matched)
+		}
+
+		for n := 0; n < limit; n++ {
+			i := matched[n]
+			conv := convs[i]
+			b.WriteString(s.Bold.Render(itoa(i+1)+".") + " " + s.CardValue.Render(conv.Title))
+			b.WriteString(s.Subtle.Render("  " + conv.UpdatedAt.Format("Jan 02 15:04")))
+			b.WriteString("\n")
+			b.WriteString(s.Subtle.Render("     ID: " + conv.ID))
+			b.WriteString("\n")
+			snippets := conv.Snippets(term, 2)
+			if len(snippets) == 0 {
+				b.WriteString(s.Subtle.Render("     (title match)"))
+				b.WriteString("\n")
+			}
+			for _, snippet := range snippets {
+				b.WriteString(s.CardValue.Render("     " + snippet))
+				b.WriteString("\n")
+			}
+		}
+
+		if len(matched) > limit {
+			b.WriteString(s.Subtle.Render("  ..." + itoa(len(matched)-limit) + " more"))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render(itoa(len(matched)) + " conversation(s) matched. Use /load <number> to open one."))
+
+		return InjectSystemMsg{Content: b.String()}
+	}
+}
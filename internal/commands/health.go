@@ -1,9 +1,13 @@
 package commands
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
 // HealthCmd shows a quick daemon health check.
@@ -17,20 +21,13 @@ func (c *HealthCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	return func() tea.Msg {
 		s := ctx.Styles
 
-		health, err := ctx.Client.GetHealth()
+		health, err := ctx.Client.GetHealthDetail()
 		if err != nil {
 			return InjectSystemMsg{Content: s.StatusError.Render("● Daemon unreachable: ") + s.Error.Render(err.Error())}
 		}
 
 		var b strings.Builder
-		switch health.Status {
-		case "healthy", "ok":
-			b.WriteString(s.StatusOK.Render("● Healthy"))
-		case "degraded":
-			b.WriteString(s.StatusWarning.Render("● Degraded"))
-		default:
-			b.WriteString(s.StatusError.Render("● " + health.Status))
-		}
+		b.WriteString(healthDot(s, health.WorstStatus()))
 
 		b.WriteString(s.Subtle.Render("  v" + health.Version))
 		b.WriteString(s.Subtle.Render("  up " + formatUptime(health.UptimeSeconds)))
@@ -47,6 +44,67 @@ func (c *HealthCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			}
 		}
 
+		// Component breakdown
+		if len(health.Checks) > 0 {
+			names := make([]string, 0, len(health.Checks))
+			for name := range health.Checks {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			b.WriteString("\n")
+			for _, name := range names {
+				check := health.Checks[name]
+				b.WriteString("\n  ")
+				b.WriteString(healthStyle(s, check.Status).Render("●"))
+				b.WriteString(" " + s.CardLabel.Render(name))
+				b.WriteString(s.Subtle.Render("  " + check.Status))
+				if check.Version != "" {
+					b.WriteString(s.Subtle.Render("  v" + check.Version))
+				}
+				if check.Message != "" {
+					b.WriteString(s.Subtle.Render("  " + check.Message))
+				}
+			}
+		}
+
+		if health.Mesh != nil {
+			b.WriteString("\n\n  ")
+			if health.Mesh.Connected {
+				b.WriteString(s.StatusOK.Render("●"))
+				b.WriteString(" " + s.CardLabel.Render("mesh"))
+				b.WriteString(s.Subtle.Render(fmt.Sprintf("  %d peers", health.Mesh.Peers)))
+			} else {
+				b.WriteString(s.StatusError.Render("●"))
+				b.WriteString(" " + s.CardLabel.Render("mesh"))
+				b.WriteString(s.Subtle.Render("  disconnected"))
+			}
+		}
+
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
+
+// healthDot renders a colored status dot with its label.
+func healthDot(s *theme.Styles, status string) string {
+	switch status {
+	case "healthy", "ok":
+		return s.StatusOK.Render("● Healthy")
+	case "degraded":
+		return s.StatusWarning.Render("● Degraded")
+	default:
+		return s.StatusError.Render("● " + status)
+	}
+}
+
+// healthStyle picks the status color for a component check.
+func healthStyle(s *theme.Styles, status string) lipgloss.Style {
+	switch status {
+	case "healthy", "ok":
+		return s.StatusOK
+	case "degraded", "starting":
+		return s.StatusWarning
+	default:
+		return s.StatusError
+	}
+}
@@ -49,14 +49,17 @@ func (c *HelpCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		// General
 		b.WriteString(section("📋", "General"))
 		b.WriteString(row("/help", "(h, ?)", "Show this help"))
+		b.WriteString(row("/keys", "(bindings)", "Show all key bindings"))
 		b.WriteString(row("/clear", "", "Clear the screen"))
 		b.WriteString(row("/quit", "(q, exit)", "Exit Hecate"))
 		b.WriteString("\n")
 
 		// Chat
 		b.WriteString(section("💬", "Chat"))
-		b.WriteString(row("/new", "", "Start new conversation"))
+		b.WriteString(row("/new", "(--fork)", "Start new conversation"))
 		b.WriteString(row("/history", "", "Show conversation history"))
+		b.WriteString(row("/diff", "", "Compare two saved conversations"))
+		b.WriteString(row("/recover", "", "Reopen a conversation that failed to load"))
 		b.WriteString(row("/delete", "(del)", "Delete messages"))
 		b.WriteString(row("/save", "", "Save conversation"))
 		b.WriteString(row("/edit", "", "Edit a message"))
@@ -67,6 +70,7 @@ func (c *HelpCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		b.WriteString(section("🤖", "LLM & Models"))
 		b.WriteString(row("/models", "", "List available models"))
 		b.WriteString(row("/model", "", "Show/select current model"))
+		b.WriteString(row("/compare", "", "Re-run last prompt on several models"))
 		b.WriteString(row("/load", "", "Load a model"))
 		b.WriteString(row("/provider", "", "Manage LLM providers"))
 		b.WriteString(row("/browse", "", "Browse capabilities"))
@@ -95,10 +99,12 @@ func (c *HelpCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		// Project & Tools
 		b.WriteString(section("🛠️", "Project & Tools"))
 		b.WriteString(row("/project", "(proj)", "Show workspace info"))
+		b.WriteString(row("/cd", "", "Change working directory"))
+		b.WriteString(row("/pwd", "", "Show working directory"))
 		b.WriteString(row("/config", "", "Show configuration"))
 		b.WriteString(row("/pair", "", "Pair programming mode"))
-		b.WriteString(row("/find", "", "Find in codebase"))
-		b.WriteString(row("/tools", "", "Detect developer tools"))
+		b.WriteString(row("/find", "(--all)", "Search chat or saved conversations"))
+		b.WriteString(row("/tools", "(detect|audit)", "Detect developer tools"))
 		b.WriteString(row("/fn", "(on|off)", "LLM function calling"))
 		b.WriteString("\n")
 
@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/chat"
+	"github.com/hecate-social/hecate-tui/internal/ui"
+)
+
+// ImportTranscriptMsg tells the app to start a new conversation from an
+// imported transcript.
+type ImportTranscriptMsg struct {
+	Source   string
+	Messages []chat.Message
+}
+
+// ImportCmd loads an external transcript into a new conversation.
+type ImportCmd struct{}
+
+func (c *ImportCmd) Name() string        { return "import" }
+func (c *ImportCmd) Aliases() []string   { return nil }
+func (c *ImportCmd) Description() string { return "Import a transcript (/import <file.md|file.json>)" }
+
+func (c *ImportCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		if len(args) == 0 {
+			return InjectSystemMsg{Content: s.Subtle.Render("Usage: /import <file>  (markdown or JSON from /save)")}
+		}
+
+		path := ui.ExpandHome(strings.Join(args, " "))
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to read: " + err.Error())}
+		}
+
+		exported, err := parseTranscript(path, data)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Cannot import " + filepath.Base(path) + ": " + err.Error())}
+		}
+
+		return ImportTranscriptMsg{
+			Source:   filepath.Base(path),
+			Messages: toChatMessages(exported),
+		}
+	}
+}
+
+// parseTranscript picks a parser from the file extension, falling back to
+// sniffing the content for JSON.
+func parseTranscript(path string, data []byte) ([]ChatExportMsg, error) {
+	format, _ := exportFormat("", path)
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("file is empty")
+	}
+	if format == FormatHTML {
+		return nil, fmt.Errorf("HTML transcripts cannot be imported; use the md or json export")
+	}
+	if format == FormatJSON || trimmed[0] == '{' || trimmed[0] == '[' {
+		return parseImportJSON(trimmed)
+	}
+	return parseImportMarkdown(string(data))
+}
+
+// parseImportJSON accepts both the /save JSON document and a bare message array.
+func parseImportJSON(data []byte) ([]ChatExportMsg, error) {
+	var msgs []ChatExportMsg
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &msgs); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	} else {
+		var err error
+		if msgs, err = parseExportJSON(data); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no messages found")
+	}
+	for i, m := range msgs {
+		switch m.Role {
+		case "user", "assistant", "system":
+		default:
+			return nil, fmt.Errorf("message %d: unknown role %q", i+1, m.Role)
+		}
+	}
+	return msgs, nil
+}
+
+// parseImportMarkdown reads the markdown written by /save. Role headings
+// may use either the "### You"/"### Hecate" form or the "▸ You"/"◆ Hecate"
+// chat markers.
+func parseImportMarkdown(text string) ([]ChatExportMsg, error) {
+	var msgs []ChatExportMsg
+	var cur *ChatExportMsg
+	var body []string
+	inCode := false
+
+	flush := func() {
+		if cur == nil {
+			return
+		}
+		// Drop the separator the exporter writes before system notes.
+		for len(body) > 0 {
+			last := strings.TrimSpace(body[len(body)-1])
+			if last != "" && last != "---" {
+				break
+			}
+			body = body[:len(body)-1]
+		}
+		cur.Content = strings.TrimSpace(strings.Join(body, "\n"))
+		if cur.Content != "" {
+			msgs = append(msgs, *cur)
+		}
+		cur, body = nil, nil
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+		}
+		if !inCode {
+			if role, ts, ok := markdownRoleHeading(trimmed); ok {
+				flush()
+				cur = &ChatExportMsg{Role: role, Time: ts}
+				continue
+			}
+			if strings.HasPrefix(trimmed, "*System: ") && strings.HasSuffix(trimmed, "*") {
+				flush()
+				msgs = append(msgs, ChatExportMsg{
+					Role:    "system",
+					Content: strings.TrimSuffix(strings.TrimPrefix(trimmed, "*System: "), "*"),
+				})
+				continue
+			}
+			if trimmed == "*End of transcript*" {
+				flush()
+				continue
+			}
+		}
+		if cur != nil {
+			body = append(body, line)
+		}
+	}
+	flush()
+
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("no messages found (expected \"### You\" / \"### Hecate\" headings)")
+	}
+	return msgs, nil
+}
+
+// markdownRoleHeading recognizes a role heading and its optional "(time)".
+func markdownRoleHeading(line string) (role, ts string, ok bool) {
+	heading := strings.HasPrefix(line, "#")
+	line = strings.TrimSpace(strings.TrimLeft(line, "#"))
+	for _, marker := range []struct{ prefix, role string }{
+		{"▸ You", "user"},
+		{"◆ Hecate", "assistant"},
+		{"You", "user"},
+		{"Hecate", "assistant"},
+	} {
+		// Bare names only count as headings, markers count anywhere.
+		if !strings.HasPrefix(line, marker.prefix) || (!heading && !strings.ContainsAny(marker.prefix, "▸◆")) {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(line, marker.prefix))
+		if rest == "" {
+			return marker.role, "", true
+		}
+		if strings.HasPrefix(rest, "(") && strings.HasSuffix(rest, ")") {
+			return marker.role, rest[1 : len(rest)-1], true
+		}
+	}
+	return "", "", false
+}
+
+// toChatMessages converts exported messages back into chat messages.
+func toChatMessages(exported []ChatExportMsg) []chat.Message {
+	msgs := make([]chat.Message, 0, len(exported))
+	for _, m := range exported {
+		var t time.Time
+		if m.Time != "" {
+			t, _ = time.ParseInLocation("2006-01-02 15:04:05", m.Time, time.Local)
+		}
+		msgs = append(msgs, chat.Message{Role: m.Role, Content: m.Content, Time: t})
+	}
+	return msgs
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTranscript(t *testing.T) {
+	sample := []ChatExportMsg{
+		{Role: "user", Content: "hello", Time: "2026-01-02 03:04:05"},
+		{Role: "assistant", Content: "Sure:\n\n```md\n### You\n---\n```"},
+		{Role: "system", Content: "Model switched"},
+		{Role: "user", Content: "thanks"},
+	}
+	now := time.Now()
+	md, _ := exportMarkdown(sample, now)
+	js, _ := exportJSON(sample, now)
+
+	tests := []struct {
+		name    string
+		path    string
+		data    string
+		want    []ChatExportMsg
+		wantErr string
+	}{
+		{name: "markdown export", path: "chat.md", data: string(md), want: sample},
+		{name: "json export", path: "chat.json", data: string(js), want: sample},
+		{name: "json sniffed", path: "chat.txt", data: string(js), want: sample},
+		{
+			name: "json array",
+			path: "chat.json",
+			data: `[{"role":"user","content":"hi"}]`,
+			want: []ChatExportMsg{{Role: "user", Content: "hi"}},
+		},
+		{
+			name: "chat markers",
+			path: "chat.md",
+			data: "▸ You\nhi\n\n◆ Hecate (10:00)\nhello\n",
+			want: []ChatExportMsg{
+				{Role: "user", Content: "hi"},
+				{Role: "assistant", Content: "hello", Time: "10:00"},
+			},
+		},
+		{name: "empty", path: "chat.md", data: "  \n", wantErr: "empty"},
+		{name: "no headings", path: "notes.md", data: "# Notes\nYou said hi\n", wantErr: "no messages"},
+		{name: "bad json", path: "chat.json", data: `{"messages": [`, wantErr: "invalid JSON"},
+		{name: "bad role", path: "chat.json", data: `[{"role":"bot","content":"x"}]`, wantErr: "unknown role"},
+		{name: "html", path: "chat.html", data: "<html></html>", wantErr: "HTML"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTranscript(tt.path, []byte(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v\nwant %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/modes"
+)
+
+// KeyBinding is one row of the key reference.
+type KeyBinding struct {
+	Keys   string
+	Action string
+	// Match lists the key strings (as tea.KeyMsg.String() reports them)
+	// the row documents. keys_test.go checks every key the handlers act
+	// on appears here, so the reference can't drift from the switches.
+	Match []string
+}
+
+// bind builds a binding shown as keys that covers the match key strings.
+func bind(keys, action string, match ...string) KeyBinding {
+	return KeyBinding{Keys: keys, Action: action, Match: match}
+}
+
+// KeySection groups related bindings under a heading.
+type KeySection struct {
+	Title    string
+	Bindings []KeyBinding
+}
+
+// ModeKeys is the key reference for one input mode.
+type ModeKeys struct {
+	Mode     modes.Mode
+	Title    string
+	Sections []KeySection
+	Footer   string
+}
+
+// shellKeys lists the keys the shell intercepts before a studio sees them.
+var shellKeys = []KeySection{
+	{"Studios (Normal mode)", []KeyBinding{
+		bind("Ctrl+1..9", "Switch to studio 1-9"),
+		bind("[ / ]", "Previous/next studio", "[", "]"),
+		bind("Ctrl+S", "Open the studio picker", "ctrl+s"),
+		bind("/ or :", "Enter Command mode", "/", ":"),
+		bind("q", "Quit", "q"),
+	}},
+	{"Home Screen", []KeyBinding{
+		bind("1-9", "Open a studio"),
+		bind("q", "Quit", "q"),
+	}},
+	{"Anywhere", []KeyBinding{
+		bind("Ctrl+C", "Force quit", "ctrl+c"),
+	}},
+}
+
+// modeKeys is the single source for both the ? mode help and /keys.
+var modeKeys = []ModeKeys{
+	{
+		Mode:  modes.Normal,
+		Title: "Normal Mode",
+		Sections: []KeySection{
+			{"Navigation", []KeyBinding{
+				bind("j/k", "Scroll chat up/down", "j", "k", "down", "up"),
+				bind("Ctrl+D/U", "Half-page scroll", "ctrl+d", "ctrl+u"),
+				bind("g/G", "Jump to top/bottom", "g", "G"),
+				bind("F", "Toggle follow (auto-scroll to new messages)", "F"),
+				bind("Click", "Select a message (double-click copies)"),
+				bind("Esc", "Clear selection, stop /status --watch or a compare", "esc"),
+			}},
+			{"Mode Switching", []KeyBinding{
+				bind("i", "Enter Insert mode (type messages)", "i"),
+				bind("/", "Enter Command mode", "/"),
+				bind(":", "Enter Command mode (vim-style)", ":"),
+			}},
+			{"Actions", []KeyBinding{
+				bind("?", "Show this help", "?"),
+				bind("t", "Show/hide thinking blocks", "t"),
+				bind("r", "Retry last message", "r"),
+				bind("y", "Copy selected message (or last response)", "y"),
+				bind("q", "Quit", "q"),
+				bind("Ctrl+C", "Force quit", "ctrl+c"),
+			}},
+		},
+		Footer: "Type / to see available commands, /keys for every binding",
+	},
+	{
+		Mode:  modes.Insert,
+		Title: "Insert Mode",
+		Sections: []KeySection{
+			{"Messaging", []KeyBinding{
+				bind("Enter", "Send message to LLM", "enter"),
+				bind("Alt+Enter", "Insert newline (multiline)", "alt+enter"),
+				bind("Ctrl+J", "Grow the input box (wraps back to one row)", "ctrl+j"),
+				bind("Ctrl+O", "Compose the message in a full editor", "ctrl+o"),
+				bind("Up/Down", "Recall previous prompts (first/last line)", "up", "down"),
+				bind("Tab/Shift+Tab", "Cycle forward/back through models", "tab", "shift+tab"),
+				bind("Esc", "Return to Normal (or cancel streaming)", "esc"),
+			}},
+			{"During Streaming", []KeyBinding{
+				bind("Esc", "Cancel the current response", "esc"),
+			}},
+		},
+	},
+	{
+		Mode:  modes.Command,
+		Title: "Command Mode",
+		Sections: []KeySection{
+			{"Input", []KeyBinding{
+				bind("Enter", "Execute command", "enter"),
+				bind("Tab", "Autocomplete command name", "tab"),
+				bind("Up/Down", "Browse command history", "up", "down"),
+				bind("Esc", "Cancel and return to Normal", "esc"),
+			}},
+		},
+	},
+	{
+		Mode:  modes.Browse,
+		Title: "Browse Mode",
+		Sections: []KeySection{
+			{"Navigation", []KeyBinding{
+				bind("j/k", "Navigate capability list", "j", "k", "down", "up"),
+				bind("h/l", "Previous/next tab", "h", "l", "left", "right"),
+				bind("Tab/Shift+Tab", "Next/previous tab", "tab", "shift+tab"),
+				bind("g/G", "Jump to top/bottom", "g", "G"),
+				bind("Enter", "View capability details (again to close)", "enter"),
+				bind("/", "Filter by name, description or tag", "/"),
+				bind("Up/Down", "Move through matches while filtering", "up", "down", "ctrl+p", "ctrl+n"),
+				bind("r", "Refresh list", "r"),
+				bind("< / >", "Narrow/widen the overlay", "<", ">"),
+				bind("q", "Close capability details", "q"),
+				bind("Esc", "Clear the filter, then return to Normal", "esc"),
+				bind("?", "Show this help", "?"),
+			}},
+		},
+	},
+	{
+		Mode:  modes.Pair,
+		Title: "Pair Mode",
+		Sections: []KeySection{
+			{"Actions", []KeyBinding{
+				bind("p", "Start pairing / re-pair", "p"),
+				bind("c", "Cancel pairing", "c"),
+				bind("r", "Refresh identity, or retry a failed pairing", "r"),
+				bind("< / >", "Resize the pair panel", "<", ">"),
+				bind("Esc", "Return to Normal", "esc"),
+				bind("?", "Show this help", "?"),
+			}},
+		},
+	},
+	{
+		Mode:  modes.Edit,
+		Title: "Edit Mode",
+		Sections: []KeySection{
+			{"File", []KeyBinding{
+				bind("Ctrl+S", "Save file", "ctrl+s"),
+				bind("Alt+S", "Save as", "alt+s"),
+				bind("Ctrl+Q", "Close editor", "ctrl+q"),
+				bind("Esc", "Close editor", "esc"),
+			}},
+			{"Navigation", []KeyBinding{
+				bind("Ctrl+F", "Find", "ctrl+f"),
+				bind("Ctrl+R", "Find and replace", "ctrl+r"),
+				bind("Ctrl+G", "Go to line", "ctrl+g"),
+				bind("Alt+L", "Show/hide line numbers", "alt+l"),
+			}},
+		},
+	},
+}
+
+// ShellKeys returns the keys the shell handles before any studio.
+func ShellKeys() []KeySection {
+	return shellKeys
+}
+
+// AllModeKeys returns the key reference for every mode that has one.
+func AllModeKeys() []ModeKeys {
+	return modeKeys
+}
+
+// KeysForMode returns the key reference for mode, if there is one.
+func KeysForMode(mode modes.Mode) (ModeKeys, bool) {
+	for _, mk := range modeKeys {
+		if mk.Mode == mode {
+			return mk, true
+		}
+	}
+	return ModeKeys{}, false
+}
+
+// RenderKeySections lays out sections with the keys column aligned.
+func RenderKeySections(ctx *Context, sections []KeySection) string {
+	width := 9
+	for _, sec := range sections {
+		for _, kb := range sec.Bindings {
+			if len(kb.Keys) > width {
+				width = len(kb.Keys)
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i, sec := range sections {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(ctx.Styles.Bold.Render(sec.Title))
+		b.WriteString("\n")
+		for _, kb := range sec.Bindings {
+			b.WriteString(fmt.Sprintf("  %-*s %s\n", width, kb.Keys, kb.Action))
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// ShowKeysMsg asks the shell to open the key reference overlay.
+type ShowKeysMsg struct{}
+
+// KeysCmd shows every key binding, grouped by mode.
+type KeysCmd struct{}
+
+func (c *KeysCmd) Name() string        { return "keys" }
+func (c *KeysCmd) Aliases() []string   { return []string{"bindings", "keybindings"} }
+func (c *KeysCmd) Description() string { return "Show all key bindings" }
+
+func (c *KeysCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg { return ShowKeysMsg{} }
+}
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/modes"
+)
+
+func TestKeysForMode(t *testing.T) {
+	for _, mode := range []modes.Mode{modes.Normal, modes.Insert, modes.Command, modes.Browse, modes.Pair, modes.Edit} {
+		mk, ok := KeysForMode(mode)
+		if !ok {
+			t.Errorf("no key reference for %s", mode)
+			continue
+		}
+		if len(mk.Sections) == 0 || len(mk.Sections[0].Bindings) == 0 {
+			t.Errorf("%s key reference is empty", mode)
+		}
+	}
+	if _, ok := KeysForMode(modes.Form); ok {
+		t.Error("Form mode should have no key reference")
+	}
+}
+
+// handledKeys parses the named functions in file (relative to this
+// package) and returns the key strings they act on: case labels of a
+// switch on key or msg.String(), and key == "..." comparisons.
+func handledKeys(t *testing.T, file string, funcs ...string) []string {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[string]bool)
+	for _, name := range funcs {
+		want[name] = true
+	}
+
+	var keys []string
+	literal := func(e ast.Expr) {
+		if lit, ok := e.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			if k, err := strconv.Unquote(lit.Value); err == nil {
+				keys = append(keys, k)
+			}
+		}
+	}
+	isKey := func(e ast.Expr) bool {
+		switch e := e.(type) {
+		case *ast.Ident:
+			return e.Name == "key"
+		case *ast.CallExpr:
+			sel, ok := e.Fun.(*ast.SelectorExpr)
+			return ok && sel.Sel.Name == "String" && len(e.Args) == 0
+		}
+		return false
+	}
+
+	found := 0
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !want[fn.Name.Name] {
+			continue
+		}
+		found++
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.SwitchStmt:
+				if n.Tag == nil || !isKey(n.Tag) {
+					return true
+				}
+				for _, stmt := range n.Body.List {
+					for _, e := range stmt.(*ast.CaseClause).List {
+						literal(e)
+					}
+				}
+			case *ast.BinaryExpr:
+				if n.Op == token.EQL && isKey(n.X) {
+					literal(n.Y)
+				}
+			}
+			return true
+		})
+	}
+	if found != len(funcs) {
+		t.Fatalf("%s: found %d of the functions %v", file, found, funcs)
+	}
+	return keys
+}
+
+// TestKeyReferenceCoversHandlers fails when a handler acts on a key the
+// ? help and /keys don't list.
+func TestKeyReferenceCoversHandlers(t *testing.T) {
+	sources := []struct {
+		name     string
+		sections []KeySection
+		handled  []string
+	}{
+		{"shell", ShellKeys(), handledKeys(t, "../app/keys.go", "handleKey", "handleHomeKey")},
+		{"Normal", mustKeys(t, modes.Normal), handledKeys(t, "../studios/llm/keys.go", "handleNormalKey")},
+		{"Insert", mustKeys(t, modes.Insert), handledKeys(t, "../studios/llm/keys.go", "handleInsertKey")},
+		{"Command", mustKeys(t, modes.Command), handledKeys(t, "../app/keys.go", "handleCommandKey")},
+		{"Browse", mustKeys(t, modes.Browse), append(
+			handledKeys(t, "../studios/llm/keys.go", "handleBrowseKey"),
+			handledKeys(t, "../browse/browse.go", "handleListKey", "handleSearchKey", "handleDetailKey")...)},
+		{"Pair", mustKeys(t, modes.Pair), append(
+			handledKeys(t, "../studios/llm/keys.go", "handlePairKey"),
+			handledKeys(t, "../pair/pair.go", "HandleKey")...)},
+		{"Edit", mustKeys(t, modes.Edit), append(
+			handledKeys(t, "../studios/llm/keys.go", "handleEditKey"),
+			handledKeys(t, "../editor/editor.go", "Update")...)},
+	}
+
+	for _, src := range sources {
+		documented := make(map[string]bool)
+		for _, sec := range src.sections {
+			for _, kb := range sec.Bindings {
+				for _, k := range kb.Match {
+					documented[k] = true
+				}
+			}
+		}
+		for _, k := range src.handled {
+			if !documented[k] {
+				t.Errorf("%s handles %q but its key reference doesn't list it", src.name, k)
+			}
+		}
+	}
+}
+
+func mustKeys(t *testing.T, mode modes.Mode) []KeySection {
+	t.Helper()
+	mk, ok := KeysForMode(mode)
+	if !ok {
+		t.Fatalf("no key reference for %s", mode)
+	}
+	return mk.Sections
+}
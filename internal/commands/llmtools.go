@@ -32,14 +32,21 @@ func (c *LLMToolsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			b.WriteString("\n\n")
 			b.WriteString("  Status: ")
 			b.WriteString(statusStyle.Render(status))
+			if ctx.ToolsAuto != nil && ctx.ToolsAuto() {
+				b.WriteString(s.Subtle.Render(" (auto)"))
+			}
 			b.WriteString("\n\n")
 			b.WriteString(s.Subtle.Render("  /fn on   - Enable function calling"))
 			b.WriteString("\n")
 			b.WriteString(s.Subtle.Render("  /fn off  - Disable function calling"))
+			b.WriteString("\n")
+			b.WriteString(s.Subtle.Render("  /fn auto - Follow the active model's tool support"))
 			b.WriteString("\n\n")
-			b.WriteString(s.Subtle.Render("  Note: Enable only for models that support tools"))
+			b.WriteString(s.Subtle.Render("  Auto mode enables tools only for models the daemon"))
+			b.WriteString("\n")
+			b.WriteString(s.Subtle.Render("  reports as tool-capable (see /models). Tools are"))
 			b.WriteString("\n")
-			b.WriteString(s.Subtle.Render("  (Claude, GPT-4, etc). Ollama models don't support this."))
+			b.WriteString(s.Subtle.Render("  off until you turn them on or choose auto."))
 
 			return InjectSystemMsg{Content: b.String()}
 		}
@@ -50,9 +57,11 @@ func (c *LLMToolsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			return EnableToolsMsg{Enabled: true}
 		case "off", "disable", "0", "false":
 			return EnableToolsMsg{Enabled: false}
+		case "auto":
+			return EnableToolsMsg{Auto: true}
 		default:
 			return InjectSystemMsg{
-				Content: s.Error.Render(fmt.Sprintf("Unknown argument: %s (use 'on' or 'off')", arg)),
+				Content: s.Error.Render(fmt.Sprintf("Unknown argument: %s (use 'on', 'off' or 'auto')", arg)),
 			}
 		}
 	}
@@ -61,4 +70,5 @@ func (c *LLMToolsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 // EnableToolsMsg tells the app to enable/disable LLM tools.
 type EnableToolsMsg struct {
 	Enabled bool
+	Auto    bool // follow the active model instead of a fixed setting
 }
@@ -3,7 +3,9 @@ package commands
 import (
 	"strings"
 
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 // MeCmd shows identity information as an inline card.
@@ -11,7 +13,58 @@ type MeCmd struct{}
 
 func (c *MeCmd) Name() string        { return "me" }
 func (c *MeCmd) Aliases() []string   { return []string{"whoami"} }
-func (c *MeCmd) Description() string { return "Show your identity" }
+func (c *MeCmd) Description() string { return "Show your identity (/me copy <field>)" }
+
+// identityField is one labelled value on the identity card. Abbreviated
+// values are shortened on the card but copied in full.
+type identityField struct {
+	key        string
+	aliases    []string
+	label      string
+	value      string
+	abbreviate bool
+}
+
+// identityFields lists the copyable identity fields. Only public material
+// is included; nothing secret ever leaves the daemon through this card.
+func identityFields(id *client.Identity) []identityField {
+	realm := id.Realm
+	if realm == "" {
+		realm = realmFromMRI(id.Identity)
+	}
+	return []identityField{
+		{key: "mri", aliases: []string{"identity", "id"}, label: "MRI", value: id.Identity},
+		{key: "realm", label: "Realm", value: realm},
+		{key: "node", aliases: []string{"node_id", "nodeid"}, label: "Node", value: id.NodeID},
+		{key: "pubkey", aliases: []string{"key", "public_key", "publickey"}, label: "Public Key", value: id.PublicKey, abbreviate: true},
+		{key: "created", label: "Created", value: id.CreatedAt},
+	}
+}
+
+// findIdentityField looks a field up by key or alias.
+func findIdentityField(fields []identityField, name string) (identityField, bool) {
+	name = strings.ToLower(name)
+	for _, f := range fields {
+		if f.key == name {
+			return f, true
+		}
+		for _, a := range f.aliases {
+			if a == name {
+				return f, true
+			}
+		}
+	}
+	return identityField{}, false
+}
+
+// realmFromMRI extracts the realm from an "mri:type:realm/..." identity.
+func realmFromMRI(mri string) string {
+	parts := strings.SplitN(mri, ":", 3)
+	if len(parts) < 3 || parts[0] != "mri" {
+		return ""
+	}
+	return strings.SplitN(parts[2], "/", 2)[0]
+}
 
 func (c *MeCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	return func() tea.Msg {
@@ -21,26 +74,42 @@ func (c *MeCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to get identity: " + err.Error())}
 		}
+		fields := identityFields(identity)
+
+		if len(args) > 0 && args[0] == "copy" {
+			if len(args) < 2 {
+				return InjectSystemMsg{Content: s.Error.Render("Usage: /me copy <mri|realm|node|pubkey|created>")}
+			}
+			f, ok := findIdentityField(fields, args[1])
+			if !ok {
+				return InjectSystemMsg{Content: s.Error.Render("Unknown field: " + args[1] + " (use mri, realm, node, pubkey or created)")}
+			}
+			if f.value == "" {
+				return InjectSystemMsg{Content: s.Error.Render(f.label + " is not set")}
+			}
+			if err := clipboard.WriteAll(f.value); err != nil {
+				return InjectSystemMsg{Content: s.Error.Render("Clipboard unavailable: " + err.Error())}
+			}
+			return InjectSystemMsg{Content: s.StatusOK.Render("Copied " + f.label + " to clipboard")}
+		}
 
 		var b strings.Builder
 		b.WriteString(s.CardTitle.Render("Identity"))
 		b.WriteString("\n\n")
 
-		b.WriteString(s.CardLabel.Render("MRI: "))
-		b.WriteString(s.CardValue.Render(identity.Identity))
-		b.WriteString("\n")
-
-		b.WriteString(s.CardLabel.Render("Public Key: "))
-		pk := identity.PublicKey
-		if len(pk) > 20 {
-			pk = pk[:8] + "..." + pk[len(pk)-8:]
+		for _, f := range fields {
+			if f.value == "" {
+				continue
+			}
+			value := f.value
+			if f.abbreviate && len(value) > 20 {
+				value = value[:8] + "..." + value[len(value)-8:]
+			}
+			b.WriteString(s.CardLabel.Render(f.label + ": "))
+			b.WriteString(s.CardValue.Render(value))
+			b.WriteString(s.Subtle.Render("  [" + f.key + "]"))
+			b.WriteString("\n")
 		}
-		b.WriteString(s.CardValue.Render(pk))
-		b.WriteString("\n")
-
-		b.WriteString(s.CardLabel.Render("Created: "))
-		b.WriteString(s.CardValue.Render(identity.CreatedAt))
-		b.WriteString("\n")
 
 		// Capability count
 		caps, capsErr := ctx.Client.DiscoverCapabilities("", "", 0)
@@ -50,6 +119,9 @@ func (c *MeCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			b.WriteString("\n")
 		}
 
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render("/me copy <field> copies a [field] in full"))
+
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
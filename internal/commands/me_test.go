@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/client"
+)
+
+func TestIdentityFields(t *testing.T) {
+	id := &client.Identity{
+		Identity:  "mri:agent:io.macula/alice",
+		PublicKey: "ed25519:abcdefghijklmnopqrstuvwxyz",
+		NodeID:    "node-7",
+	}
+	fields := identityFields(id)
+
+	tests := []struct {
+		name  string
+		want  string
+		found bool
+	}{
+		{"realm", "io.macula", true},
+		{"MRI", "mri:agent:io.macula/alice", true},
+		{"public_key", "ed25519:abcdefghijklmnopqrstuvwxyz", true},
+		{"node_id", "node-7", true},
+		{"secret", "", false},
+	}
+	for _, tt := range tests {
+		f, ok := findIdentityField(fields, tt.name)
+		if ok != tt.found || f.value != tt.want {
+			t.Errorf("findIdentityField(%q) = %q, %v; want %q, %v", tt.name, f.value, ok, tt.want, tt.found)
+		}
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/llm"
+	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
 // ModelsCmd lists available LLM models.
@@ -12,9 +14,16 @@ type ModelsCmd struct{}
 
 func (c *ModelsCmd) Name() string        { return "models" }
 func (c *ModelsCmd) Aliases() []string   { return nil }
-func (c *ModelsCmd) Description() string { return "List available LLM models" }
+func (c *ModelsCmd) Description() string { return "List available LLM models (--refresh to re-query)" }
+
+// RefreshModelsMsg tells the chat to re-query the daemon's model list.
+type RefreshModelsMsg struct{}
 
 func (c *ModelsCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	if len(args) > 0 && (args[0] == "--refresh" || args[0] == "-r") {
+		return func() tea.Msg { return RefreshModelsMsg{} }
+	}
+
 	return func() tea.Msg {
 		s := ctx.Styles
 
@@ -28,8 +37,8 @@ func (c *ModelsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		}
 
 		// Calculate column widths
-		maxName := 4 // "Name"
-		maxSize := 4 // "Size"
+		maxName := 4     // "Name"
+		maxSize := 4     // "Size"
 		maxProvider := 8 // "Provider"
 		for _, m := range models {
 			if len(m.Name) > maxName {
@@ -48,16 +57,19 @@ func (c *ModelsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		b.WriteString("\n\n")
 
 		// Header
-		header := fmt.Sprintf("  %-*s  %-*s  %-*s  %s",
+		header := fmt.Sprintf("  %-*s  %-*s  %-7s  %-5s  %-5s  %-*s  %s",
 			maxName, "Name",
 			maxSize, "Size",
+			"Context",
+			"Tools",
+			"Cost",
 			maxProvider, "Provider",
 			"Family")
 		b.WriteString(s.Subtle.Render(header))
 		b.WriteString("\n")
 
 		// Separator
-		b.WriteString(s.Subtle.Render("  " + strings.Repeat("─", maxName+maxSize+maxProvider+20)))
+		b.WriteString(s.Subtle.Render("  " + strings.Repeat("─", maxName+maxSize+maxProvider+44)))
 		b.WriteString("\n")
 
 		// Rows
@@ -80,6 +92,16 @@ func (c *ModelsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			b.WriteString("  ")
 			b.WriteString(s.CardValue.Render(fmt.Sprintf("%-*s", maxSize, size)))
 			b.WriteString("  ")
+			b.WriteString(s.CardValue.Render(fmt.Sprintf("%-7s", formatContextLength(m.ContextLength))))
+			b.WriteString("  ")
+			b.WriteString(toolSupportLabel(s, m))
+			b.WriteString("  ")
+			if m.IsPaid() {
+				b.WriteString(s.StatusWarning.Render(fmt.Sprintf("%-5s", "paid")))
+			} else {
+				b.WriteString(s.StatusOK.Render(fmt.Sprintf("%-5s", "free")))
+			}
+			b.WriteString("  ")
 			b.WriteString(s.Subtle.Render(fmt.Sprintf("%-*s", maxProvider, provider)))
 			b.WriteString("  ")
 			b.WriteString(s.Subtle.Render(family))
@@ -87,12 +109,41 @@ func (c *ModelsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		}
 
 		b.WriteString("\n")
-		b.WriteString(s.Subtle.Render("  Use /model <name> to switch"))
+		b.WriteString(s.Subtle.Render("  Use /model <name> to switch · ? = capabilities not reported"))
 
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
 
+// formatContextLength renders a token count compactly (8192 → 8k).
+func formatContextLength(n int) string {
+	switch {
+	case n <= 0:
+		return "-"
+	case n >= 1000000 && n%1000000 == 0:
+		return fmt.Sprintf("%dM", n/1000000)
+	case n >= 1024 && n%1024 == 0:
+		return fmt.Sprintf("%dk", n/1024)
+	case n >= 1000:
+		return fmt.Sprintf("%dk", n/1000)
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
+
+// toolSupportLabel renders a padded yes/no/? cell for function calling.
+func toolSupportLabel(s *theme.Styles, m llm.Model) string {
+	supported, known := m.SupportsTools()
+	switch {
+	case !known:
+		return s.Subtle.Render(fmt.Sprintf("%-5s", "?"))
+	case supported:
+		return s.StatusOK.Render(fmt.Sprintf("%-5s", "yes"))
+	default:
+		return s.Subtle.Render(fmt.Sprintf("%-5s", "no"))
+	}
+}
+
 // ModelCmd switches the active LLM model.
 type ModelCmd struct{}
 
@@ -117,3 +168,36 @@ func (c *ModelCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return SwitchModelMsg{Name: modelName}
 	}
 }
+
+// CompareCmd runs the last prompt against several models at once.
+type CompareCmd struct{}
+
+func (c *CompareCmd) Name() string      { return "compare" }
+func (c *CompareCmd) Aliases() []string { return nil }
+func (c *CompareCmd) Description() string {
+	return "Re-run the last prompt on several models (/compare <model1,model2,...>)"
+}
+
+// CompareModelsMsg tells the chat to stream the last prompt to each model.
+type CompareModelsMsg struct {
+	Models []string
+}
+
+func (c *CompareCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	var names []string
+	for _, arg := range args {
+		for _, name := range strings.Split(arg, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) < 2 {
+		return func() tea.Msg {
+			return InjectSystemMsg{Content: ctx.Styles.Subtle.Render("Usage: /compare <model1,model2,...>  (Esc cancels)")}
+		}
+	}
+	return func() tea.Msg {
+		return CompareModelsMsg{Models: names}
+	}
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// listPageSize is how many entries a paginated list shows at once.
+const listPageSize = 20
+
+// extractPage removes a "--page N" (or "-p N") flag from args and returns
+// the requested page, 1 when absent.
+func extractPage(args []string) ([]string, int, error) {
+	page := 1
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		if args[i] != "--page" && args[i] != "-p" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, 0, fmt.Errorf("--page needs a number")
+		}
+		n, err := strconv.Atoi(args[i+1])
+		if err != nil || n < 1 {
+			return nil, 0, fmt.Errorf("invalid page: %s", args[i+1])
+		}
+		page = n
+		i++
+	}
+	return rest, page, nil
+}
+
+// pageRange returns the bounds of page (1-based) within total entries,
+// clamping it to the last page.
+func pageRange(total, page int) (start, end, clamped int) {
+	last := (total + listPageSize - 1) / listPageSize
+	if last < 1 {
+		last = 1
+	}
+	if page > last {
+		page = last
+	}
+	start = (page - 1) * listPageSize
+	end = start + listPageSize
+	if end > total {
+		end = total
+	}
+	return start, end, page
+}
+
+// pageFooter describes which slice of a long list is shown and the command
+// for the next page. It is empty when everything fits on one page.
+func pageFooter(start, end, total, page int, cmd string) string {
+	if total <= listPageSize {
+		return ""
+	}
+	footer := fmt.Sprintf("Showing %d–%d of %d", start+1, end, total)
+	if end < total {
+		footer += fmt.Sprintf(" · %s --page %d for more", cmd, page+1)
+	}
+	return footer
+}
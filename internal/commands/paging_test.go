@@ -0,0 +1,41 @@
+package commands
+
+import "testing"
+
+func TestExtractPage(t *testing.T) {
+	rest, page, err := extractPage([]string{"list", "--page", "3", "all"})
+	if err != nil || page != 3 || len(rest) != 2 || rest[1] != "all" {
+		t.Errorf("extractPage() = %v, %d, %v", rest, page, err)
+	}
+	if _, page, _ := extractPage([]string{"list"}); page != 1 {
+		t.Errorf("default page = %d, want 1", page)
+	}
+	for _, args := range [][]string{{"--page"}, {"-p", "0"}, {"--page", "two"}} {
+		if _, _, err := extractPage(args); err == nil {
+			t.Errorf("extractPage(%v) should fail", args)
+		}
+	}
+}
+
+func TestPageRangeAndFooter(t *testing.T) {
+	start, end, page := pageRange(73, 2)
+	if start != 20 || end != 40 || page != 2 {
+		t.Errorf("pageRange(73, 2) = %d, %d, %d", start, end, page)
+	}
+	if got, want := pageFooter(start, end, 73, page, "/venture list"), "Showing 21–40 of 73 · /venture list --page 3 for more"; got != want {
+		t.Errorf("footer = %q, want %q", got, want)
+	}
+
+	// Past the end clamps to the last page, which has no "more".
+	start, end, page = pageRange(73, 9)
+	if start != 60 || end != 73 || page != 4 {
+		t.Errorf("pageRange(73, 9) = %d, %d, %d", start, end, page)
+	}
+	if got, want := pageFooter(start, end, 73, page, "/venture list"), "Showing 61–73 of 73"; got != want {
+		t.Errorf("footer = %q, want %q", got, want)
+	}
+
+	if got := pageFooter(0, 5, 5, 1, "/venture list"); got != "" {
+		t.Errorf("single page footer = %q, want none", got)
+	}
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// lifecyclePhases lists the division phases in lifecycle order.
+// These are the only values the daemon accepts as a transition target,
+// and the phase commands, labels and progress all read them from here.
+var lifecyclePhases = []string{
+	"design",
+	"plan",
+	"generation",
+	"testing",
+	"deployment",
+	"monitoring",
+	"rescue",
+}
+
+// phaseAliases maps shorthand and ALC role names to canonical phases.
+var phaseAliases = map[string]string{
+	"dna":            "design",
+	"discovery":      "design",
+	"anp":            "plan",
+	"architecture":   "plan",
+	"planning":       "plan",
+	"gen":            "generation",
+	"generate":       "generation",
+	"tni":            "testing",
+	"test":           "testing",
+	"implementation": "testing",
+	"dno":            "deployment",
+	"deploy":         "deployment",
+	"monitor":        "monitoring",
+	"operations":     "monitoring",
+}
+
+// normalizePhase resolves a user-typed transition target to a canonical phase.
+// Returns an error listing the valid targets when the input is not recognized.
+func normalizePhase(target string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(target))
+	if isLifecyclePhase(key) {
+		return key, nil
+	}
+	if phase, ok := phaseAliases[key]; ok {
+		return phase, nil
+	}
+	return "", fmt.Errorf("unknown phase %q (valid: %s)", target, strings.Join(lifecyclePhases, ", "))
+}
+
+// isLifecyclePhase reports whether key is one of lifecyclePhases.
+func isLifecyclePhase(key string) bool {
+	for _, phase := range lifecyclePhases {
+		if key == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// phaseStep returns how far a phase key (as from divisionPhaseKey) sits
+// along the main lifecycle, out of the phases before rescue. Rescue
+// counts as fully through; unknown phases count as not started.
+func phaseStep(key string) (step, total int) {
+	total = len(lifecyclePhases) - 1
+	switch key {
+	case "completed", "rescue":
+		return total, total
+	}
+	for i, phase := range lifecyclePhases[:total] {
+		if key == phase {
+			return i + 1, total
+		}
+	}
+	return 0, total
+}
+
+// phaseColor picks the theme color used to draw a phase key.
+func phaseColor(t *theme.Theme, key string) lipgloss.Color {
+	switch key {
+	case "design":
+		return t.Secondary
+	case "plan":
+		return t.Primary
+	case "generation":
+		return t.Accent
+	case "testing":
+		return t.Warning
+	case "deployment", "monitoring", "completed":
+		return t.Success
+	case "rescue":
+		return t.Error
+	default:
+		return t.TextMuted
+	}
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizePhase_Canonical(t *testing.T) {
+	for _, phase := range lifecyclePhases {
+		got, err := normalizePhase(phase)
+		if err != nil {
+			t.Fatalf("normalizePhase(%q) error = %v", phase, err)
+		}
+		if got != phase {
+			t.Errorf("normalizePhase(%q) = %q, want %q", phase, got, phase)
+		}
+	}
+}
+
+func TestNormalizePhase_Aliases(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"dna", "design"},
+		{"Discovery", "design"},
+		{"anp", "plan"},
+		{"tni", "testing"},
+		{"dno", "deployment"},
+		{" DEPLOY ", "deployment"},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizePhase(tt.input)
+		if err != nil {
+			t.Fatalf("normalizePhase(%q) error = %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("normalizePhase(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizePhase_Unknown(t *testing.T) {
+	_, err := normalizePhase("arcitecture")
+	if err == nil {
+		t.Fatal("normalizePhase(arcitecture) should return an error")
+	}
+	if !strings.Contains(err.Error(), "design, plan") {
+		t.Errorf("error should list valid phases, got %q", err.Error())
+	}
+}
+
+func TestPhaseStep(t *testing.T) {
+	tests := []struct {
+		key  string
+		want int
+	}{
+		{"design", 1},
+		{"testing", 4},
+		{"monitoring", 6},
+		{"rescue", 6},
+		{"completed", 6},
+		{"initiated", 0},
+	}
+	for _, tt := range tests {
+		step, total := phaseStep(tt.key)
+		if step != tt.want || total != 6 {
+			t.Errorf("phaseStep(%q) = %d/%d, want %d/6", tt.key, step, total, tt.want)
+		}
+	}
+}
+
+func TestFormatDepartmentPhase(t *testing.T) {
+	tests := map[string]string{
+		"design":     "Design",
+		"GENERATION": "Generation",
+		"completed":  "Completed",
+		"custom":     "custom",
+		"":           "",
+	}
+	for in, want := range tests {
+		if got := formatDepartmentPhase(in); got != want {
+			t.Errorf("formatDepartmentPhase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// PinMsg tells the app to pin context, or list pins when empty.
+type PinMsg struct {
+	Text string
+	Last bool // pin the last assistant response
+}
+
+// UnpinMsg tells the app to remove a pin (1-based), or all pins.
+type UnpinMsg struct {
+	Index int
+	All   bool
+}
+
+// PinCmd pins context that is sent with every message.
+type PinCmd struct{}
+
+func (c *PinCmd) Name() string        { return "pin" }
+func (c *PinCmd) Aliases() []string   { return nil }
+func (c *PinCmd) Description() string { return "Pin context to every message (/pin <text>|--last)" }
+
+func (c *PinCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		if len(args) == 1 && (args[0] == "--last" || args[0] == "-l") {
+			return PinMsg{Last: true}
+		}
+		return PinMsg{Text: strings.Join(args, " ")}
+	}
+}
+
+// UnpinCmd removes pinned context.
+type UnpinCmd struct{}
+
+func (c *UnpinCmd) Name() string        { return "unpin" }
+func (c *UnpinCmd) Aliases() []string   { return nil }
+func (c *UnpinCmd) Description() string { return "Remove pinned context (/unpin [n|all])" }
+
+func (c *UnpinCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		if len(args) == 0 || args[0] == "all" {
+			return UnpinMsg{All: len(args) > 0}
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Usage: /unpin [number|all]")}
+		}
+		return UnpinMsg{Index: n}
+	}
+}
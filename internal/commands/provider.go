@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"fmt"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/secret"
 )
 
 // ProviderCmd manages LLM provider configuration.
@@ -23,6 +25,8 @@ func (c *ProviderCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return c.addProvider(args[1:], ctx)
 	case "remove", "rm":
 		return c.removeProvider(args[1:], ctx)
+	case "test":
+		return c.testProvider(args[1:], ctx)
 	case "help":
 		return c.showHelp(ctx)
 	default:
@@ -57,7 +61,10 @@ func (c *ProviderCmd) listProviders(ctx *Context) tea.Cmd {
 			b.WriteString(s.Subtle.Render(" (" + p.Type + ") "))
 			b.WriteString(status)
 			if p.URL != "" {
-				b.WriteString(s.Subtle.Render(" " + p.URL))
+				b.WriteString(s.Subtle.Render(" " + secret.MaskURL(p.URL)))
+			}
+			if p.APIKey != "" {
+				b.WriteString(s.Subtle.Render(" key " + secret.Mask(p.APIKey)))
 			}
 			b.WriteString("\n")
 		}
@@ -67,6 +74,8 @@ func (c *ProviderCmd) listProviders(ctx *Context) tea.Cmd {
 		b.WriteString("\n")
 		b.WriteString(s.Subtle.Render("  /provider remove <name>     Remove provider"))
 		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render("  /provider test <name>       Check credentials"))
+		b.WriteString("\n")
 		b.WriteString(s.Subtle.Render("  /provider help              How to obtain API keys"))
 		b.WriteString("\n\n")
 		b.WriteString(s.Subtle.Render("  Types: anthropic, openai, google, mistral, groq, together"))
@@ -77,6 +86,12 @@ func (c *ProviderCmd) listProviders(ctx *Context) tea.Cmd {
 	}
 }
 
+// ProvidersChangedMsg reports a provider was added or removed. The chat
+// shows Notice and re-queries its models so new ones appear right away.
+type ProvidersChangedMsg struct {
+	Notice string
+}
+
 // providerDefaults maps shorthand types to their canonical type and default URL.
 type providerDefaults struct {
 	name     string
@@ -114,9 +129,10 @@ func (c *ProviderCmd) addProvider(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to add provider: " + err.Error())}
 		}
 
-		msg := s.StatusOK.Render("Added " + defaults.name + " provider (" + defaults.apiType + ")")
+		msg := s.StatusOK.Render("Added " + defaults.name + " provider (" + defaults.apiType + ", key " + secret.Mask(apiKey) + ")")
+		msg += "\n" + s.Subtle.Render("Run /provider test "+defaults.name+" to check the key.")
 		msg += "\n" + s.Error.Render("⚠ You are responsible for usage costs. Set spending limits at provider dashboard!")
-		return InjectSystemMsg{Content: msg}
+		return ProvidersChangedMsg{Notice: msg}
 	}
 }
 
@@ -134,8 +150,81 @@ func (c *ProviderCmd) removeProvider(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to remove provider: " + err.Error())}
 		}
 
-		return InjectSystemMsg{Content: s.StatusOK.Render("Removed provider: " + name)}
+		return ProvidersChangedMsg{Notice: s.StatusOK.Render("Removed provider: " + name)}
+	}
+}
+
+func (c *ProviderCmd) testProvider(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		if len(args) == 0 {
+			return InjectSystemMsg{Content: s.Subtle.Render("Usage: /provider test <name>")}
+		}
+
+		name := strings.ToLower(args[0])
+		result, err := ctx.Client.TestProvider(name)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to test provider: " + err.Error())}
+		}
+
+		var b strings.Builder
+		b.WriteString(s.CardTitle.Render("Provider Test: " + name))
+		b.WriteString("\n\n")
+
+		if result.OK {
+			b.WriteString("  " + s.StatusOK.Render("✓ PASS") + s.Subtle.Render("  credentials accepted"))
+		} else {
+			b.WriteString("  " + s.Error.Render("✗ FAIL") + "  " + s.Bold.Render(providerFailureReason(result.Reason, result.Error)))
+		}
+		b.WriteString("\n")
+
+		if result.KeyHint != "" {
+			b.WriteString(s.CardLabel.Render("  Key:     ") + s.CardValue.Render(secret.Mask(result.KeyHint)) + "\n")
+		}
+		if result.Model != "" {
+			b.WriteString(s.CardLabel.Render("  Model:   ") + s.CardValue.Render(result.Model) + "\n")
+		}
+		if result.LatencyMs > 0 {
+			b.WriteString(s.CardLabel.Render("  Latency: ") + s.CardValue.Render(fmt.Sprintf("%dms", result.LatencyMs)) + "\n")
+		}
+		if !result.OK && result.Error != "" {
+			b.WriteString(s.CardLabel.Render("  Error:   ") + s.Subtle.Render(result.Error) + "\n")
+		}
+
+		return InjectSystemMsg{Content: strings.TrimRight(b.String(), "\n")}
+	}
+}
+
+// providerFailureReason turns a daemon reason code (or, failing that, the raw
+// error text) into a short human explanation.
+func providerFailureReason(reason, errText string) string {
+	switch strings.ToLower(reason) {
+	case "auth", "unauthorized", "invalid_key":
+		return "bad API key"
+	case "network", "timeout", "unreachable":
+		return "network error"
+	case "rate_limit", "rate_limited", "quota":
+		return "rate-limited"
+	case "not_found":
+		return "provider not configured"
+	}
+
+	e := strings.ToLower(errText)
+	switch {
+	case strings.Contains(e, "401"), strings.Contains(e, "403"),
+		strings.Contains(e, "unauthorized"), strings.Contains(e, "invalid api key"),
+		strings.Contains(e, "authentication"):
+		return "bad API key"
+	case strings.Contains(e, "429"), strings.Contains(e, "rate limit"), strings.Contains(e, "quota"):
+		return "rate-limited"
+	case strings.Contains(e, "timeout"), strings.Contains(e, "connection refused"),
+		strings.Contains(e, "no such host"), strings.Contains(e, "network"):
+		return "network error"
+	case strings.Contains(e, "not found"):
+		return "provider not configured"
 	}
+	return "validation failed"
 }
 
 func (c *ProviderCmd) showHelp(ctx *Context) tea.Cmd {
@@ -0,0 +1,24 @@
+package commands
+
+import "testing"
+
+func TestProviderFailureReason(t *testing.T) {
+	tests := []struct {
+		reason string
+		err    string
+		want   string
+	}{
+		{"auth", "", "bad API key"},
+		{"rate_limit", "", "rate-limited"},
+		{"", "upstream returned 401 Unauthorized", "bad API key"},
+		{"", "HTTP 429: slow down", "rate-limited"},
+		{"", "dial tcp: lookup api.openai.com: no such host", "network error"},
+		{"", "something odd", "validation failed"},
+	}
+
+	for _, tt := range tests {
+		if got := providerFailureReason(tt.reason, tt.err); got != tt.want {
+			t.Errorf("providerFailureReason(%q, %q) = %q, want %q", tt.reason, tt.err, got, tt.want)
+		}
+	}
+}
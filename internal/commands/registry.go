@@ -25,15 +25,19 @@ func NewRegistry() *Registry {
 	r.Register(&HelpCmd{registry: r})
 	r.Register(&HistoryCmd{})
 	r.Register(&CdCmd{})
+	r.Register(&PwdCmd{})
 	r.Register(&ClearCmd{})
 	r.Register(&DeleteCmd{})
+	r.Register(&DiffCmd{})
 	r.Register(&QuitCmd{})
 	r.Register(&StatusCmd{})
 	r.Register(&HealthCmd{})
 	r.Register(&GeoCmd{})
 	r.Register(&ModelsCmd{})
 	r.Register(&ModelCmd{})
+	r.Register(&CompareCmd{})
 	r.Register(&LoadCmd{})
+	r.Register(&RecoverCmd{})
 	r.Register(&MeCmd{})
 	r.Register(&NewCmd{})
 	r.Register(&BrowseCmd{})
@@ -42,10 +46,14 @@ func NewRegistry() *Registry {
 	r.Register(&EditCmd{})
 	r.Register(&FindCmd{})
 	r.Register(&PairCmd{})
+	r.Register(&PinCmd{})
+	r.Register(&UnpinCmd{})
 	r.Register(&ProjectCmd{})
 	r.Register(&SaveCmd{})
+	r.Register(&ImportCmd{})
 	r.Register(&SubscriptionsCmd{})
 	r.Register(&SystemCmd{})
+	r.Register(&TagCmd{})
 	r.Register(&ThemeCmd{})
 	r.Register(&ToolsCmd{})
 	r.Register(&LLMToolsCmd{})
@@ -60,7 +68,9 @@ func NewRegistry() *Registry {
 	r.Register(&DepartmentsCmd{})
 	r.Register(&AgentsCmd{})
 	r.Register(&CostCmd{})
+	r.Register(&StatsCmd{})
 	r.Register(&StudioCmd{})
+	r.Register(&KeysCmd{})
 
 	return r
 }
@@ -7,26 +7,36 @@ import (
 	"github.com/hecate-social/hecate-tui/internal/config"
 )
 
-// RoleCmd switches between ALC roles (DnA, AnP, TnI, DnO).
+// RoleCmd switches between ALC roles (DnA, AnP, TnI, DnO, plus custom
+// roles from the config) and manages the custom ones.
 type RoleCmd struct{}
 
-func (c *RoleCmd) Name() string        { return "roles" }
-func (c *RoleCmd) Aliases() []string   { return []string{"role", "r"} }
-func (c *RoleCmd) Description() string { return "Switch ALC role (/roles <dna|anp|tni|dno>)" }
+func (c *RoleCmd) Name() string      { return "roles" }
+func (c *RoleCmd) Aliases() []string { return []string{"role", "r"} }
+func (c *RoleCmd) Description() string {
+	return "Switch ALC role (/roles <role> | add <name> <display> -- <prompt> | remove <name>)"
+}
 
 func (c *RoleCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	if len(args) == 0 || args[0] == "list" {
 		return c.listRoles(ctx)
 	}
 
+	switch strings.ToLower(args[0]) {
+	case "add":
+		return c.addRole(args[1:], ctx)
+	case "remove", "rm":
+		return c.removeRole(args[1:], ctx)
+	}
+
 	role := strings.ToLower(args[0])
 
 	// Validate role
-	if _, ok := config.RoleInfo[role]; !ok {
+	if _, ok := findRole(ctx, role); !ok {
 		return func() tea.Msg {
 			return InjectSystemMsg{
 				Content: ctx.Styles.Error.Render("Unknown role: "+role) +
-					"\n" + ctx.Styles.Subtle.Render("Available: dna, anp, tni, dno"),
+					"\n" + ctx.Styles.Subtle.Render("Available: "+strings.Join(roleNames(ctx), ", ")),
 			}
 		}
 	}
@@ -36,6 +46,87 @@ func (c *RoleCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 }
 
+// roles returns the configured roles, or the built-ins if the studio
+// doesn't provide them.
+func roles(ctx *Context) []config.Role {
+	if ctx.GetRoles != nil {
+		return ctx.GetRoles()
+	}
+	return config.Config{}.Roles()
+}
+
+func findRole(ctx *Context, name string) (config.Role, bool) {
+	for _, r := range roles(ctx) {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return config.Role{}, false
+}
+
+func roleNames(ctx *Context) []string {
+	var names []string
+	for _, r := range roles(ctx) {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// parseRoleAdd splits "<name> <display name...> -- <prompt...>". The
+// display name is optional.
+func parseRoleAdd(args []string) (config.CustomRole, bool) {
+	sep := -1
+	for i, a := range args {
+		if a == "--" {
+			sep = i
+			break
+		}
+	}
+	if sep < 1 || sep == len(args)-1 {
+		return config.CustomRole{}, false
+	}
+	return config.CustomRole{
+		Name:        args[0],
+		DisplayName: strings.Join(args[1:sep], " "),
+		Prompt:      strings.Join(args[sep+1:], " "),
+	}, true
+}
+
+func (c *RoleCmd) addRole(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		role, ok := parseRoleAdd(args)
+		if !ok {
+			return InjectSystemMsg{Content: s.Error.Render("Usage: /roles add <name> [display name] -- <prompt>")}
+		}
+		if ctx.AddRole == nil {
+			return InjectSystemMsg{Content: s.Error.Render("Roles can't be changed here.")}
+		}
+		if err := ctx.AddRole(role); err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to add role: " + err.Error())}
+		}
+		return InjectSystemMsg{Content: s.StatusOK.Render("Added role "+strings.ToLower(role.Name)) +
+			"\n" + s.Subtle.Render("Use /roles "+strings.ToLower(role.Name)+" to switch to it.")}
+	}
+}
+
+func (c *RoleCmd) removeRole(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		if len(args) != 1 {
+			return InjectSystemMsg{Content: s.Error.Render("Usage: /roles remove <name>")}
+		}
+		if ctx.RemoveRole == nil {
+			return InjectSystemMsg{Content: s.Error.Render("Roles can't be changed here.")}
+		}
+		name := strings.ToLower(args[0])
+		if err := ctx.RemoveRole(name); err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to remove role: " + err.Error())}
+		}
+		return InjectSystemMsg{Content: s.StatusOK.Render("Removed role " + name)}
+	}
+}
+
 func (c *RoleCmd) listRoles(ctx *Context) tea.Cmd {
 	return func() tea.Msg {
 		s := ctx.Styles
@@ -48,21 +139,29 @@ func (c *RoleCmd) listRoles(ctx *Context) tea.Cmd {
 		b.WriteString(s.CardTitle.Render("ALC Roles"))
 		b.WriteString("\n\n")
 
-		// Order: dna, anp, tni, dno
-		roles := []string{"dna", "anp", "tni", "dno"}
-		for _, role := range roles {
-			info := config.RoleInfo[role]
+		if r, ok := findRole(ctx, activeRole); ok {
+			b.WriteString(s.CardLabel.Render("Active: "))
+			b.WriteString(s.CardValue.Render(config.RoleTag(activeRole) + " — " + r.DisplayName))
+		} else {
+			b.WriteString(s.Subtle.Render("No role active."))
+		}
+		b.WriteString("\n\n")
+
+		for _, r := range roles(ctx) {
 			marker := "  "
-			if role == activeRole {
+			if r.Name == activeRole {
 				marker = "● "
 			}
-			b.WriteString(s.Bold.Render(marker + role))
-			b.WriteString(s.Subtle.Render("  " + info.DisplayName))
+			b.WriteString(s.Bold.Render(marker + r.Name))
+			b.WriteString(s.Subtle.Render("  " + r.DisplayName))
+			if !r.Builtin {
+				b.WriteString(s.Subtle.Render("  (custom)"))
+			}
 			b.WriteString("\n")
 		}
 
 		b.WriteString("\n")
-		b.WriteString(s.Subtle.Render("  Use /roles <code> to switch"))
+		b.WriteString(s.Subtle.Render("  Use /roles <name> to switch, /roles add <name> [display] -- <prompt> to add"))
 		b.WriteString("\n\n")
 
 		// Show descriptions
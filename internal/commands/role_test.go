@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRoleAdd(t *testing.T) {
+	tests := []struct {
+		in                    string
+		ok                    bool
+		name, display, prompt string
+	}{
+		{"sec Security Review -- Review for vulnerabilities.", true, "sec", "Security Review", "Review for vulnerabilities."},
+		{"sec -- Review it.", true, "sec", "", "Review it."},
+		{"sec Security Review", false, "", "", ""},
+		{"-- prompt only", false, "", "", ""},
+		{"sec --", false, "", "", ""},
+	}
+	for _, tt := range tests {
+		got, ok := parseRoleAdd(strings.Fields(tt.in))
+		if ok != tt.ok {
+			t.Errorf("parseRoleAdd(%q) ok = %v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && (got.Name != tt.name || got.DisplayName != tt.display || got.Prompt != tt.prompt) {
+			t.Errorf("parseRoleAdd(%q) = %+v", tt.in, got)
+		}
+	}
+}
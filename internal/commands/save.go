@@ -14,7 +14,7 @@ type SaveCmd struct{}
 
 func (c *SaveCmd) Name() string        { return "save" }
 func (c *SaveCmd) Aliases() []string   { return []string{"w"} }
-func (c *SaveCmd) Description() string { return "Save chat transcript (/save [filename])" }
+func (c *SaveCmd) Description() string { return "Save transcript (/save [-f md|json|html] [file])" }
 
 func (c *SaveCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	return func() tea.Msg {
@@ -25,42 +25,39 @@ func (c *SaveCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Subtle.Render("No messages to save.")}
 		}
 
-		// Determine filename
-		filename := ""
-		if len(args) > 0 {
-			filename = strings.Join(args, " ")
-		} else {
-			filename = fmt.Sprintf("hecate-chat-%s.md", time.Now().Format("2006-01-02-150405"))
+		format, filename := "", ""
+		var rest []string
+		for i := 0; i < len(args); i++ {
+			switch {
+			case (args[i] == "--format" || args[i] == "-f") && i+1 < len(args):
+				format = args[i+1]
+				i++
+			case strings.HasPrefix(args[i], "--format="):
+				format = strings.TrimPrefix(args[i], "--format=")
+			default:
+				rest = append(rest, args[i])
+			}
 		}
+		filename = strings.Join(rest, " ")
 
-		// Build markdown content
-		var b strings.Builder
-		b.WriteString("# Hecate Chat Transcript\n")
-		fmt.Fprintf(&b, "*Exported: %s*\n\n", time.Now().Format("2006-01-02 15:04:05"))
-		b.WriteString("---\n\n")
+		format, err := exportFormat(format, filename)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render(err.Error())}
+		}
 
-		for _, msg := range messages {
-			timestamp := ""
-			if msg.Time != "" {
-				timestamp = " (" + msg.Time + ")"
-			}
+		now := time.Now()
+		if filename == "" {
+			filename = fmt.Sprintf("hecate-chat-%s.%s", now.Format("2006-01-02-150405"), format)
+		}
 
-			switch msg.Role {
-			case "user":
-				b.WriteString("### You" + timestamp + "\n\n")
-				b.WriteString(msg.Content + "\n\n")
-			case "assistant":
-				b.WriteString("### Hecate" + timestamp + "\n\n")
-				b.WriteString(msg.Content + "\n\n")
-			case "system":
-				b.WriteString("---\n\n")
-				b.WriteString("*System: " + firstLine(msg.Content) + "*\n\n")
+		data, err := exporters[format](redactExport(messages), now)
+		if err != nil {
+			return InjectSystemMsg{
+				Content: s.Error.Render("Failed to export: " + err.Error()),
 			}
 		}
 
-		b.WriteString("---\n*End of transcript*\n")
-
-		err := os.WriteFile(filename, []byte(b.String()), 0644)
+		err = os.WriteFile(filename, data, 0644)
 		if err != nil {
 			return InjectSystemMsg{
 				Content: s.Error.Render("Failed to save: " + err.Error()),
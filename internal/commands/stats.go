@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/chat"
+	"github.com/hecate-social/hecate-tui/internal/config"
+)
+
+// StatsCmd shows statistics for the current chat session.
+type StatsCmd struct{}
+
+func (c *StatsCmd) Name() string        { return "stats" }
+func (c *StatsCmd) Aliases() []string   { return nil }
+func (c *StatsCmd) Description() string { return "Show session token and speed statistics" }
+
+func (c *StatsCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		if ctx.GetSessionStats == nil {
+			return InjectSystemMsg{Content: s.Subtle.Render("Session stats are only available in the LLM studio.")}
+		}
+		stats := ctx.GetSessionStats()
+
+		var b strings.Builder
+		b.WriteString(s.CardTitle.Render("Session Stats"))
+		b.WriteString("\n\n")
+
+		b.WriteString(s.CardLabel.Render("Tokens:   "))
+		b.WriteString(s.Bold.Render(formatTokens(int64(stats.TotalTokens))))
+		if prompt := promptTokens(stats.Turns); prompt > 0 {
+			b.WriteString(s.Subtle.Render(" out, " + formatTokens(int64(prompt)) + " in"))
+		}
+		b.WriteString("\n")
+
+		b.WriteString(s.CardLabel.Render("Messages: "))
+		b.WriteString(s.CardValue.Render(formatRoleCounts(stats.Roles)))
+		b.WriteString("\n")
+
+		b.WriteString(s.CardLabel.Render("Turns:    "))
+		b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", len(stats.Turns))))
+		b.WriteString("\n")
+
+		if avg, fastest, slowest, ok := turnSpeeds(stats.Turns); ok {
+			b.WriteString(s.CardLabel.Render("Average:  "))
+			b.WriteString(s.CardValue.Render(fmt.Sprintf("%.1f tok/s", avg)))
+			b.WriteString("\n")
+			b.WriteString(s.CardLabel.Render("Fastest:  "))
+			b.WriteString(s.CardValue.Render(describeTurn(fastest)))
+			b.WriteString("\n")
+			b.WriteString(s.CardLabel.Render("Slowest:  "))
+			b.WriteString(s.CardValue.Render(describeTurn(slowest)))
+			b.WriteString("\n")
+		}
+
+		cost, priced, unpriced := estimateCost(stats.Turns)
+		if priced > 0 {
+			b.WriteString(s.CardLabel.Render("Est. cost: "))
+			b.WriteString(s.Bold.Render(formatCost(cost)))
+			b.WriteString("\n")
+		}
+		if unpriced > 0 {
+			b.WriteString(s.Subtle.Render(fmt.Sprintf(
+				"\n%d paid turn(s) have no price; add them under [pricing] in %s",
+				unpriced, config.DefaultPath())))
+		}
+
+		return InjectSystemMsg{Content: strings.TrimRight(b.String(), "\n")}
+	}
+}
+
+// formatRoleCounts renders "3 user · 3 assistant · 1 system".
+func formatRoleCounts(roles map[string]int) string {
+	if len(roles) == 0 {
+		return "none"
+	}
+	order := []string{"user", "assistant", "tool", "system"}
+	seen := make(map[string]bool)
+	var parts []string
+	for _, role := range order {
+		if n := roles[role]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, role))
+		}
+		seen[role] = true
+	}
+	var rest []string
+	for role := range roles {
+		if !seen[role] {
+			rest = append(rest, role)
+		}
+	}
+	sort.Strings(rest)
+	for _, role := range rest {
+		parts = append(parts, fmt.Sprintf("%d %s", roles[role], role))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// turnSpeeds returns the average speed across turns with timing data, and
+// the fastest and slowest of them.
+func turnSpeeds(turns []chat.TurnStats) (avg float64, fastest, slowest chat.TurnStats, ok bool) {
+	var total float64
+	n := 0
+	for _, t := range turns {
+		sp := t.Speed()
+		if sp <= 0 {
+			continue
+		}
+		if n == 0 || sp > fastest.Speed() {
+			fastest = t
+		}
+		if n == 0 || sp < slowest.Speed() {
+			slowest = t
+		}
+		total += sp
+		n++
+	}
+	if n == 0 {
+		return 0, fastest, slowest, false
+	}
+	return total / float64(n), fastest, slowest, true
+}
+
+func describeTurn(t chat.TurnStats) string {
+	return fmt.Sprintf("%.1f tok/s (%d tokens in %.1fs, %s)", t.Speed(), t.Tokens, t.Duration.Seconds(), t.Model)
+}
+
+// estimateCost totals the estimated spend of paid turns. Local turns are
+// free; paid turns without a known price are counted in unpriced.
+func estimateCost(turns []chat.TurnStats) (cost float64, priced, unpriced int) {
+	for _, t := range turns {
+		switch {
+		case !t.Paid():
+		case t.Priced:
+			cost += t.Cost
+			priced++
+		default:
+			unpriced++
+		}
+	}
+	return cost, priced, unpriced
+}
+
+// promptTokens totals the prompt tokens the daemon reported.
+func promptTokens(turns []chat.TurnStats) int {
+	total := 0
+	for _, t := range turns {
+		total += t.PromptTokens
+	}
+	return total
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/chat"
+)
+
+func TestTurnSpeeds(t *testing.T) {
+	turns := []chat.TurnStats{
+		{Model: "a", Tokens: 100, Duration: 10 * time.Second},
+		{Model: "b", Tokens: 100, Duration: 2 * time.Second},
+		{Model: "c", Tokens: 50}, // no timing, ignored
+	}
+
+	avg, fastest, slowest, ok := turnSpeeds(turns)
+	if !ok {
+		t.Fatal("turnSpeeds() ok = false, want true")
+	}
+	if avg != 30 {
+		t.Errorf("avg = %v, want 30", avg)
+	}
+	if fastest.Model != "b" || slowest.Model != "a" {
+		t.Errorf("fastest/slowest = %s/%s, want b/a", fastest.Model, slowest.Model)
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	turns := []chat.TurnStats{
+		{Model: "gpt-4o", Provider: "openai", Tokens: 2000, Cost: 0.02, Priced: true},
+		{Model: "llama3", Provider: "ollama", Tokens: 5000},
+		{Model: "mystery", Provider: "anthropic", Tokens: 100},
+	}
+
+	cost, priced, unpriced := estimateCost(turns)
+	if cost != 0.02 || priced != 1 || unpriced != 1 {
+		t.Errorf("estimateCost() = (%v, %d, %d), want (0.02, 1, 1)", cost, priced, unpriced)
+	}
+}
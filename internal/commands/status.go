@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/secret"
 )
 
 // StatusCmd shows daemon status as an inline card.
@@ -12,52 +14,123 @@ type StatusCmd struct{}
 
 func (c *StatusCmd) Name() string        { return "status" }
 func (c *StatusCmd) Aliases() []string   { return nil }
-func (c *StatusCmd) Description() string { return "Show daemon status" }
+func (c *StatusCmd) Description() string { return "Show daemon status (--watch to live-refresh)" }
+
+// StatusWatchMsg asks the studio to show a status card that refreshes in place.
+type StatusWatchMsg struct{}
 
 func (c *StatusCmd) Execute(args []string, ctx *Context) tea.Cmd {
-	return func() tea.Msg {
-		s := ctx.Styles
+	for _, arg := range args {
+		if arg == "--watch" || arg == "-w" {
+			return func() tea.Msg { return StatusWatchMsg{} }
+		}
+	}
 
+	return func() tea.Msg {
 		health, err := ctx.Client.GetHealth()
 		if err != nil {
-			return InjectSystemMsg{Content: s.Error.Render("Failed to get status: " + err.Error())}
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render("Failed to get status: " + err.Error())}
 		}
+		return InjectSystemMsg{Content: renderStatusCard(ctx, health)}
+	}
+}
+
+// RenderStatusWatch builds the live status card shown by /status --watch.
+// Unlike the one-shot card, a failed health check is rendered as a state
+// rather than an error, since waiting for the daemon is the point.
+func RenderStatusWatch(ctx *Context) string {
+	s := ctx.Styles
+	var b strings.Builder
 
-		var b strings.Builder
+	health, err := ctx.Client.GetHealth()
+	if err != nil {
 		b.WriteString(s.CardTitle.Render("Daemon Status"))
 		b.WriteString("\n\n")
-
-		// Status indicator
-		statusIcon := "●"
-		statusStyle := s.StatusOK
-		switch health.Status {
-		case "degraded":
-			statusStyle = s.StatusWarning
-		case "error", "unhealthy":
-			statusStyle = s.StatusError
-		}
 		b.WriteString(s.CardLabel.Render("Daemon: "))
-		b.WriteString(statusStyle.Render(statusIcon + " " + health.Status))
+		b.WriteString(s.StatusError.Render("● unreachable"))
 		b.WriteString("\n")
-
-		b.WriteString(s.CardLabel.Render("Version: "))
-		b.WriteString(s.CardValue.Render(health.Version))
+		b.WriteString(s.Subtle.Render(err.Error()))
 		b.WriteString("\n")
+	} else {
+		b.WriteString(renderStatusCard(ctx, health))
+	}
 
-		b.WriteString(s.CardLabel.Render("Uptime: "))
-		b.WriteString(s.CardValue.Render(formatUptime(health.UptimeSeconds)))
+	b.WriteString(s.CardLabel.Render("Transport: "))
+	b.WriteString(s.CardValue.Render(statusTransport(ctx)))
+	b.WriteString("\n")
+	if ctx.HTTPUrl != "" {
+		b.WriteString(s.CardLabel.Render("Auth: "))
+		b.WriteString(s.CardValue.Render(authSummary(ctx.AuthToken)))
 		b.WriteString("\n")
+	}
 
-		// Mesh status
-		identity, identErr := ctx.Client.GetIdentity()
-		if identErr == nil && identity != nil {
-			b.WriteString(s.CardLabel.Render("Identity: "))
-			b.WriteString(s.CardValue.Render(identity.Identity))
+	if err == nil {
+		if models, mErr := ctx.Client.ListModels(); mErr == nil {
+			b.WriteString(s.CardLabel.Render("Models: "))
+			b.WriteString(s.CardValue.Render(fmt.Sprintf("%d", len(models))))
 			b.WriteString("\n")
 		}
+	}
+
+	return b.String()
+}
+
+// renderStatusCard renders the daemon health card shared by /status and --watch.
+func renderStatusCard(ctx *Context, health *client.Health) string {
+	s := ctx.Styles
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Daemon Status"))
+	b.WriteString("\n\n")
+
+	// Status indicator
+	statusIcon := "●"
+	statusStyle := s.StatusOK
+	switch health.Status {
+	case "degraded":
+		statusStyle = s.StatusWarning
+	case "error", "unhealthy":
+		statusStyle = s.StatusError
+	}
+	b.WriteString(s.CardLabel.Render("Daemon: "))
+	b.WriteString(statusStyle.Render(statusIcon + " " + health.Status))
+	b.WriteString("\n")
+
+	b.WriteString(s.CardLabel.Render("Version: "))
+	b.WriteString(s.CardValue.Render(health.Version))
+	b.WriteString("\n")
+
+	b.WriteString(s.CardLabel.Render("Uptime: "))
+	b.WriteString(s.CardValue.Render(formatUptime(health.UptimeSeconds)))
+	b.WriteString("\n")
+
+	// Mesh status
+	identity, identErr := ctx.Client.GetIdentity()
+	if identErr == nil && identity != nil {
+		b.WriteString(s.CardLabel.Render("Identity: "))
+		b.WriteString(s.CardValue.Render(identity.Identity))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// statusTransport describes how the TUI is connected to the daemon.
+func statusTransport(ctx *Context) string {
+	if ctx.SocketPath != "" {
+		return "unix " + ctx.SocketPath
+	}
+	if ctx.HTTPUrl != "" {
+		return ctx.HTTPUrl
+	}
+	return "unknown"
+}
 
-		return InjectSystemMsg{Content: b.String()}
+// authSummary describes the bearer token without revealing it.
+func authSummary(token string) string {
+	if token == "" {
+		return "none"
 	}
+	return "bearer " + secret.Mask(token)
 }
 
 func formatUptime(seconds int) string {
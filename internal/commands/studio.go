@@ -20,16 +20,41 @@ func (c *StudioCmd) Name() string        { return "studio" }
 func (c *StudioCmd) Aliases() []string   { return []string{"s"} }
 func (c *StudioCmd) Description() string { return "Switch studio (/studio <name|number>)" }
 
-var studioNames = []struct {
-	Index int
-	Name  string
-	Short string
-}{
-	{0, "llm", "LLM"},
-	{1, "dev", "Dev"},
-	{2, "ops", "Ops"},
-	{3, "social", "Social"},
-	{4, "arcade", "Arcade"},
+// StudioInfo identifies a studio for /studio.
+type StudioInfo struct {
+	Name      string
+	ShortName string
+	Aliases   []string // older names kept working, e.g. "ops"
+	Icon      string
+}
+
+// findStudio resolves a 1-based number, name, short name or alias to an
+// index.
+func findStudio(studios []StudioInfo, target string) (int, bool) {
+	if n, err := strconv.Atoi(target); err == nil {
+		return n - 1, n >= 1 && n <= len(studios)
+	}
+	for i, s := range studios {
+		if strings.EqualFold(s.Name, target) || strings.EqualFold(s.ShortName, target) {
+			return i, true
+		}
+		for _, alias := range s.Aliases {
+			if strings.EqualFold(alias, target) {
+				return i, true
+			}
+		}
+	}
+	// Unique prefix, e.g. "arc" for Arcade
+	match := -1
+	for i, s := range studios {
+		if strings.HasPrefix(strings.ToLower(s.Name), target) || strings.HasPrefix(strings.ToLower(s.ShortName), target) {
+			if match >= 0 {
+				return 0, false
+			}
+			match = i
+		}
+	}
+	return match, match >= 0
 }
 
 func (c *StudioCmd) Execute(args []string, ctx *Context) tea.Cmd {
@@ -38,21 +63,9 @@ func (c *StudioCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 
 	target := strings.ToLower(args[0])
-
-	// Try numeric index first (1-based)
-	if n, err := strconv.Atoi(target); err == nil && n >= 1 && n <= len(studioNames) {
+	if idx, ok := findStudio(ctx.Studios, target); ok {
 		return func() tea.Msg {
-			return SwitchStudioMsg{Index: n - 1}
-		}
-	}
-
-	// Match by name
-	for _, s := range studioNames {
-		if strings.EqualFold(s.Name, target) || strings.EqualFold(s.Short, target) {
-			idx := s.Index
-			return func() tea.Msg {
-				return SwitchStudioMsg{Index: idx}
-			}
+			return SwitchStudioMsg{Index: idx}
 		}
 	}
 
@@ -70,15 +83,15 @@ func (c *StudioCmd) listStudios(ctx *Context) tea.Cmd {
 		var b strings.Builder
 		b.WriteString(s.CardTitle.Render("Studios"))
 		b.WriteString("\n\n")
-		for _, st := range studioNames {
-			b.WriteString(s.Bold.Render(fmt.Sprintf("  %d. %s", st.Index+1, st.Short)))
+		for i, st := range ctx.Studios {
+			b.WriteString(s.Bold.Render(fmt.Sprintf("  %d. %s %s", i+1, st.Icon, st.ShortName)))
 			b.WriteString(s.Subtle.Render("  " + st.Name))
 			b.WriteString("\n")
 		}
 		b.WriteString("\n")
 		b.WriteString(s.Subtle.Render("  Use /studio <name|number> to switch"))
 		b.WriteString("\n")
-		b.WriteString(s.Subtle.Render("  Or Ctrl+1-5 in Normal mode"))
+		b.WriteString(s.Subtle.Render(fmt.Sprintf("  Or Ctrl+1-%d, or Ctrl+S for the picker, in Normal mode", len(ctx.Studios))))
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
@@ -92,9 +105,10 @@ func (c *StudioCmd) Complete(args []string, ctx *Context) []string {
 		prefix = strings.ToLower(args[0])
 	}
 	var matches []string
-	for _, s := range studioNames {
-		if strings.HasPrefix(strings.ToLower(s.Name), prefix) {
-			matches = append(matches, s.Name)
+	for _, s := range ctx.Studios {
+		name := strings.ToLower(s.ShortName)
+		if strings.HasPrefix(name, prefix) {
+			matches = append(matches, name)
 		}
 	}
 	return matches
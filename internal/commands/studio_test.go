@@ -0,0 +1,35 @@
+package commands
+
+import "testing"
+
+func TestFindStudio(t *testing.T) {
+	studios := []StudioInfo{
+		{Name: "LLM", ShortName: "LLM"},
+		{Name: "DevOps", ShortName: "DevOps", Aliases: []string{"dev"}},
+		{Name: "Node", ShortName: "Node", Aliases: []string{"ops"}},
+		{Name: "Social", ShortName: "Social"},
+		{Name: "Arcade", ShortName: "Arcade"},
+	}
+	tests := []struct {
+		target string
+		want   int
+		ok     bool
+	}{
+		{"1", 0, true},
+		{"5", 4, true},
+		{"6", 0, false},
+		{"devops", 1, true},
+		{"arc", 4, true},
+		{"node", 2, true},
+		{"dev", 1, true},
+		{"ops", 2, true},
+		{"OPS", 2, true},
+		{"x", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := findStudio(studios, tt.target)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("findStudio(%q) = %d, %v; want %d, %v", tt.target, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -4,16 +4,34 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
-// SubscriptionsCmd lists active mesh subscriptions.
+// SubscriptionMuteMsg tells the app to show or hide subscription
+// deliveries in the chat.
+type SubscriptionMuteMsg struct {
+	Muted bool
+}
+
+// SubscriptionsCmd lists, inspects and removes mesh subscriptions.
 type SubscriptionsCmd struct{}
 
 func (c *SubscriptionsCmd) Name() string        { return "subscriptions" }
 func (c *SubscriptionsCmd) Aliases() []string   { return []string{"subs"} }
-func (c *SubscriptionsCmd) Description() string { return "List active mesh subscriptions" }
+func (c *SubscriptionsCmd) Description() string { return "Subscriptions (/subs [id|remove|mute])" }
 
 func (c *SubscriptionsCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	if len(args) > 0 {
+		switch args[0] {
+		case "remove", "rm":
+			return c.remove(args[1:], ctx)
+		case "mute", "unmute":
+			muted := args[0] == "mute"
+			return func() tea.Msg { return SubscriptionMuteMsg{Muted: muted} }
+		}
+	}
+
 	return func() tea.Msg {
 		s := ctx.Styles
 
@@ -22,6 +40,14 @@ func (c *SubscriptionsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to list subscriptions: " + err.Error())}
 		}
 
+		if len(args) > 0 {
+			sub := findSubscription(subs, args[0])
+			if sub == nil {
+				return InjectSystemMsg{Content: s.Error.Render("No subscription matching: " + args[0])}
+			}
+			return InjectSystemMsg{Content: renderSubscriptionDetail(*sub, s)}
+		}
+
 		var b strings.Builder
 		b.WriteString(s.CardTitle.Render("Subscriptions"))
 		b.WriteString("\n\n")
@@ -40,9 +66,18 @@ func (c *SubscriptionsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			b.WriteString(" ")
 			b.WriteString(s.CardValue.Render(sub.SubscriptionID))
 			b.WriteString("\n")
+			if sub.Topic != "" {
+				b.WriteString(s.CardLabel.Render("Topic: "))
+				b.WriteString(" ")
+				b.WriteString(s.CardValue.Render(sub.Topic))
+				b.WriteString("\n")
+			}
 			b.WriteString(s.CardLabel.Render("Since: "))
 			b.WriteString(" ")
 			b.WriteString(s.CardValue.Render(sub.SubscribedAt))
+			if sub.DeliveryCount > 0 {
+				b.WriteString(s.Subtle.Render("  " + itoa(sub.DeliveryCount) + " delivered"))
+			}
 			if i < len(subs)-1 {
 				b.WriteString("\n\n")
 			}
@@ -50,9 +85,99 @@ func (c *SubscriptionsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 
 		b.WriteString("\n\n")
 		b.WriteString(s.Subtle.Render(itoa(len(subs)) + " active subscription(s)"))
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render("/subs <id> for details, /subs remove <id> to unsubscribe, /subs mute to hide deliveries"))
 
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
 
+// remove unsubscribes after confirmation. Without --yes it only shows what
+// would be removed.
+func (c *SubscriptionsCmd) remove(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		var id string
+		confirmed := false
+		for _, a := range args {
+			switch a {
+			case "--yes", "-y":
+				confirmed = true
+			default:
+				id = a
+			}
+		}
+		if id == "" {
+			return InjectSystemMsg{Content: s.Error.Render("Usage: /subs remove <id> [--yes]")}
+		}
+
+		subs, err := ctx.Client.ListSubscriptions()
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to list subscriptions: " + err.Error())}
+		}
+		sub := findSubscription(subs, id)
+		if sub == nil {
+			return InjectSystemMsg{Content: s.Error.Render("No subscription matching: " + id)}
+		}
+
+		if !confirmed {
+			return InjectSystemMsg{Content: s.StatusWarning.Render("This will unsubscribe from "+sub.ServiceMRI+".") +
+				"\n" + s.Subtle.Render("Re-run with --yes to confirm: /subs remove "+sub.SubscriptionID+" --yes")}
+		}
+
+		if err := ctx.Client.Unsubscribe(sub.SubscriptionID); err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to unsubscribe: " + err.Error())}
+		}
+		return InjectSystemMsg{Content: s.StatusOK.Render("Unsubscribed from " + sub.ServiceMRI)}
+	}
+}
+
+// findSubscription matches an exact ID, falling back to a unique ID prefix.
+func findSubscription(subs []client.Subscription, id string) *client.Subscription {
+	var match *client.Subscription
+	for i := range subs {
+		if subs[i].SubscriptionID == id {
+			return &subs[i]
+		}
+		if strings.HasPrefix(subs[i].SubscriptionID, id) {
+			if match != nil {
+				return nil
+			}
+			match = &subs[i]
+		}
+	}
+	return match
+}
+
+func renderSubscriptionDetail(sub client.Subscription, s *theme.Styles) string {
+	orNone := func(v string) string {
+		if v == "" {
+			return s.Subtle.Render("—")
+		}
+		return s.CardValue.Render(v)
+	}
+
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Subscription"))
+	b.WriteString("\n\n")
+	rows := []struct{ label, value string }{
+		{"Service:  ", s.Bold.Render(sub.ServiceMRI)},
+		{"ID:       ", s.CardValue.Render(sub.SubscriptionID)},
+		{"Topic:    ", orNone(sub.Topic)},
+		{"Since:    ", orNone(sub.SubscribedAt)},
+		{"Delivered:", s.CardValue.Render(itoa(sub.DeliveryCount))},
+		{"Last msg: ", orNone(sub.LastMessageAt)},
+	}
+	for _, r := range rows {
+		b.WriteString(s.CardLabel.Render(r.label))
+		b.WriteString(" ")
+		b.WriteString(r.value)
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(s.Subtle.Render("/subs remove " + sub.SubscriptionID + " to unsubscribe"))
+	return b.String()
+}
+
 // itoa is defined in me.go
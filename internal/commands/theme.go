@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"os"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -16,6 +17,7 @@ func (c *ThemeCmd) Description() string { return "Switch theme (/theme <name> or
 
 // SwitchThemeMsg tells the app to switch to a different theme.
 type SwitchThemeMsg struct {
+	Key   string // name in theme.BuiltinThemes, saved to config
 	Theme *theme.Theme
 }
 
@@ -29,6 +31,12 @@ func (c *ThemeCmd) Execute(args []string, ctx *Context) tea.Cmd {
 
 	t, ok := themes[name]
 	if !ok {
+		// A user theme that failed validation isn't listed; say why
+		if _, err := theme.LoadUserTheme(name); err != nil && !os.IsNotExist(err) {
+			return func() tea.Msg {
+				return InjectSystemMsg{Content: ctx.Styles.Error.Render("Invalid theme file: " + err.Error())}
+			}
+		}
 		return func() tea.Msg {
 			var names []string
 			for n := range themes {
@@ -42,7 +50,7 @@ func (c *ThemeCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	}
 
 	return func() tea.Msg {
-		return SwitchThemeMsg{Theme: t}
+		return SwitchThemeMsg{Key: name, Theme: t}
 	}
 }
 
@@ -67,6 +75,8 @@ func (c *ThemeCmd) listThemes(ctx *Context) tea.Cmd {
 
 		b.WriteString("\n")
 		b.WriteString(s.Subtle.Render("  Use /theme <name> to switch"))
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render("  Custom themes: " + theme.UserThemesDir() + "/<name>.toml"))
 
 		return InjectSystemMsg{Content: b.String()}
 	}
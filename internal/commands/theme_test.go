@@ -0,0 +1,15 @@
+package commands
+
+import "testing"
+
+func TestThemeCmd_CarriesKey(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	msg, ok := (&ThemeCmd{}).Execute([]string{"Light"}, &Context{})().(SwitchThemeMsg)
+	if !ok {
+		t.Fatal("expected a SwitchThemeMsg")
+	}
+	if msg.Key != "light" || msg.Theme == nil {
+		t.Errorf("msg = %+v, want the light theme under its key", msg)
+	}
+}
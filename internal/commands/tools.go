@@ -2,10 +2,14 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/config"
+	"github.com/hecate-social/hecate-tui/internal/theme"
 	"github.com/hecate-social/hecate-tui/internal/tools"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 )
 
 // ToolsCmd detects and lists local developer tools.
@@ -16,6 +20,18 @@ func (c *ToolsCmd) Aliases() []string   { return []string{"t"} }
 func (c *ToolsCmd) Description() string { return "Detect installed developer tools" }
 
 func (c *ToolsCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	if len(args) > 0 {
+		switch strings.ToLower(args[0]) {
+		case "detect", "refresh":
+			return c.detect(ctx)
+		case "audit":
+			return c.audit(args[1:], ctx)
+		default:
+			return func() tea.Msg {
+				return InjectSystemMsg{Content: ctx.Styles.Error.Render("Unknown subcommand: " + args[0] + " (usage: /tools [detect|audit [n]])")}
+			}
+		}
+	}
 	return func() tea.Msg {
 		detector := tools.NewDetector()
 		detected := detector.Detect()
@@ -84,3 +100,123 @@ func (c *ToolsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
+
+// detect re-runs tool detection and renders the result as a table.
+func (c *ToolsCmd) detect(ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		detected := tools.NewDetector().Detect()
+		return InjectSystemMsg{Content: renderToolTable(ctx.Styles, detected)}
+	}
+}
+
+// Column widths for the /tools detect table.
+const (
+	toolNameWidth    = 16
+	toolStatusWidth  = 8
+	toolVersionWidth = 28
+)
+
+// renderToolTable draws one row per tool with its status, version and
+// resolved path.
+func renderToolTable(s *theme.Styles, detected []tools.Tool) string {
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Tool Detection"))
+	b.WriteString("\n\n")
+
+	header := padRunes("TOOL", toolNameWidth) + " " +
+		padRunes("STATUS", toolStatusWidth) + " " +
+		padRunes("VERSION", toolVersionWidth) + " PATH"
+	b.WriteString(s.Bold.Render(header))
+	b.WriteString("\n")
+
+	installed := 0
+	for _, t := range detected {
+		name := padRunes(truncateLine(t.Name, toolNameWidth), toolNameWidth)
+		if !t.Installed {
+			b.WriteString(s.Subtle.Render(name + " " + padRunes("missing", toolStatusWidth) + " " +
+				padRunes("-", toolVersionWidth) + " -"))
+			b.WriteString("\n")
+			continue
+		}
+		installed++
+		version := t.Version
+		if version == "" {
+			version = "unknown"
+		}
+		b.WriteString(name + " ")
+		b.WriteString(s.StatusOK.Render(padRunes("found", toolStatusWidth)))
+		b.WriteString(" " + padRunes(truncateLine(version, toolVersionWidth), toolVersionWidth) + " ")
+		b.WriteString(s.Subtle.Render(ui.ShortenHome(t.Path)))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(s.Bold.Render(fmt.Sprintf("%d of %d tools found", installed, len(detected))))
+	return b.String()
+}
+
+// defaultAuditEntries is how many audit entries /tools audit shows.
+const defaultAuditEntries = 20
+
+// audit renders the most recent entries of the tool audit log.
+func (c *ToolsCmd) audit(args []string, ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+		limit := defaultAuditEntries
+		if len(args) > 0 {
+			n, err := strconv.Atoi(args[0])
+			if err != nil || n < 1 {
+				return InjectSystemMsg{Content: s.Error.Render("Usage: /tools audit [count]")}
+			}
+			limit = n
+		}
+
+		entries, err := config.LoadToolAudit(limit)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to read tool audit log: " + err.Error())}
+		}
+		return InjectSystemMsg{Content: renderToolAudit(s, entries)}
+	}
+}
+
+// auditArgsWidth caps the argument preview in /tools audit rows.
+const auditArgsWidth = 60
+
+// renderToolAudit draws audit entries newest first, one call per row with
+// its arguments on the line below.
+func renderToolAudit(s *theme.Styles, entries []config.ToolAuditEntry) string {
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Tool Audit"))
+	b.WriteString("\n\n")
+
+	if len(entries) == 0 {
+		b.WriteString(s.Subtle.Render("No tool calls recorded yet."))
+		return b.String()
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		b.WriteString(s.Subtle.Render(e.At.Local().Format("2006-01-02 15:04:05")))
+		b.WriteString("  ")
+		if e.OK {
+			b.WriteString(s.StatusOK.Render("✓"))
+		} else {
+			b.WriteString(s.StatusError.Render("✗"))
+		}
+		b.WriteString(" " + s.Bold.Render(e.Tool))
+		b.WriteString(s.Subtle.Render(fmt.Sprintf("  %s · %dms", e.Decision, e.DurationMs)))
+		b.WriteString("\n")
+		if e.Args != "" {
+			b.WriteString(s.Subtle.Render("    " + truncateLine(e.Args, auditArgsWidth)))
+			b.WriteString("\n")
+		}
+		if e.Error != "" {
+			b.WriteString(s.Error.Render("    " + truncateLine(e.Error, auditArgsWidth)))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(s.Subtle.Render(fmt.Sprintf("%d entries · %s", len(entries), ui.ShortenHome(config.ToolAuditPath()))))
+	return b.String()
+}
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/theme"
+	"github.com/hecate-social/hecate-tui/internal/tools"
+)
+
+func TestRenderToolTable(t *testing.T) {
+	t.Setenv("HOME", "/home/dev")
+	s := theme.HecateDark().ComputeStyles()
+	out := renderToolTable(s, []tools.Tool{
+		{Name: "Go", Installed: true, Version: "go version go1.22.1", Path: "/home/dev/sdk/go/bin/go"},
+		{Name: "Rebar3"},
+		{Name: "Zig", Installed: true, Path: "/usr/bin/zig"},
+	})
+
+	for _, want := range []string{"TOOL", "PATH", "go1.22.1", "~/sdk/go/bin/go", "missing", "unknown", "/usr/bin/zig", "2 of 3 tools found"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -9,8 +9,12 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/alc"
+	"github.com/hecate-social/hecate-tui/internal/chat"
 	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/scaffold"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 )
 
 // VentureCmd handles all /venture subcommands for business endeavor management.
@@ -23,7 +27,7 @@ func (c *VentureCmd) Description() string { return "Manage business endeavors (V
 // Complete implements Completable for venture argument completion.
 func (c *VentureCmd) Complete(args []string, ctx *Context) []string {
 	// Subcommands
-	subcommands := []string{"init", "new", "list", "ls", "select", "clear", "exit", "archive", "refine-vision", "refine", "rv", "submit-vision", "submit", "sv", "help", "status"}
+	subcommands := []string{"init", "new", "list", "ls", "select", "clear", "exit", "archive", "refine-vision", "refine", "rv", "vision", "submit-vision", "submit", "sv", "scaffold", "help", "status"}
 
 	if len(args) == 0 {
 		return subcommands
@@ -42,10 +46,10 @@ func (c *VentureCmd) Complete(args []string, ctx *Context) []string {
 			// Select needs active venture IDs/names
 			return c.completeVentureIDs(args[1], ctx, false)
 		case "list", "ls":
-			// List can have "all" or "archived" as second arg
+			// List can have "all", "archived" or a page flag as second arg
 			prefix := strings.ToLower(args[1])
 			var matches []string
-			for _, opt := range []string{"all", "archived"} {
+			for _, opt := range []string{"all", "archived", "--page"} {
 				if strings.HasPrefix(opt, prefix) {
 					matches = append(matches, opt)
 				}
@@ -99,9 +103,14 @@ func (c *VentureCmd) completeVentureIDs(prefix string, ctx *Context, includeArch
 }
 
 func (c *VentureCmd) Execute(args []string, ctx *Context) tea.Cmd {
+	args, page, err := extractPage(args)
+	if err != nil {
+		return c.showError(ctx, err.Error())
+	}
+
 	// No args → show current venture or list if none selected
 	if len(args) == 0 {
-		return c.showOrPick(ctx)
+		return c.showOrPick(ctx, page)
 	}
 
 	sub := strings.ToLower(args[0])
@@ -113,7 +122,7 @@ func (c *VentureCmd) Execute(args []string, ctx *Context) tea.Cmd {
 		return c.initiateVenture(args[1:], ctx)
 	case "list", "ls":
 		includeArchived := len(args) > 1 && (args[1] == "all" || args[1] == "archived")
-		return c.listVentures(ctx, includeArchived)
+		return c.listVentures(ctx, includeArchived, page)
 	case "select", "switch", "use":
 		if len(args) < 2 {
 			return c.showError(ctx, "Usage: /venture select <id|name|number>")
@@ -130,10 +139,17 @@ func (c *VentureCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			reason = strings.Join(args[2:], " ")
 		}
 		return c.archiveVenture(args[1], reason, ctx)
+	case "vision":
+		return c.showVision(ctx)
 	case "refine-vision", "refine", "rv":
+		if len(args) > 1 && args[1] == "--show" {
+			return c.showVision(ctx)
+		}
 		return c.refineVision(args[1:], ctx)
 	case "submit-vision", "submit", "sv":
 		return c.submitVision(ctx)
+	case "scaffold":
+		return c.rescaffold(ctx)
 	case "help":
 		return c.showUsage(ctx)
 	default:
@@ -182,16 +198,20 @@ func (c *VentureCmd) showUsage(ctx *Context) tea.Cmd {
 		b.WriteString(row("/venture", "Show current venture status"))
 		b.WriteString(row("/venture status", "Show current venture status"))
 		b.WriteString(row("/venture init <name> [brief]", "Initiate a new venture"))
+		b.WriteString(row("/venture init --preview <path>", "Show what init would create"))
 		b.WriteString(row("/venture archive <venture-id> [reason]", "Archive a venture (soft delete)"))
 		b.WriteString(row("/venture refine-vision", "Open VISION.md for editing"))
+		b.WriteString(row("/venture vision", "Preview rendered VISION.md"))
 		b.WriteString(row("/venture submit-vision", "Submit vision, complete DnA phase"))
+		b.WriteString(row("/venture scaffold", "Recreate missing scaffold files"))
 		b.WriteString(row("/venture list", "List active ventures"))
 		b.WriteString(row("/venture list all", "List all ventures (including archived)"))
+		b.WriteString(row("/venture list --page <n>", "Show another page of a long list"))
 		b.WriteString(row("/venture <id>", "Show specific venture by ID"))
 		b.WriteString("\n")
 
 		// Aliases for vision commands
-		b.WriteString(s.Subtle.Render("Vision aliases: refine/rv, submit/sv"))
+		b.WriteString(s.Subtle.Render("Vision aliases: refine/rv, submit/sv, refine-vision --show"))
 		b.WriteString("\n")
 
 		// Aliases
@@ -214,7 +234,7 @@ func (c *VentureCmd) showCurrentVenture(ctx *Context) tea.Cmd {
 	}
 }
 
-func (c *VentureCmd) listVentures(ctx *Context, includeArchived bool) tea.Cmd {
+func (c *VentureCmd) listVentures(ctx *Context, includeArchived bool, page int) tea.Cmd {
 	return func() tea.Msg {
 		s := ctx.Styles
 
@@ -249,7 +269,8 @@ func (c *VentureCmd) listVentures(ctx *Context, includeArchived bool) tea.Cmd {
 		b.WriteString(s.CardTitle.Render(title))
 		b.WriteString("\n\n")
 
-		for i, venture := range ventures {
+		start, end, page := pageRange(len(ventures), page)
+		for i, venture := range ventures[start:end] {
 			if i > 0 {
 				b.WriteString("\n")
 			}
@@ -268,6 +289,15 @@ func (c *VentureCmd) listVentures(ctx *Context, includeArchived bool) tea.Cmd {
 			}
 		}
 
+		next := "/venture list"
+		if includeArchived {
+			next += " all"
+		}
+		if footer := pageFooter(start, end, len(ventures), page, next); footer != "" {
+			b.WriteString("\n")
+			b.WriteString(s.Subtle.Render(footer))
+		}
+
 		return InjectSystemMsg{Content: b.String()}
 	}
 }
@@ -280,73 +310,194 @@ func (c *VentureCmd) initiateVenture(args []string, ctx *Context) tea.Cmd {
 		}
 	}
 
+	preview := false
+	var rest []string
+	for _, arg := range args {
+		if arg == "--preview" {
+			preview = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	if len(rest) == 0 {
+		return c.showError(ctx, "Usage: /venture init [--preview] <path> [brief]")
+	}
+
 	// With args → create directly (power user mode)
 	// First arg is path (e.g., "my-venture" or "~/projects/my-venture")
 	// Second arg onwards is brief
 	cwd, _ := os.Getwd()
-	path := expandPath(args[0], cwd)
-	name := inferName(path)
+	path := ui.ExpandPath(rest[0], cwd)
+	name := ui.InferName(path)
 	brief := ""
-	if len(args) > 1 {
-		brief = strings.Join(args[1:], " ")
+	if len(rest) > 1 {
+		brief = strings.Join(rest[1:], " ")
 	}
 
+	if preview {
+		return func() tea.Msg {
+			return InjectSystemMsg{Content: renderScaffoldPlan(ctx.Styles, scaffold.PlanScaffold(path), name, brief)}
+		}
+	}
 	return c.doInitiateVenture(path, name, brief, ctx)
 }
 
-// expandPath expands ~ and makes path absolute relative to cwd.
-func expandPath(path, cwd string) string {
-	if path == "" {
-		return cwd
+// renderScaffoldPlan shows what /venture init would do at a path without
+// doing any of it.
+func renderScaffoldPlan(s *theme.Styles, plan scaffold.Plan, name, brief string) string {
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Preview: venture init"))
+	b.WriteString("\n\n")
+	b.WriteString(s.CardLabel.Render(" Path: "))
+	b.WriteString(s.CardValue.Render(plan.Root))
+	if plan.RootExists {
+		b.WriteString(s.Subtle.Render(" (exists)"))
+	} else {
+		b.WriteString(s.Subtle.Render(" (will be created)"))
+	}
+	b.WriteString("\n")
+	b.WriteString(s.CardLabel.Render(" Name: "))
+	b.WriteString(s.CardValue.Render(name))
+	b.WriteString("\n")
+	if brief != "" {
+		b.WriteString(s.CardLabel.Render("Brief: "))
+		b.WriteString(s.CardValue.Render(brief))
+		b.WriteString("\n")
 	}
 
-	// Expand ~
-	if strings.HasPrefix(path, "~") {
-		if home, err := os.UserHomeDir(); err == nil {
-			path = home + path[1:]
+	b.WriteString("\n")
+	b.WriteString(s.Bold.Render("Would scaffold:"))
+	b.WriteString("\n")
+	for _, a := range plan.Actions {
+		switch {
+		case a.Exists && a.Overwrite:
+			b.WriteString(s.StatusWarning.Render("  ~ "))
+			b.WriteString(s.Subtle.Render(a.Name + " (overwritten)"))
+		case a.Exists:
+			b.WriteString(s.Subtle.Render("  · " + a.Name + " (already present, kept)"))
+		default:
+			b.WriteString(s.StatusOK.Render("  + "))
+			b.WriteString(s.Subtle.Render(a.Name))
 		}
+		b.WriteString("\n")
 	}
 
-	// Make absolute if relative
-	if !strings.HasPrefix(path, "/") {
-		path = cwd + "/" + path
-	}
+	b.WriteString("\n")
+	b.WriteString(s.Subtle.Render("Nothing was written and the daemon was not contacted."))
+	return b.String()
+}
 
-	// Clean the path
-	return cleanPath(path)
+// VentureCreatedMsg is sent after a venture is successfully created and scaffolded.
+// It triggers a cd to the new venture directory.
+type VentureCreatedMsg struct {
+	Path    string
+	Message string
 }
 
-// cleanPath normalizes a path (removes . and ..)
-func cleanPath(path string) string {
-	parts := strings.Split(path, "/")
-	var result []string
-	for _, p := range parts {
-		if p == ".." && len(result) > 0 {
-			result = result[:len(result)-1]
-		} else if p != "." && p != "" {
-			result = append(result, p)
-		}
+// VentureInitiatedHeading titles the init report, flagging a scaffold
+// that did not finish.
+func VentureInitiatedHeading(s *theme.Styles, result scaffold.Result) string {
+	if result.Error != nil {
+		return s.StatusWarning.Render("Venture Initiated (scaffold incomplete)")
 	}
-	return "/" + strings.Join(result, "/")
+	return s.StatusOK.Render("Venture Initiated")
 }
 
-// inferName extracts the project name from a path.
-func inferName(path string) string {
-	// Get last non-empty component
-	parts := strings.Split(path, "/")
-	for i := len(parts) - 1; i >= 0; i-- {
-		if parts[i] != "" {
-			return parts[i]
+// RenderScaffoldResult lists what scaffolding created, what it found
+// already in place and any warnings. A hard failure is reported with a
+// pointer to /venture scaffold, since the daemon already has the venture.
+func RenderScaffoldResult(s *theme.Styles, result scaffold.Result) string {
+	var b strings.Builder
+	b.WriteString(s.CardTitle.Render("Scaffolded:"))
+	b.WriteString("\n")
+
+	created := []struct {
+		ok   bool
+		name string
+	}{
+		{result.ManifestCreated, ".hecate/venture.json"},
+		{result.AgentsCloned, ".hecate/agents/"},
+		{result.ReadmeCreated, "README.md"},
+		{result.ChangelogCreated, "CHANGELOG.md"},
+		{result.VisionCreated, "VISION.md"},
+		{result.GitignoreCreated, ".gitignore"},
+		{result.GitInitialized, "git init"},
+		{result.GitCommitted, "git commit"},
+	}
+	for _, item := range created {
+		if item.ok {
+			b.WriteString(s.StatusOK.Render("  ✓ "))
+			b.WriteString(s.Subtle.Render(item.name))
+			b.WriteString("\n")
 		}
 	}
-	return "unnamed"
+
+	for _, name := range result.Existing {
+		b.WriteString(s.Subtle.Render("  · " + name + " (already present)"))
+		b.WriteString("\n")
+	}
+
+	for _, warn := range result.Warnings {
+		b.WriteString("\n")
+		b.WriteString(s.StatusWarning.Render("⚠ " + warn))
+	}
+
+	if result.Error != nil {
+		b.WriteString("\n")
+		b.WriteString(s.StatusError.Render("✗ Local scaffold incomplete: " + result.Error.Error()))
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render("The venture is recorded in the daemon. Fix the problem, then run /venture scaffold to finish (it makes the initial commit if there is none)."))
+	}
+	return strings.TrimRight(b.String(), "\n")
 }
 
-// VentureCreatedMsg is sent after a venture is successfully created and scaffolded.
-// It triggers a cd to the new venture directory.
-type VentureCreatedMsg struct {
-	Path    string
-	Message string
+// rescaffold re-runs scaffolding for the current venture, creating only
+// the files that are missing from its root.
+func (c *VentureCmd) rescaffold(ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		if ctx.GetALCContext == nil {
+			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
+		}
+		state := ctx.GetALCContext()
+		if state == nil || state.Venture == nil {
+			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
+		}
+
+		root, err := ventureRoot(state.Venture)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Cannot determine working directory: " + err.Error())}
+		}
+
+		manifest := scaffold.VentureManifest{
+			VentureID:   state.Venture.ID,
+			Name:        state.Venture.Name,
+			Brief:       state.Venture.Brief,
+			Root:        root,
+			InitiatedBy: client.Actor(),
+		}
+		if !state.Venture.InitiatedAt.IsZero() {
+			manifest.InitiatedAt = state.Venture.InitiatedAt.UnixMilli()
+		}
+		// Prefer the daemon's record when it is reachable
+		if venture, err := ctx.Client.GetVentureByID(state.Venture.ID); err == nil {
+			manifest.Brief = venture.Brief
+			manifest.InitiatedAt = venture.InitiatedAt
+			manifest.InitiatedBy = venture.InitiatedBy
+		}
+
+		result := scaffold.Rescaffold(root, manifest)
+		if result.Error == nil {
+			_ = config.SaveVentureRoot(state.Venture.ID, root)
+		}
+
+		var b strings.Builder
+		b.WriteString(s.Subtle.Render("Root: " + root))
+		b.WriteString("\n\n")
+		b.WriteString(RenderScaffoldResult(s, result))
+		return InjectSystemMsg{Content: b.String()}
+	}
 }
 
 // doInitiateVenture performs the actual venture creation.
@@ -359,7 +510,7 @@ func (c *VentureCmd) doInitiateVenture(path, name, brief string, ctx *Context) t
 		}
 
 		if strings.TrimSpace(name) == "" {
-			name = inferName(path)
+			name = ui.InferName(path)
 		}
 
 		// Create directory if it doesn't exist
@@ -383,9 +534,10 @@ func (c *VentureCmd) doInitiateVenture(path, name, brief string, ctx *Context) t
 		}
 
 		result := scaffold.Scaffold(path, manifest)
+		_ = config.SaveVentureRoot(venture.VentureID, path)
 
 		var b strings.Builder
-		b.WriteString(s.StatusOK.Render("Venture Initiated"))
+		b.WriteString(VentureInitiatedHeading(s, result))
 		b.WriteString("\n\n")
 		b.WriteString(c.renderVentureCard(venture, ctx))
 		b.WriteString("\n")
@@ -393,56 +545,7 @@ func (c *VentureCmd) doInitiateVenture(path, name, brief string, ctx *Context) t
 
 		// Show scaffolding results
 		b.WriteString("\n\n")
-		b.WriteString(s.CardTitle.Render("Scaffolded:"))
-		b.WriteString("\n")
-
-		if result.Success {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render(".hecate/venture.json"))
-			b.WriteString("\n")
-		}
-
-		if result.AgentsCloned {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render(".hecate/agents/"))
-			b.WriteString("\n")
-		}
-
-		if result.ReadmeCreated {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render("README.md"))
-			b.WriteString("\n")
-		}
-
-		if result.ChangelogCreated {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render("CHANGELOG.md"))
-			b.WriteString("\n")
-		}
-
-		if result.VisionCreated {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render("VISION.md"))
-			b.WriteString("\n")
-		}
-
-		if result.GitInitialized {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render("git init"))
-			b.WriteString("\n")
-		}
-
-		if result.GitCommitted {
-			b.WriteString(s.StatusOK.Render("  ✓ "))
-			b.WriteString(s.Subtle.Render("git commit"))
-			b.WriteString("\n")
-		}
-
-		// Show warnings
-		for _, warn := range result.Warnings {
-			b.WriteString("\n")
-			b.WriteString(s.StatusWarning.Render("⚠ " + warn))
-		}
+		b.WriteString(RenderScaffoldResult(s, result))
 
 		// Hint about next steps
 		b.WriteString("\n\n")
@@ -495,8 +598,7 @@ func (c *VentureCmd) refineVision(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
 		}
 
-		// Venture root = cwd (the TUI cds into the venture dir on init/select)
-		cwd, err := os.Getwd()
+		cwd, err := ventureRoot(state.Venture)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Cannot determine working directory: " + err.Error())}
 		}
@@ -505,29 +607,74 @@ func (c *VentureCmd) refineVision(args []string, ctx *Context) tea.Cmd {
 		manifest := scaffold.VentureManifest{
 			Name:        state.Venture.Name,
 			Brief:       state.Venture.Brief,
-			InitiatedBy: userAtHost(),
+			InitiatedBy: client.Actor(),
 		}
-		created, err := scaffold.ScaffoldVision(cwd, manifest)
+		_, err = scaffold.ScaffoldVision(cwd, manifest)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to scaffold VISION.md: " + err.Error())}
 		}
 
 		// Tell daemon vision refinement started
 		params := map[string]interface{}{
-			"refined_by": userAtHost(),
+			"refined_by": client.Actor(),
 		}
-		_ = ctx.Client.RefineVision(state.Venture.ID, params)
+		refineErr := ctx.Client.RefineVision(state.Venture.ID, params)
 
+		// Open the file either way; the daemon call only records that refinement started
 		visionPath := scaffold.VisionPath(cwd)
-		if created {
-			return EditFileMsg{Path: visionPath}
+		if refineErr != nil {
+			warning := InjectSystemMsg{Content: s.Subtle.Render("Warning: daemon did not record vision refinement: " + refineErr.Error())}
+			return tea.Batch(
+				func() tea.Msg { return EditFileMsg{Path: visionPath} },
+				func() tea.Msg { return warning },
+			)()
 		}
-
-		// Already exists — just open it
 		return EditFileMsg{Path: visionPath}
 	}
 }
 
+// showVision renders VISION.md in-chat so it can be reviewed before submitting.
+func (c *VentureCmd) showVision(ctx *Context) tea.Cmd {
+	return func() tea.Msg {
+		s := ctx.Styles
+
+		if ctx.GetALCContext == nil {
+			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
+		}
+		state := ctx.GetALCContext()
+		if state == nil || state.Venture == nil {
+			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
+		}
+
+		cwd, err := ventureRoot(state.Venture)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Cannot determine working directory: " + err.Error())}
+		}
+		if !scaffold.VisionExists(cwd) {
+			return InjectSystemMsg{Content: s.Error.Render("No VISION.md found. Use /venture refine-vision to create and edit it first.")}
+		}
+
+		visionPath := scaffold.VisionPath(cwd)
+		data, err := os.ReadFile(visionPath)
+		if err != nil {
+			return InjectSystemMsg{Content: s.Error.Render("Failed to read VISION.md: " + err.Error())}
+		}
+
+		width := ctx.Width - 8
+		if width < 40 {
+			width = 80
+		}
+
+		var b strings.Builder
+		b.WriteString(s.CardTitle.Render("Vision: " + state.Venture.Name))
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render(visionPath))
+		b.WriteString("\n\n")
+		b.WriteString(chat.RenderMarkdown(string(data), ctx.Theme, width))
+		return InjectSystemMsg{Content: b.String()}
+	}
+}
+
 // submitVision submits the venture vision, completing the DnA phase.
 // Validates that VISION.md exists before allowing submission.
 func (c *VentureCmd) submitVision(ctx *Context) tea.Cmd {
@@ -544,7 +691,7 @@ func (c *VentureCmd) submitVision(ctx *Context) tea.Cmd {
 		}
 
 		// Check VISION.md exists
-		cwd, err := os.Getwd()
+		cwd, err := ventureRoot(state.Venture)
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Cannot determine working directory: " + err.Error())}
 		}
@@ -552,7 +699,7 @@ func (c *VentureCmd) submitVision(ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("No VISION.md found. Use /venture refine-vision to create and edit it first.")}
 		}
 
-		err = ctx.Client.SubmitVision(state.Venture.ID, userAtHost())
+		err = ctx.Client.SubmitVision(state.Venture.ID, client.Actor())
 		if err != nil {
 			return InjectSystemMsg{Content: s.Error.Render("Failed to submit vision: " + err.Error())}
 		}
@@ -565,17 +712,13 @@ func (c *VentureCmd) submitVision(ctx *Context) tea.Cmd {
 	}
 }
 
-// userAtHost returns "user@hostname" for attribution.
-func userAtHost() string {
-	user := os.Getenv("USER")
-	if user == "" {
-		user = "unknown"
-	}
-	hostname, _ := os.Hostname()
-	if hostname == "" {
-		hostname = "localhost"
+// ventureRoot returns the venture's root directory, falling back to the
+// working directory when the root isn't known (the TUI cds into it on select).
+func ventureRoot(venture *alc.VentureInfo) (string, error) {
+	if venture != nil && venture.Root != "" {
+		return venture.Root, nil
 	}
-	return user + "@" + hostname
+	return os.Getwd()
 }
 
 func (c *VentureCmd) renderVentureCard(venture *client.Venture, ctx *Context) string {
@@ -653,12 +796,13 @@ func formatVentureStatus(status int) string {
 }
 
 // showOrPick shows current venture or lists available ventures to pick.
-func (c *VentureCmd) showOrPick(ctx *Context) tea.Cmd {
+// Asking for a page beyond the first always shows the picker.
+func (c *VentureCmd) showOrPick(ctx *Context, page int) tea.Cmd {
 	return func() tea.Msg {
 		s := ctx.Styles
 
 		// Check if we have a venture in context
-		if ctx.GetALCContext != nil {
+		if ctx.GetALCContext != nil && page == 1 {
 			if state := ctx.GetALCContext(); state != nil && state.Venture != nil {
 				return c.showCurrentVenture(ctx)()
 			}
@@ -674,12 +818,13 @@ func (c *VentureCmd) showOrPick(ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Subtle.Render("No ventures found. Use /venture init <name> to create one.")}
 		}
 
-		return c.renderVenturePicker(ventures, ctx)
+		return c.renderVenturePicker(ventures, page, ctx)
 	}
 }
 
-// renderVenturePicker renders a numbered list for selection.
-func (c *VentureCmd) renderVenturePicker(ventures []client.Venture, ctx *Context) tea.Msg {
+// renderVenturePicker renders one page of a numbered list for selection.
+// Numbers run across pages so /venture <number> selects from any page.
+func (c *VentureCmd) renderVenturePicker(ventures []client.Venture, page int, ctx *Context) tea.Msg {
 	s := ctx.Styles
 	t := ctx.Theme
 
@@ -687,7 +832,9 @@ func (c *VentureCmd) renderVenturePicker(ventures []client.Venture, ctx *Context
 	b.WriteString(s.CardTitle.Render("Select a Venture"))
 	b.WriteString("\n\n")
 
-	for i, venture := range ventures {
+	start, end, page := pageRange(len(ventures), page)
+	for i := start; i < end; i++ {
+		venture := ventures[i]
 		// Numbered entry
 		numStyle := lipgloss.NewStyle().Foreground(t.Secondary).Bold(true)
 		b.WriteString(numStyle.Render(fmt.Sprintf("  %d. ", i+1)))
@@ -716,6 +863,11 @@ func (c *VentureCmd) renderVenturePicker(ventures []client.Venture, ctx *Context
 		b.WriteString("\n")
 	}
 
+	if footer := pageFooter(start, end, len(ventures), page, "/venture"); footer != "" {
+		b.WriteString("\n")
+		b.WriteString(s.Subtle.Render(footer))
+		b.WriteString("\n")
+	}
 	b.WriteString("\n")
 	b.WriteString(s.Subtle.Render("Select: /venture <number> or /venture <id>"))
 	b.WriteString("\n")
@@ -754,19 +906,7 @@ func (c *VentureCmd) selectVenture(idOrName string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("Venture not found: " + idOrName)}
 		}
 
-		// Convert to VentureInfo and send message to switch context
-		ventureInfo := &alc.VentureInfo{
-			ID:          selected.VentureID,
-			Name:        selected.Name,
-			Brief:       selected.Brief,
-			InitiatedAt: time.UnixMilli(selected.InitiatedAt),
-		}
-
-		return SetALCContextMsg{
-			Context: alc.Venture,
-			Venture: ventureInfo,
-			Source:  "manual",
-		}
+		return c.ventureSelectedMsg(selected, ctx)
 	}
 }
 
@@ -784,19 +924,32 @@ func (c *VentureCmd) selectVentureByIndex(index int, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render(fmt.Sprintf("Invalid index: %d (have %d ventures)", index, len(ventures)))}
 		}
 
-		selected := &ventures[index-1]
-		ventureInfo := &alc.VentureInfo{
+		return c.ventureSelectedMsg(&ventures[index-1], ctx)
+	}
+}
+
+// ventureSelectedMsg builds the context switch for a selected venture.
+// If the venture's root directory is known it is attached so the studio
+// can cd into it; a root that has since disappeared is reported as an error.
+func (c *VentureCmd) ventureSelectedMsg(selected *client.Venture, ctx *Context) tea.Msg {
+	root := config.VentureRoot(selected.VentureID)
+	if root != "" {
+		if info, err := os.Stat(root); err != nil || !info.IsDir() {
+			return InjectSystemMsg{Content: ctx.Styles.Error.Render(
+				"Venture directory no longer exists: " + root + "\nUse /cd to its new location to re-link it.")}
+		}
+	}
+
+	return SetALCContextMsg{
+		Context: alc.Venture,
+		Venture: &alc.VentureInfo{
 			ID:          selected.VentureID,
 			Name:        selected.Name,
 			Brief:       selected.Brief,
 			InitiatedAt: time.UnixMilli(selected.InitiatedAt),
-		}
-
-		return SetALCContextMsg{
-			Context: alc.Venture,
-			Venture: ventureInfo,
-			Source:  "manual",
-		}
+			Root:        root,
+		},
+		Source: "manual",
 	}
 }
 
@@ -833,8 +986,12 @@ func (c *VenturesCmd) Description() string { return "List all ventures" }
 
 func (c *VenturesCmd) Execute(args []string, ctx *Context) tea.Cmd {
 	ventureCmd := &VentureCmd{}
+	args, page, err := extractPage(args)
+	if err != nil {
+		return ventureCmd.showError(ctx, err.Error())
+	}
 	includeArchived := len(args) > 0 && (args[0] == "all" || args[0] == "archived")
-	return ventureCmd.listVentures(ctx, includeArchived)
+	return ventureCmd.listVentures(ctx, includeArchived, page)
 }
 
 // ChatCmd handles /chat - returns to Chat mode (clears venture context).
@@ -907,9 +1064,7 @@ func (c *DepartmentsCmd) Execute(args []string, ctx *Context) tea.Cmd {
 			return InjectSystemMsg{Content: s.Error.Render("No venture selected. Use /venture to select one first.")}
 		}
 
-		// For now, delegate to /department command
-		// TODO: Filter departments by current venture when API supports it
 		departmentCmd := &DepartmentCmd{}
-		return departmentCmd.Execute(nil, ctx)()
+		return departmentCmd.tree(ctx)()
 	}
 }
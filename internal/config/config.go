@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/BurntSushi/toml"
+	"github.com/hecate-social/hecate-tui/internal/llm"
 )
 
 // Config holds all persistent user preferences (consolidated TOML).
@@ -33,6 +35,13 @@ type Config struct {
 
 	// Personality settings
 	Personality PersonalityConfig `toml:"personality"`
+
+	// LLM tool settings
+	Tools ToolsConfig `toml:"tools"`
+
+	// Per-model token prices overriding llm.DefaultPrices, keyed
+	// "provider/model" or "model"
+	Pricing map[string]llm.Price `toml:"pricing,omitempty"`
 }
 
 // PersonalityConfig holds agent personality and role settings.
@@ -43,8 +52,18 @@ type PersonalityConfig struct {
 	// Directory containing role files (DnA.md, AnP.md, TnI.md, DnO.md)
 	RolesDir string `toml:"roles_dir,omitempty"`
 
-	// Current active role (dna, anp, tni, dno)
+	// Current active role (dna, anp, tni, dno, or a custom role)
 	ActiveRole string `toml:"active_role,omitempty"`
+
+	// Custom roles added alongside the four built-ins
+	Roles []CustomRole `toml:"roles,omitempty"`
+}
+
+// ToolsConfig holds LLM tool settings.
+type ToolsConfig struct {
+	// Directory tools that take a path are confined to (empty = the current
+	// working directory, following /cd and venture switches)
+	SandboxRoot string `toml:"sandbox_root,omitempty"`
 }
 
 // ConnectionConfig holds daemon connection settings.
@@ -55,14 +74,30 @@ type ConnectionConfig struct {
 	// TCP URL fallback (default: http://localhost:4444)
 	DaemonURL string `toml:"daemon_url,omitempty"`
 
-	// Request timeout in seconds
+	// Seconds a one-shot daemon request may take (0 = default of 10s;
+	// LLM, RPC and mesh discovery calls get at least 60s)
 	Timeout int `toml:"timeout,omitempty"`
+
+	// PEM CA bundle for an https:// daemon URL with a private CA
+	// (HECATE_CA_CERT overrides)
+	CACert string `toml:"ca_cert,omitempty"`
+
+	// Skip TLS certificate verification; testing only
+	// (HECATE_TLS_INSECURE=1 overrides)
+	InsecureSkipVerify bool `toml:"insecure_skip_verify,omitempty"`
+
+	// Bearer token for a TCP daemon; unused over the Unix socket
+	// (HECATE_TOKEN overrides)
+	Token string `toml:"token,omitempty"`
 }
 
 // EditorConfig holds editor preferences.
 type EditorConfig struct {
 	Preferred string   `toml:"preferred,omitempty"`
 	Args      []string `toml:"args,omitempty"`
+
+	// Last file opened in the built-in editor, reopened by a bare /edit
+	LastFile string `toml:"last_file,omitempty"`
 }
 
 // UIConfig holds UI preferences.
@@ -70,6 +105,58 @@ type UIConfig struct {
 	Animations   bool `toml:"animations"`
 	CompactMode  bool `toml:"compact_mode"`
 	ShowThinking bool `toml:"show_thinking"`
+
+	// Seconds before a pending tool approval is auto-denied
+	// (0 = default of 120s, negative = never)
+	ApprovalTimeout int `toml:"approval_timeout,omitempty"`
+
+	// Notify when a slow response finishes: "bell", "osc9" (desktop
+	// notification) or empty for off
+	Notify string `toml:"notify,omitempty"`
+
+	// Seconds a response must take before notifying (0 = default of 10s)
+	NotifyAfter int `toml:"notify_after,omitempty"`
+
+	// Share of the width given to the Pair panel (0 = default of 0.5)
+	PairSplit float64 `toml:"pair_split,omitempty"`
+
+	// Share of the width given to the Browse overlay (0 = default of 0.7)
+	BrowseWidth float64 `toml:"browse_width,omitempty"`
+
+	// Hide subscription deliveries in the chat; subscriptions stay active
+	MuteSubscriptions bool `toml:"mute_subscriptions,omitempty"`
+
+	// How chat messages show their time: "time" (default, 15:04),
+	// "datetime" (2006-01-02 15:04) or "relative" (3m ago, yesterday)
+	TimestampFormat string `toml:"timestamp_format,omitempty"`
+
+	// Seconds between autosaves of a response still streaming
+	// (0 = default of 30s, negative = never)
+	AutosaveInterval int `toml:"autosave_interval,omitempty"`
+}
+
+// Split ratio defaults and bounds for the Pair and Browse panes.
+const (
+	DefaultPairSplit   = 0.5
+	DefaultBrowseWidth = 0.7
+	MinSplitRatio      = 0.3
+	MaxSplitRatio      = 0.9
+	SplitRatioStep     = 0.05
+)
+
+// ClampRatio returns r bounded to [MinSplitRatio, MaxSplitRatio], or def
+// when r is unset.
+func ClampRatio(r, def float64) float64 {
+	if r == 0 {
+		return def
+	}
+	if r < MinSplitRatio {
+		return MinSplitRatio
+	}
+	if r > MaxSplitRatio {
+		return MaxSplitRatio
+	}
+	return r
 }
 
 // configDir returns ~/.config/hecate-tui.
@@ -129,6 +216,14 @@ func (c Config) SaveTo(path string) error {
 	return encoder.Encode(c)
 }
 
+// Update applies fn to the config on disk and saves it, so settings saved
+// elsewhere since this process loaded its copy aren't overwritten.
+func Update(fn func(*Config)) (Config, error) {
+	cfg := Load()
+	fn(&cfg)
+	return cfg, cfg.Save()
+}
+
 // DaemonURL returns the configured daemon URL (backward-compatible accessor).
 func (c Config) DaemonURL() string {
 	return c.Connection.DaemonURL
@@ -269,6 +364,15 @@ var RoleInfo = map[string]struct {
 	"dno": {"HECATE_DEPLOYMENT_N_OPERATIONS.md", "Deployment & Operations"},
 }
 
+// RoleTag returns the short label for a role code ("dna" -> "DnA").
+// Custom role names are returned as they are.
+func RoleTag(role string) string {
+	if _, ok := RoleInfo[role]; !ok || len(role) != 3 {
+		return role
+	}
+	return strings.ToUpper(role[:1]) + role[1:2] + strings.ToUpper(role[2:])
+}
+
 // LoadPersonality reads the personality file if configured.
 func (c Config) LoadPersonality() (string, error) {
 	if c.Personality.PersonalityFile == "" {
@@ -282,8 +386,12 @@ func (c Config) LoadPersonality() (string, error) {
 	return string(data), nil
 }
 
-// LoadRole reads the role file for the given role code.
+// LoadRole returns the prompt for the given role: a custom role's
+// fragment, or the built-in role's file from RolesDir.
 func (c Config) LoadRole(role string) (string, error) {
+	if custom, ok := c.customRole(role); ok {
+		return custom.Prompt, nil
+	}
 	if c.Personality.RolesDir == "" || role == "" {
 		return "", nil
 	}
@@ -306,8 +414,8 @@ func (c Config) LoadActiveRole() (string, error) {
 
 // ActiveRoleDisplayName returns the display name of the active role.
 func (c Config) ActiveRoleDisplayName() string {
-	if info, ok := RoleInfo[c.Personality.ActiveRole]; ok {
-		return info.DisplayName
+	if r, ok := c.FindRole(c.Personality.ActiveRole); ok {
+		return r.DisplayName
 	}
 	return ""
 }
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// HeroDuelRecord is the outcome of one hero-vs-hero duel. These are kept
+// on this machine only and feed the local ratings; they are not the
+// daemon's hero records.
+type HeroDuelRecord struct {
+	MatchID string    `json:"match_id"`
+	Hero1ID string    `json:"hero1_id"`
+	Hero2ID string    `json:"hero2_id"`
+	Winner  string    `json:"winner"` // player1, player2 or draw
+	At      time.Time `json:"at"`
+}
+
+// heroDuelsPath returns ~/.config/hecate-tui/hero_duels.json.
+func heroDuelsPath() string {
+	return filepath.Join(configDir(), "hero_duels.json")
+}
+
+// LoadHeroDuels returns recorded hero-vs-hero duels, oldest first.
+func LoadHeroDuels() []HeroDuelRecord {
+	data, err := os.ReadFile(heroDuelsPath())
+	if err != nil {
+		return nil
+	}
+	var records []HeroDuelRecord
+	_ = json.Unmarshal(data, &records)
+	return records
+}
+
+// RecordHeroDuel appends a duel outcome. A match already recorded is
+// ignored, so replays of the same stream don't count twice.
+func RecordHeroDuel(r HeroDuelRecord) ([]HeroDuelRecord, error) {
+	records := LoadHeroDuels()
+	for _, existing := range records {
+		if r.MatchID != "" && existing.MatchID == r.MatchID {
+			return records, nil
+		}
+	}
+	records = append(records, r)
+
+	if err := os.MkdirAll(configDir(), 0755); err != nil {
+		return records, err
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return records, err
+	}
+	return records, os.WriteFile(heroDuelsPath(), append(data, '\n'), 0644)
+}
@@ -1,13 +1,16 @@
 package config
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 // Conversation is a saved chat session.
@@ -15,6 +18,8 @@ type Conversation struct {
 	ID        string             `json:"id"`
 	Title     string             `json:"title"`
 	Model     string             `json:"model,omitempty"`
+	Tags      []string           `json:"tags,omitempty"`
+	Pins      []string           `json:"pins,omitempty"`
 	Messages  []ConversationMsg  `json:"messages"`
 	CreatedAt time.Time          `json:"created_at"`
 	UpdatedAt time.Time          `json:"updated_at"`
@@ -25,6 +30,9 @@ type ConversationMsg struct {
 	Role    string    `json:"role"`
 	Content string    `json:"content"`
 	Time    time.Time `json:"time"`
+
+	// Partial marks a response autosaved while it was still streaming.
+	Partial bool `json:"partial,omitempty"`
 }
 
 // ConversationsDir returns ~/.config/hecate-tui/conversations/.
@@ -80,7 +88,34 @@ func SaveConversation(conv Conversation) error {
 	}
 
 	path := filepath.Join(dir, conv.ID+".json")
-	return os.WriteFile(path, append(data, '\n'), 0644)
+	return writeFileAtomic(path, append(data, '\n'), 0644)
+}
+
+// writeFileAtomic writes data to a temp file beside path and renames it
+// into place, so readers and overlapping saves only ever see a whole file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	// Flush before the rename so a crash can't leave an empty file behind.
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
 }
 
 // LoadConversation reads a conversation by ID.
@@ -100,6 +135,91 @@ func LoadConversation(id string) (Conversation, error) {
 	return conv, nil
 }
 
+// LatestConversationFile returns the ID and size of the most recently
+// written conversation file without parsing it.
+func LatestConversationFile() (id string, size int64, ok bool) {
+	entries, err := os.ReadDir(ConversationsDir())
+	if err != nil {
+		return "", 0, false
+	}
+	var newest time.Time
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || (ok && !info.ModTime().After(newest)) {
+			continue
+		}
+		id, size, ok, newest = strings.TrimSuffix(entry.Name(), ".json"), info.Size(), true, info.ModTime()
+	}
+	return id, size, ok
+}
+
+// RecoverConversation loads a conversation like LoadConversation, but when
+// the file is damaged it salvages every message before the damage.
+// salvaged reports that the file was only partly readable.
+func RecoverConversation(id string) (conv Conversation, salvaged bool, err error) {
+	conv, err = LoadConversation(id)
+	if err == nil {
+		return conv, false, nil
+	}
+	data, readErr := os.ReadFile(filepath.Join(ConversationsDir(), id+".json"))
+	if readErr != nil {
+		return Conversation{}, false, err
+	}
+	conv, ok := salvageConversation(data)
+	if !ok {
+		return Conversation{}, false, err
+	}
+	if conv.ID == "" {
+		conv.ID = id
+	}
+	return conv, true, nil
+}
+
+// salvageConversation decodes a conversation field by field, keeping the
+// messages that parse before the first error. It reports whether any
+// message survived.
+func salvageConversation(data []byte) (Conversation, bool) {
+	var conv Conversation
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return conv, false
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		key, _ := tok.(string)
+		if key != "messages" {
+			var raw json.RawMessage
+			if dec.Decode(&raw) != nil {
+				break
+			}
+			// Unmarshal the lone field so the struct tags still apply.
+			name, _ := json.Marshal(key)
+			_ = json.Unmarshal([]byte("{"+string(name)+":"+string(raw)+"}"), &conv)
+			continue
+		}
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+			break
+		}
+		for dec.More() {
+			var m ConversationMsg
+			if dec.Decode(&m) != nil {
+				return conv, len(conv.Messages) > 0
+			}
+			conv.Messages = append(conv.Messages, m)
+		}
+		if _, err := dec.Token(); err != nil {
+			break
+		}
+	}
+	return conv, len(conv.Messages) > 0
+}
+
 // DeleteConversation removes a conversation by ID.
 func DeleteConversation(id string) error {
 	path := filepath.Join(ConversationsDir(), id+".json")
@@ -109,6 +229,47 @@ func DeleteConversation(id string) error {
 	return os.Remove(path)
 }
 
+// ConversationMeta is the summary of a saved conversation used for
+// filtering without holding every message.
+type ConversationMeta struct {
+	ID        string
+	Title     string
+	CreatedAt time.Time
+}
+
+// ListConversationMeta returns metadata for all saved conversations,
+// newest first. Conversations without a creation time fall back to their
+// last update.
+func ListConversationMeta() []ConversationMeta {
+	convs := ListConversations()
+	metas := make([]ConversationMeta, 0, len(convs))
+	for _, c := range convs {
+		created := c.CreatedAt
+		if created.IsZero() {
+			created = c.UpdatedAt
+		}
+		metas = append(metas, ConversationMeta{ID: c.ID, Title: c.Title, CreatedAt: created})
+	}
+	return metas
+}
+
+// DeleteConversations removes the given conversations and returns how
+// many were deleted. It keeps going past failures and reports the first.
+func DeleteConversations(ids []string) (int, error) {
+	deleted := 0
+	var firstErr error
+	for _, id := range ids {
+		if err := DeleteConversation(id); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		deleted++
+	}
+	return deleted, firstErr
+}
+
 // ListConversations returns all saved conversations, newest first.
 func ListConversations() []Conversation {
 	dir := ConversationsDir()
@@ -127,6 +288,8 @@ func ListConversations() []Conversation {
 		id := strings.TrimSuffix(entry.Name(), ".json")
 		conv, err := LoadConversation(id)
 		if err != nil {
+			// One bad file must not hide the rest of the history.
+			logSkipped(filepath.Join(dir, entry.Name()), err)
 			continue
 		}
 		convs = append(convs, conv)
@@ -140,6 +303,33 @@ func ListConversations() []Conversation {
 	return convs
 }
 
+// skippedLog records corrupt conversation files, once each per run, since
+// the TUI owns the terminal and can't print them.
+const skippedLog = "skipped.log"
+
+var (
+	skippedMu   sync.Mutex
+	skippedSeen = make(map[string]bool)
+)
+
+// logSkipped notes a conversation file that failed to load in skipped.log
+// beside the conversations.
+func logSkipped(path string, err error) {
+	skippedMu.Lock()
+	defer skippedMu.Unlock()
+	if skippedSeen[path] {
+		return
+	}
+	skippedSeen[path] = true
+
+	f, ferr := os.OpenFile(filepath.Join(filepath.Dir(path), skippedLog), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if ferr != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = fmt.Fprintf(f, "%s skipped %s: %v\n", time.Now().Format(time.RFC3339), filepath.Base(path), err)
+}
+
 // TitleFromMessages derives a conversation title from the first user message.
 func TitleFromMessages(msgs []ConversationMsg) string {
 	for _, m := range msgs {
@@ -157,3 +347,115 @@ func TitleFromMessages(msgs []ConversationMsg) string {
 	}
 	return "Empty conversation"
 }
+
+// NormalizeTag lowercases a tag and strips a leading '#'.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// HasTag reports whether the conversation carries the given tag.
+func (c Conversation) HasTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for _, t := range c.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag returns tags with tag appended, and whether it was new.
+func AddTag(tags []string, tag string) ([]string, bool) {
+	tag = NormalizeTag(tag)
+	if tag == "" || (Conversation{Tags: tags}).HasTag(tag) {
+		return tags, false
+	}
+	out := append(append([]string(nil), tags...), tag)
+	sort.Strings(out)
+	return out, true
+}
+
+// RemoveTag returns tags without tag, and whether it was present.
+func RemoveTag(tags []string, tag string) ([]string, bool) {
+	tag = NormalizeTag(tag)
+	var out []string
+	found := false
+	for _, t := range tags {
+		if t == tag {
+			found = true
+			continue
+		}
+		out = append(out, t)
+	}
+	return out, found
+}
+
+// Matches reports whether query appears (case-insensitively) in the title
+// or any message of the conversation.
+func (c Conversation) Matches(query string) bool {
+	query = strings.ToLower(query)
+	if strings.Contains(strings.ToLower(c.Title), query) {
+		return true
+	}
+	for _, m := range c.Messages {
+		if strings.Contains(strings.ToLower(m.Content), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// snippetWidth is the longest snippet Snippets returns.
+const snippetWidth = 100
+
+// Snippets returns up to max message lines containing query
+// (case-insensitively), each trimmed to a window around the match.
+func (c Conversation) Snippets(query string, max int) []string {
+	query = strings.ToLower(query)
+	var out []string
+	for _, m := range c.Messages {
+		for _, line := range strings.Split(m.Content, "\n") {
+			lower := strings.ToLower(line)
+			idx := strings.Index(lower, query)
+			if idx < 0 {
+				continue
+			}
+			out = append(out, snippetAround(strings.TrimSpace(line), strings.TrimSpace(lower), query))
+			if len(out) >= max {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// snippetAround shortens line to snippetWidth, keeping the match visible.
+func snippetAround(line, lower, query string) string {
+	if len(line) <= snippetWidth {
+		return line
+	}
+	start := 0
+	// Lowercasing can change byte offsets outside ASCII; only shift the
+	// window when the offsets still line up.
+	if idx := strings.Index(lower, query); idx > snippetWidth/3 && len(lower) == len(line) {
+		start = idx - snippetWidth/3
+	}
+	for start > 0 && !utf8.RuneStart(line[start]) {
+		start--
+	}
+	end := start + snippetWidth - 6
+	if end > len(line) {
+		end = len(line)
+	}
+	for end < len(line) && !utf8.RuneStart(line[end]) {
+		end--
+	}
+	s := line[start:end]
+	if start > 0 {
+		s = "..." + s
+	}
+	if end < len(line) {
+		s += "..."
+	}
+	return s
+}
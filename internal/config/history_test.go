@@ -0,0 +1,161 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	tags, added := AddTag(nil, "#Work")
+	if !added || !reflect.DeepEqual(tags, []string{"work"}) {
+		t.Fatalf("AddTag = %v, %v", tags, added)
+	}
+	if _, added := AddTag(tags, "work"); added {
+		t.Error("AddTag should not add a duplicate")
+	}
+	tags, _ = AddTag(tags, "alpha")
+	if !reflect.DeepEqual(tags, []string{"alpha", "work"}) {
+		t.Errorf("tags not sorted: %v", tags)
+	}
+	tags, removed := RemoveTag(tags, "WORK")
+	if !removed || !reflect.DeepEqual(tags, []string{"alpha"}) {
+		t.Errorf("RemoveTag = %v, %v", tags, removed)
+	}
+	if _, removed := RemoveTag(tags, "missing"); removed {
+		t.Error("RemoveTag reported removing a missing tag")
+	}
+}
+
+func TestConversationMatches(t *testing.T) {
+	conv := Conversation{
+		Title:    "Deploy plan",
+		Messages: []ConversationMsg{{Role: "user", Content: "How do I roll back Kubernetes?"}},
+	}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"deploy", true},
+		{"kubernetes", true},
+		{"terraform", false},
+	}
+	for _, tt := range tests {
+		if got := conv.Matches(tt.query); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestConversationSnippets(t *testing.T) {
+	long := strings.Repeat("x", 150) + " needle " + strings.Repeat("y", 150)
+	conv := Conversation{Messages: []ConversationMsg{
+		{Role: "user", Content: "first line\n  the Needle is here  \nnothing"},
+		{Role: "assistant", Content: long},
+		{Role: "user", Content: "another needle"},
+	}}
+
+	got := conv.Snippets("needle", 2)
+	if len(got) != 2 {
+		t.Fatalf("Snippets returned %d lines, want 2: %q", len(got), got)
+	}
+	if got[0] != "the Needle is here" {
+		t.Errorf("snippet[0] = %q", got[0])
+	}
+	if !strings.Contains(got[1], "needle") || len(got[1]) > snippetWidth {
+		t.Errorf("snippet[1] = %q (len %d), want match within %d bytes", got[1], len(got[1]), snippetWidth)
+	}
+	if !strings.HasPrefix(got[1], "...") || !strings.HasSuffix(got[1], "...") {
+		t.Errorf("snippet[1] = %q, want ellipses on both sides", got[1])
+	}
+
+	if got := conv.Snippets("absent", 3); len(got) != 0 {
+		t.Errorf("Snippets(absent) = %q, want none", got)
+	}
+}
+
+func TestSaveConversation_ReplacesWholeFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	conv := Conversation{ID: "c1", Messages: []ConversationMsg{
+		{Role: "user", Content: "hi"},
+		{Role: "assistant", Content: "a long partial answer", Partial: true},
+	}}
+	if err := SaveConversation(conv); err != nil {
+		t.Fatal(err)
+	}
+	conv.Messages = conv.Messages[:1]
+	if err := SaveConversation(conv); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadConversation("c1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Messages) != 1 {
+		t.Errorf("loaded %d messages, want the 1 from the last save", len(got.Messages))
+	}
+	entries, _ := os.ReadDir(ConversationsDir())
+	if len(entries) != 1 {
+		t.Errorf("conversations dir holds %d files, want only c1.json", len(entries))
+	}
+}
+
+func TestListConversations_SkipsTruncatedFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	if err := SaveConversation(Conversation{ID: "good", Messages: []ConversationMsg{{Role: "user", Content: "hi"}}}); err != nil {
+		t.Fatal(err)
+	}
+	// A save killed mid-write before saves were atomic.
+	truncated := filepath.Join(ConversationsDir(), "newer.json")
+	if err := os.WriteFile(truncated, []byte(`{"id": "newer", "messages": [{"role": "us`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Auto-load takes the first listed conversation.
+	convs := ListConversations()
+	if len(convs) != 1 || convs[0].ID != "good" {
+		t.Fatalf("ListConversations() = %v, want just the good conversation", convs)
+	}
+	if _, err := LoadConversation("newer"); err == nil {
+		t.Error("LoadConversation() of a truncated file should fail")
+	}
+	logged, err := os.ReadFile(filepath.Join(ConversationsDir(), skippedLog))
+	if err != nil || !strings.Contains(string(logged), "newer.json") {
+		t.Errorf("skipped.log = %q, %v; want an entry for newer.json", logged, err)
+	}
+}
+
+func TestRecoverConversation_SalvagesTruncatedFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	if err := os.MkdirAll(ConversationsDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"id": "cut", "title": "Cut short", "tags": ["work"], "messages": [` +
+		`{"role": "user", "content": "hi"}, {"role": "assistant", "content": "hello"}, {"role": "us`
+	if err := os.WriteFile(filepath.Join(ConversationsDir(), "cut.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if id, _, ok := LatestConversationFile(); !ok || id != "cut" {
+		t.Errorf("LatestConversationFile() = %q, %v; want cut", id, ok)
+	}
+	conv, salvaged, err := RecoverConversation("cut")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !salvaged {
+		t.Error("RecoverConversation() should report a salvaged file")
+	}
+	if conv.Title != "Cut short" || !conv.HasTag("work") || len(conv.Messages) != 2 {
+		t.Errorf("salvaged %+v, want the title, tag and both whole messages", conv)
+	}
+}
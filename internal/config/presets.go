@@ -0,0 +1,77 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FitnessWeightCount is the number of weights in a stable fitness preset.
+const FitnessWeightCount = 7
+
+// FitnessPreset is a user-saved set of stable fitness weights.
+type FitnessPreset struct {
+	Name    string    `json:"name"`
+	Weights []float64 `json:"weights"`
+}
+
+// fitnessPresetsPath returns ~/.config/hecate-tui/stable_presets.json.
+func fitnessPresetsPath() string {
+	return filepath.Join(configDir(), "stable_presets.json")
+}
+
+// LoadFitnessPresets returns the saved presets in the order they were
+// created. Presets with the wrong number of weights are skipped.
+func LoadFitnessPresets() []FitnessPreset {
+	data, err := os.ReadFile(fitnessPresetsPath())
+	if err != nil {
+		return nil
+	}
+	var all []FitnessPreset
+	if json.Unmarshal(data, &all) != nil {
+		return nil
+	}
+	presets := all[:0]
+	for _, p := range all {
+		if p.Name != "" && len(p.Weights) == FitnessWeightCount {
+			presets = append(presets, p)
+		}
+	}
+	return presets
+}
+
+// SaveFitnessPreset stores p, replacing any preset with the same name
+// (case-insensitive).
+func SaveFitnessPreset(p FitnessPreset) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("preset name is empty")
+	}
+	if len(p.Weights) != FitnessWeightCount {
+		return fmt.Errorf("preset needs %d weights, got %d", FitnessWeightCount, len(p.Weights))
+	}
+
+	presets := LoadFitnessPresets()
+	replaced := false
+	for i := range presets {
+		if strings.EqualFold(presets[i].Name, p.Name) {
+			presets[i] = p
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		presets = append(presets, p)
+	}
+
+	if err := os.MkdirAll(configDir(), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(presets, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fitnessPresetsPath(), append(data, '\n'), 0644)
+}
@@ -0,0 +1,28 @@
+package config
+
+import "testing"
+
+func TestSaveFitnessPreset(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	w := []float64{0.2, 60, 250, 40, 120, 1, -0.5}
+	if err := SaveFitnessPreset(FitnessPreset{Name: "Hunter", Weights: w}); err != nil {
+		t.Fatal(err)
+	}
+	w2 := []float64{0.3, 60, 250, 40, 120, 1, -0.5}
+	if err := SaveFitnessPreset(FitnessPreset{Name: "hunter", Weights: w2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveFitnessPreset(FitnessPreset{Name: "short", Weights: w[:3]}); err == nil {
+		t.Error("expected an error for the wrong number of weights")
+	}
+
+	presets := LoadFitnessPresets()
+	if len(presets) != 1 {
+		t.Fatalf("got %d presets, want 1 (same name should replace)", len(presets))
+	}
+	if presets[0].Weights[0] != 0.3 {
+		t.Errorf("preset not replaced: %v", presets[0].Weights)
+	}
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// builtinRoles lists the file-backed ALC roles in lifecycle order.
+var builtinRoles = []string{"dna", "anp", "tni", "dno"}
+
+// CustomRole is a user-defined role whose prompt fragment lives in the
+// config rather than in RolesDir.
+type CustomRole struct {
+	Name        string `toml:"name"`
+	DisplayName string `toml:"display_name,omitempty"`
+	Prompt      string `toml:"prompt"`
+}
+
+// Role describes a selectable role, built in or custom.
+type Role struct {
+	Name        string
+	DisplayName string
+	Builtin     bool
+}
+
+// Roles returns the built-in roles followed by the configured custom ones.
+func (c Config) Roles() []Role {
+	roles := make([]Role, 0, len(builtinRoles)+len(c.Personality.Roles))
+	for _, name := range builtinRoles {
+		roles = append(roles, Role{Name: name, DisplayName: RoleInfo[name].DisplayName, Builtin: true})
+	}
+	for _, r := range c.Personality.Roles {
+		display := r.DisplayName
+		if display == "" {
+			display = r.Name
+		}
+		roles = append(roles, Role{Name: r.Name, DisplayName: display})
+	}
+	return roles
+}
+
+// FindRole looks up a role by name.
+func (c Config) FindRole(name string) (Role, bool) {
+	for _, r := range c.Roles() {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Role{}, false
+}
+
+// customRole returns the configured custom role called name.
+func (c Config) customRole(name string) (CustomRole, bool) {
+	for _, r := range c.Personality.Roles {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return CustomRole{}, false
+}
+
+// AddRole adds or replaces a custom role. Names are lowercase letters,
+// digits and dashes, and may not shadow a built-in role.
+func (c *Config) AddRole(r CustomRole) error {
+	r.Name = strings.ToLower(strings.TrimSpace(r.Name))
+	r.Prompt = strings.TrimSpace(r.Prompt)
+	if r.Name == "" || strings.Trim(r.Name, "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+		return fmt.Errorf("role name %q must use only a-z, 0-9 and -", r.Name)
+	}
+	if _, ok := RoleInfo[r.Name]; ok {
+		return fmt.Errorf("%q is a built-in role", r.Name)
+	}
+	if r.Prompt == "" {
+		return fmt.Errorf("role %q needs a prompt", r.Name)
+	}
+	for i, existing := range c.Personality.Roles {
+		if existing.Name == r.Name {
+			c.Personality.Roles[i] = r
+			return nil
+		}
+	}
+	c.Personality.Roles = append(c.Personality.Roles, r)
+	return nil
+}
+
+// RemoveRole deletes a custom role, clearing it if it was active.
+func (c *Config) RemoveRole(name string) error {
+	if _, ok := RoleInfo[name]; ok {
+		return fmt.Errorf("%q is a built-in role and can't be removed", name)
+	}
+	for i, r := range c.Personality.Roles {
+		if r.Name == name {
+			c.Personality.Roles = append(c.Personality.Roles[:i:i], c.Personality.Roles[i+1:]...)
+			if c.Personality.ActiveRole == name {
+				c.Personality.ActiveRole = ""
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("no custom role %q", name)
+}
@@ -0,0 +1,46 @@
+package config
+
+import "testing"
+
+func TestAddRemoveRole(t *testing.T) {
+	var c Config
+	if err := c.AddRole(CustomRole{Name: "Sec", DisplayName: "Security Review", Prompt: " Review for vulnerabilities. "}); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := []CustomRole{
+		{Name: "dna", Prompt: "x"},       // shadows a built-in
+		{Name: "two words", Prompt: "x"}, // invalid name
+		{Name: "empty", Prompt: "   "},   // no prompt
+	}
+	for _, r := range bad {
+		if err := c.AddRole(r); err == nil {
+			t.Errorf("AddRole(%+v) succeeded, want error", r)
+		}
+	}
+
+	r, ok := c.FindRole("sec")
+	if !ok || r.DisplayName != "Security Review" || r.Builtin {
+		t.Fatalf("FindRole(sec) = %+v, %v", r, ok)
+	}
+	if got := len(c.Roles()); got != 5 {
+		t.Errorf("Roles() has %d entries, want 5", got)
+	}
+	if prompt, _ := c.LoadRole("sec"); prompt != "Review for vulnerabilities." {
+		t.Errorf("LoadRole(sec) = %q", prompt)
+	}
+
+	c.Personality.ActiveRole = "sec"
+	if c.ActiveRoleDisplayName() != "Security Review" {
+		t.Errorf("ActiveRoleDisplayName() = %q", c.ActiveRoleDisplayName())
+	}
+	if err := c.RemoveRole("dna"); err == nil {
+		t.Error("RemoveRole(dna) succeeded, want error")
+	}
+	if err := c.RemoveRole("sec"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Personality.ActiveRole != "" || len(c.Personality.Roles) != 0 {
+		t.Errorf("after remove: active %q, roles %v", c.Personality.ActiveRole, c.Personality.Roles)
+	}
+}
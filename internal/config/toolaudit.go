@@ -0,0 +1,112 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ToolAuditEntry is one line of the tool audit log.
+type ToolAuditEntry struct {
+	At         time.Time `json:"at"`
+	Tool       string    `json:"tool"`
+	Args       string    `json:"args,omitempty"`
+	Decision   string    `json:"decision"`
+	DurationMs int64     `json:"duration_ms"`
+	OK         bool      `json:"ok"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// toolAuditMu serializes appends from concurrent tool calls.
+var toolAuditMu sync.Mutex
+
+// maxToolAuditBytes is the size at which the audit log is rotated, which
+// also bounds how much LoadToolAudit has to scan.
+const maxToolAuditBytes = 1 << 20
+
+// ToolAuditPath returns ~/.config/hecate-tui/tool_audit.jsonl.
+func ToolAuditPath() string {
+	return filepath.Join(configDir(), "tool_audit.jsonl")
+}
+
+// AppendToolAudit adds an entry to the end of the audit log. Entries are
+// never rewritten; once the log reaches maxToolAuditBytes it is moved to
+// tool_audit.jsonl.1, replacing the previous one, and a fresh log begins.
+func AppendToolAudit(e ToolAuditEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	toolAuditMu.Lock()
+	defer toolAuditMu.Unlock()
+
+	if err := os.MkdirAll(configDir(), 0755); err != nil {
+		return err
+	}
+	if fi, err := os.Stat(ToolAuditPath()); err == nil && fi.Size() >= maxToolAuditBytes {
+		if err := os.Rename(ToolAuditPath(), ToolAuditPath()+".1"); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(ToolAuditPath(), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadToolAudit returns the last limit entries of the audit log, oldest
+// first (limit <= 0 returns all). The rotated log is read first, so
+// history survives a rotation. Unreadable lines are skipped.
+func LoadToolAudit(limit int) ([]ToolAuditEntry, error) {
+	entries, err := scanToolAudit(ToolAuditPath()+".1", nil, limit)
+	if err != nil {
+		return nil, err
+	}
+	entries, err = scanToolAudit(ToolAuditPath(), entries, limit)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries, nil
+}
+
+// scanToolAudit appends the entries in path to entries, keeping at most
+// about 2*limit of them. A missing file adds nothing.
+func scanToolAudit(path string, entries []ToolAuditEntry, limit int) ([]ToolAuditEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e ToolAuditEntry
+		if json.Unmarshal(scanner.Bytes(), &e) != nil {
+			continue
+		}
+		entries = append(entries, e)
+		if limit > 0 && len(entries) > 2*limit {
+			entries = append(entries[:0], entries[len(entries)-limit:]...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
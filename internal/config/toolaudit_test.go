@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestToolAudit(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	if entries, err := LoadToolAudit(10); err != nil || entries != nil {
+		t.Fatalf("empty log = %v, %v", entries, err)
+	}
+
+	for i := 0; i < 5; i++ {
+		err := AppendToolAudit(ToolAuditEntry{
+			At:       time.Now(),
+			Tool:     fmt.Sprintf("tool_%d", i),
+			Decision: "allowed",
+			OK:       true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A damaged line is skipped rather than failing the whole log.
+	f, err := os.OpenFile(ToolAuditPath(), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("{not json\n")
+	f.Close()
+	if err := AppendToolAudit(ToolAuditEntry{Tool: "tool_5", Decision: "denied"}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := LoadToolAudit(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Tool != "tool_3" || entries[2].Tool != "tool_5" {
+		t.Errorf("last 3 entries = %+v", entries)
+	}
+	if all, _ := LoadToolAudit(0); len(all) != 6 {
+		t.Errorf("LoadToolAudit(0) returned %d entries, want 6", len(all))
+	}
+}
+
+func TestToolAudit_Rotates(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	big := ToolAuditEntry{Tool: "write_file", Decision: "allowed", Args: strings.Repeat("x", 64*1024)}
+	n := maxToolAuditBytes/len(big.Args) + 2
+	for i := 0; i < n; i++ {
+		if err := AppendToolAudit(big); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := AppendToolAudit(ToolAuditEntry{Tool: "last", Decision: "denied"}); err != nil {
+		t.Fatal(err)
+	}
+
+	fi, err := os.Stat(ToolAuditPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() >= maxToolAuditBytes {
+		t.Errorf("log is %d bytes, want it rotated below %d", fi.Size(), maxToolAuditBytes)
+	}
+	if _, err := os.Stat(ToolAuditPath() + ".1"); err != nil {
+		t.Errorf("rotated log missing: %v", err)
+	}
+
+	// Reading spans the rotation.
+	all, err := LoadToolAudit(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != n+1 || all[len(all)-1].Tool != "last" {
+		t.Errorf("LoadToolAudit(0) = %d entries ending %q, want %d ending \"last\"", len(all), all[len(all)-1].Tool, n+1)
+	}
+}
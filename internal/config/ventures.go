@@ -0,0 +1,52 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// venturesPath returns ~/.config/hecate-tui/ventures.json.
+func venturesPath() string {
+	return filepath.Join(configDir(), "ventures.json")
+}
+
+// loadVentureRoots reads the venture ID → root directory map.
+// Returns an empty map if the file doesn't exist or is unreadable.
+func loadVentureRoots() map[string]string {
+	roots := make(map[string]string)
+	data, err := os.ReadFile(venturesPath())
+	if err != nil {
+		return roots
+	}
+	_ = json.Unmarshal(data, &roots)
+	return roots
+}
+
+// VentureRoot returns the known root directory for a venture, or "" if unknown.
+func VentureRoot(ventureID string) string {
+	return loadVentureRoots()[ventureID]
+}
+
+// SaveVentureRoot records the root directory of a venture on this machine.
+// The daemon doesn't track local checkouts, so the TUI remembers them itself.
+func SaveVentureRoot(ventureID, root string) error {
+	if ventureID == "" || root == "" {
+		return nil
+	}
+
+	roots := loadVentureRoots()
+	if roots[ventureID] == root {
+		return nil
+	}
+	roots[ventureID] = root
+
+	if err := os.MkdirAll(configDir(), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(roots, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(venturesPath(), append(data, '\n'), 0644)
+}
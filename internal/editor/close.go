@@ -0,0 +1,36 @@
+package editor
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// CloseMsg asks the host to close the editor. Sent once the buffer is saved
+// or the user chose to discard it.
+type CloseMsg struct{}
+
+func closeCmd() tea.Msg { return CloseMsg{} }
+
+// ConfirmClose asks whether to save, discard or keep editing a dirty buffer.
+func (m *Model) ConfirmClose() {
+	m.prompt = &prompt{kind: promptConfirmClose}
+}
+
+// handleCloseKey handles the save/discard/cancel prompt.
+func (m Model) handleCloseKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "s", "S", "ctrl+s":
+		m.prompt = nil
+		m.closeAfterSave = true
+		if m.filepath == "" {
+			m.openSaveAs()
+			return m, nil
+		}
+		return m, m.save()
+	case "d", "D", "ctrl+q":
+		// A second ctrl+q force-discards.
+		m.prompt = nil
+		m.RemoveSwap()
+		return m, closeCmd
+	case "c", "C", "esc", "ctrl+c":
+		m.prompt = nil
+	}
+	return m, nil
+}
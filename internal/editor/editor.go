@@ -24,6 +24,7 @@ type Model struct {
 	filepath    string
 	filename    string
 	modified    bool
+	saved       string // content as of the last open/save
 
 	// Content
 	textarea    textarea.Model
@@ -48,6 +49,22 @@ type Model struct {
 	// Messages
 	message     string
 	messageErr  bool
+
+	// Status-line prompt (save as, find, ...), nil when closed
+	prompt *prompt
+
+	// Find/replace state
+	search search
+
+	// Close once the pending save succeeds
+	closeAfterSave bool
+
+	// Instance id for autosave ticks
+	id int64
+
+	// Title bar overrides (compose overlay)
+	title string
+	hints string
 }
 
 // New creates a new editor
@@ -62,6 +79,7 @@ func New() Model {
 		lines:    []string{""},
 		mode:     ModeInsert, // Start in insert mode for simplicity
 		lang:     LangPlain,
+		id:       editorSeq.Add(1),
 	}
 }
 
@@ -80,15 +98,18 @@ func NewWithFile(path string) (Model, error) {
 	m.highlighter = NewHighlighter(m.lang)
 
 	content := string(data)
-	m.textarea.SetValue(content)
 	m.lines = strings.Split(content, "\n")
+	m.fitGutter(len(m.lines))
+	m.textarea.SetValue(content)
+	m.saved = content
+	m.offerRecovery()
 
 	return m, nil
 }
 
 // Init initializes the editor
 func (m Model) Init() tea.Cmd {
-	return textarea.Blink
+	return tea.Batch(textarea.Blink, autosaveTick(m.id))
 }
 
 // Update handles messages
@@ -96,14 +117,43 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case autosaveMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		m.writeSwap()
+		return m, autosaveTick(m.id)
+
 	case saveResultMsg:
 		if msg.err != nil {
 			m.message = "Save failed: " + msg.err.Error()
 			m.messageErr = true
+			m.closeAfterSave = false
 		} else {
 			m.message = "Saved: " + m.filename
 			m.messageErr = false
-			m.modified = false
+			m.markSaved(msg.content)
+			m.RemoveSwap()
+			if m.closeAfterSave {
+				return m, closeCmd
+			}
+		}
+		return m, nil
+
+	case saveAsResultMsg:
+		if msg.err != nil {
+			m.message = "Save failed: " + msg.err.Error()
+			m.messageErr = true
+			m.closeAfterSave = false
+		} else {
+			m.RemoveSwap()
+			m.SetFilepath(msg.path)
+			m.message = "Saved as: " + msg.path
+			m.messageErr = false
+			m.markSaved(msg.content)
+			if m.closeAfterSave {
+				return m, closeCmd
+			}
 		}
 		return m, nil
 
@@ -112,25 +162,44 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.message = ""
 		m.messageErr = false
 
+		if m.prompt != nil {
+			return m.handlePromptKey(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+s":
-			// Save file
+			// Save file (prompt for a path if there isn't one yet)
+			if m.filepath == "" {
+				m.openSaveAs()
+				return m, nil
+			}
 			return m, m.save()
 
+		case "alt+s":
+			m.openSaveAs()
+			return m, nil
+
+		case "ctrl+f":
+			m.openFind()
+			return m, nil
+
+		case "ctrl+r":
+			m.openReplace()
+			return m, nil
+
 		case "ctrl+q", "esc":
-			if m.modified {
-				m.message = "Unsaved changes! Press Ctrl+Q again to quit without saving."
-				m.messageErr = true
-				// For now just mark as not modified to allow quit
-				// In a full impl, we'd track double-press
-				m.modified = false
+			if m.IsDirty() {
+				m.ConfirmClose()
 				return m, nil
 			}
-			return m, tea.Quit
+			return m, closeCmd
 
 		case "ctrl+g":
-			// Go to line (placeholder)
-			m.message = "Go to line not yet implemented"
+			m.openGoto()
+			return m, nil
+
+		case "alt+l":
+			m.ToggleLineNumbers()
 			return m, nil
 		}
 
@@ -140,8 +209,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 		// Track modifications
-		m.modified = true
+		m.modified = m.IsDirty()
 		m.lines = strings.Split(m.textarea.Value(), "\n")
+		m.fitGutter(len(m.lines) + 1)
 		m.cursorLine = m.textarea.Line()
 		m.cursorCol = m.textarea.LineInfo().ColumnOffset
 
@@ -170,7 +240,8 @@ func (m *Model) updateScroll() {
 }
 
 type saveResultMsg struct {
-	err error
+	content string
+	err     error
 }
 
 func (m Model) save() tea.Cmd {
@@ -181,10 +252,16 @@ func (m Model) save() tea.Cmd {
 
 		content := m.textarea.Value()
 		err := os.WriteFile(m.filepath, []byte(content), 0644)
-		return saveResultMsg{err: err}
+		return saveResultMsg{content: content, err: err}
 	}
 }
 
+// markSaved records content as the last saved state.
+func (m *Model) markSaved(content string) {
+	m.saved = content
+	m.modified = m.IsDirty()
+}
+
 // View renders the editor
 func (m Model) View() string {
 	if m.width == 0 {
@@ -201,15 +278,21 @@ func (m Model) View() string {
 	b.WriteString(m.renderContent())
 	b.WriteString("\n")
 
-	// Status bar
-	b.WriteString(m.renderStatusBar())
+	// Status bar (or the active prompt)
+	if m.prompt != nil {
+		b.WriteString(m.renderPrompt())
+	} else {
+		b.WriteString(m.renderStatusBar())
+	}
 
 	return b.String()
 }
 
 func (m Model) renderTitleBar() string {
 	title := "Quick Edit"
-	if m.filename != "" {
+	if m.title != "" {
+		title = m.title
+	} else if m.filename != "" {
 		title = m.filename
 	}
 
@@ -224,7 +307,11 @@ func (m Model) renderTitleBar() string {
 	}
 
 	left := TitleBarStyle.Render(" " + title + langStr)
-	right := TitleBarStyle.Render(" Ctrl+S save | Ctrl+Q quit ")
+	hints := " ^S save | Alt+S save as | ^F find | ^R replace | ^G line | Alt+L numbers | ^Q quit "
+	if m.hints != "" {
+		hints = " " + m.hints + " "
+	}
+	right := TitleBarStyle.Render(hints)
 
 	gap := m.width - lipgloss.Width(left) - lipgloss.Width(right)
 	if gap < 0 {
@@ -247,7 +334,7 @@ func (m Model) renderContent() string {
 		style = EditorActiveStyle
 	}
 
-	return style.Width(m.width - 4).Height(m.visibleLines).Render(m.textarea.View())
+	return style.Width(m.width - 4).Height(m.visibleLines).Render(m.highlightMatches(m.textarea.View()))
 }
 
 func (m Model) renderStatusBar() string {
@@ -311,8 +398,10 @@ func (m *Model) Blur() {
 
 // SetContent sets the editor content
 func (m *Model) SetContent(content string) {
-	m.textarea.SetValue(content)
 	m.lines = strings.Split(content, "\n")
+	m.fitGutter(len(m.lines))
+	m.textarea.SetValue(content)
+	m.saved = content
 	m.modified = false
 }
 
@@ -329,11 +418,34 @@ func (m *Model) SetFilepath(path string) {
 	m.highlighter = NewHighlighter(m.lang)
 }
 
+// SetTitle replaces the title bar's file name and key hints, for hosts that
+// use the editor for something other than a file.
+func (m *Model) SetTitle(title, hints string) {
+	m.title = title
+	m.hints = hints
+}
+
+// SetMessage shows a note in the status bar until the next key press.
+func (m *Model) SetMessage(msg string, isErr bool) {
+	m.message = msg
+	m.messageErr = isErr
+}
+
+// Filepath returns the path of the file being edited, or "" for a scratch buffer
+func (m Model) Filepath() string {
+	return m.filepath
+}
+
 // IsModified returns whether the content has been modified
 func (m Model) IsModified() bool {
 	return m.modified
 }
 
+// IsDirty returns whether the content differs from the last open or save
+func (m Model) IsDirty() bool {
+	return m.textarea.Value() != m.saved
+}
+
 func itoa(n int) string {
 	if n == 0 {
 		return "0"
@@ -0,0 +1,80 @@
+package editor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minGutterDigits keeps the gutter from resizing while small files grow.
+const minGutterDigits = 3
+
+// ShowLineNumbers reports whether the line-number gutter is visible.
+func (m Model) ShowLineNumbers() bool {
+	return m.textarea.ShowLineNumbers
+}
+
+// ToggleLineNumbers shows or hides the line-number gutter.
+func (m *Model) ToggleLineNumbers() {
+	m.textarea.ShowLineNumbers = !m.textarea.ShowLineNumbers
+	// The textarea reserves gutter space in SetWidth.
+	if m.width > 0 {
+		m.textarea.SetWidth(m.width - 8)
+	}
+}
+
+// SetLineNumberColor sets the gutter color, typically the theme's TextMuted.
+func (m *Model) SetLineNumberColor(c lipgloss.TerminalColor) {
+	for _, st := range []*lipgloss.Style{
+		&m.textarea.FocusedStyle.LineNumber,
+		&m.textarea.FocusedStyle.CursorLineNumber,
+		&m.textarea.BlurredStyle.LineNumber,
+		&m.textarea.BlurredStyle.CursorLineNumber,
+	} {
+		*st = st.Foreground(c)
+	}
+}
+
+// fitGutter sizes the textarea's line capacity to the content. The textarea
+// derives the gutter width from MaxHeight, so rounding it up to the next
+// power of ten keeps the gutter steady until the digit count changes. It
+// also lifts the textarea's default 99-line cap.
+func (m *Model) fitGutter(lineCount int) {
+	digits := len(strconv.Itoa(lineCount))
+	if digits < minGutterDigits {
+		digits = minGutterDigits
+	}
+	maxLines, _ := strconv.Atoi(strings.Repeat("9", digits))
+	if maxLines > m.textarea.MaxHeight {
+		m.textarea.MaxHeight = maxLines
+	}
+}
+
+// openGoto prompts for a line number.
+func (m *Model) openGoto() {
+	m.prompt = newPrompt(promptGoto, " Go to line: ", "")
+	m.prompt.input.CharLimit = 9
+}
+
+// digitsOnly reports whether a key carries only digits.
+func digitsOnly(runes []rune) bool {
+	for _, r := range runes {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// gotoLine moves the cursor to a 1-based line, clamped to the buffer.
+func (m *Model) gotoLine(n int) {
+	last := m.textarea.LineCount()
+	if n < 1 {
+		n = 1
+	}
+	if n > last {
+		n = last
+	}
+	m.moveCursor(match{line: n - 1, col: 0})
+}
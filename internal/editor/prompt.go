@@ -0,0 +1,192 @@
+package editor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// promptKind identifies what a status-line prompt is asking for.
+type promptKind int
+
+const (
+	promptSaveAs promptKind = iota
+	promptConfirmOverwrite
+	promptFind
+	promptReplace
+	promptReplaceWith
+	promptConfirmReplace
+	promptConfirmClose
+	promptGoto
+	promptRecover
+)
+
+// prompt is a one-line input shown in place of the status bar.
+type prompt struct {
+	kind  promptKind
+	input textinput.Model
+	path  string // target path awaiting overwrite confirmation
+}
+
+func newPrompt(kind promptKind, label, value string) *prompt {
+	ti := textinput.New()
+	ti.Prompt = label
+	ti.PromptStyle = StatusModeStyle
+	ti.SetValue(value)
+	ti.CursorEnd()
+	ti.Focus()
+	return &prompt{kind: kind, input: ti}
+}
+
+// PromptActive reports whether the editor is showing a status-line prompt.
+// While true, the editor wants esc/enter for itself.
+func (m Model) PromptActive() bool {
+	return m.prompt != nil
+}
+
+// openSaveAs prompts for a new path, prefilled with the current one.
+func (m *Model) openSaveAs() {
+	m.prompt = newPrompt(promptSaveAs, " Save as: ", m.filepath)
+}
+
+// handlePromptKey routes keys to the active prompt.
+func (m Model) handlePromptKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	p := m.prompt
+
+	switch p.kind {
+	case promptFind, promptReplace, promptReplaceWith, promptConfirmReplace:
+		return m.handleSearchKey(msg)
+	}
+
+	if p.kind == promptConfirmClose {
+		return m.handleCloseKey(msg)
+	}
+
+	if p.kind == promptRecover {
+		return m.handleRecoverKey(msg)
+	}
+
+	if p.kind == promptConfirmOverwrite {
+		switch msg.String() {
+		case "y", "Y":
+			m.prompt = nil
+			return m, m.saveTo(p.path)
+		case "n", "N", "esc", "ctrl+c":
+			m.prompt = nil
+			m.closeAfterSave = false
+			m.message = "Save cancelled"
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "ctrl+c":
+		m.prompt = nil
+		m.closeAfterSave = false
+		return m, nil
+	case "enter":
+		return m.submitPrompt()
+	}
+
+	if p.kind == promptGoto && msg.Type == tea.KeyRunes && !digitsOnly(msg.Runes) {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return m, cmd
+}
+
+// submitPrompt acts on the entered value.
+func (m Model) submitPrompt() (Model, tea.Cmd) {
+	value := strings.TrimSpace(m.prompt.input.Value())
+
+	switch m.prompt.kind {
+	case promptSaveAs:
+		if value == "" {
+			m.prompt = nil
+			m.closeAfterSave = false
+			return m, nil
+		}
+		path := expandHome(value)
+		if _, err := os.Stat(path); err == nil && path != m.filepath {
+			m.prompt = &prompt{kind: promptConfirmOverwrite, path: path}
+			return m, nil
+		}
+		m.prompt = nil
+		return m, m.saveTo(path)
+
+	case promptGoto:
+		m.prompt = nil
+		if n, err := strconv.Atoi(value); err == nil {
+			m.gotoLine(n)
+		}
+		return m, nil
+	}
+
+	m.prompt = nil
+	return m, nil
+}
+
+// saveAsResultMsg reports the outcome of writing to a new path.
+type saveAsResultMsg struct {
+	path    string
+	content string
+	err     error
+}
+
+// saveTo writes the buffer to path. The original file is left untouched.
+func (m Model) saveTo(path string) tea.Cmd {
+	content := m.textarea.Value()
+	return func() tea.Msg {
+		if dir := filepath.Dir(path); dir != "" {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return saveAsResultMsg{path: path, err: err}
+			}
+		}
+		err := os.WriteFile(path, []byte(content), 0644)
+		return saveAsResultMsg{path: path, content: content, err: err}
+	}
+}
+
+// renderPrompt draws the active prompt in the status bar slot.
+func (m Model) renderPrompt() string {
+	switch m.prompt.kind {
+	case promptRecover:
+		return StatusBarStyle.Width(m.width).Render(
+			ErrorStyle.Render(" Unsaved changes from a previous session ") +
+				" (r)ecover  (d)iscard  (i)gnore",
+		)
+	case promptConfirmClose:
+		return StatusBarStyle.Width(m.width).Render(
+			ErrorStyle.Render(" Unsaved changes ") +
+				" (s)ave  (d)iscard  (c)ancel  ·  Ctrl+Q again to discard",
+		)
+	case promptConfirmOverwrite:
+		return StatusBarStyle.Width(m.width).Render(
+			ErrorStyle.Render(" " + filepath.Base(m.prompt.path) + " exists. Overwrite? (y/n) "),
+		)
+	case promptConfirmReplace:
+		return StatusBarStyle.Width(m.width).Render(
+			StatusModeStyle.Render(" Replace? ") + m.searchStatus() +
+				" (y)es (n)o (a)ll (q)uit",
+		)
+	case promptFind:
+		return StatusBarStyle.Width(m.width).Render(m.prompt.input.View() + m.searchStatus())
+	}
+	return StatusBarStyle.Width(m.width).Render(m.prompt.input.View())
+}
+
+// expandHome expands a leading ~/ to the user's home directory.
+func expandHome(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, path[2:])
+		}
+	}
+	return path
+}
@@ -0,0 +1,317 @@
+package editor
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// match is the position of a search hit, in lines and rune columns.
+type match struct {
+	line int
+	col  int
+}
+
+// search tracks the current find/replace state.
+type search struct {
+	term    string
+	with    string
+	matches []match
+	current int
+	count   int // replacements made in the current replace run
+}
+
+// findMatches returns every occurrence of term in content. The term is
+// matched literally, so regex metacharacters stand for themselves.
+func findMatches(content, term string) []match {
+	if term == "" {
+		return nil
+	}
+	var out []match
+	termLen := len([]rune(term))
+	for i, line := range strings.Split(content, "\n") {
+		runes := []rune(line)
+		for col := 0; col+termLen <= len(runes); {
+			if string(runes[col:col+termLen]) == term {
+				out = append(out, match{line: i, col: col})
+				col += termLen
+				continue
+			}
+			col++
+		}
+	}
+	return out
+}
+
+// openFind prompts for a search term.
+func (m *Model) openFind() {
+	m.prompt = newPrompt(promptFind, " Find: ", m.search.term)
+	m.runSearch(m.search.term)
+}
+
+// openReplace prompts for the term to replace.
+func (m *Model) openReplace() {
+	m.prompt = newPrompt(promptReplace, " Replace: ", m.search.term)
+}
+
+// runSearch recomputes matches for term and jumps to the first one at or
+// after the cursor.
+func (m *Model) runSearch(term string) {
+	m.search.term = term
+	m.search.matches = findMatches(m.textarea.Value(), term)
+	m.search.current = 0
+	if len(m.search.matches) == 0 {
+		return
+	}
+	line, col := m.textarea.Line(), m.textarea.LineInfo().ColumnOffset
+	for i, mt := range m.search.matches {
+		if mt.line > line || (mt.line == line && mt.col >= col) {
+			m.search.current = i
+			break
+		}
+	}
+	m.jumpToMatch()
+}
+
+// stepMatch moves to the next (or previous) match, wrapping around.
+func (m *Model) stepMatch(delta int) {
+	n := len(m.search.matches)
+	if n == 0 {
+		return
+	}
+	m.search.current = (m.search.current + delta + n) % n
+	m.jumpToMatch()
+}
+
+// jumpToMatch moves the cursor to the current match.
+func (m *Model) jumpToMatch() {
+	if len(m.search.matches) == 0 {
+		return
+	}
+	m.moveCursor(m.search.matches[m.search.current])
+}
+
+// moveCursor places the textarea cursor at a line and column.
+func (m *Model) moveCursor(pos match) {
+	// Soft-wrapped rows take several steps per line; cap the loop at the
+	// buffer length so it always terminates.
+	limit := m.textarea.Length() + m.textarea.LineCount()
+	for i := 0; m.textarea.Line() < pos.line && i < limit; i++ {
+		m.textarea.CursorDown()
+	}
+	for i := 0; m.textarea.Line() > pos.line && i < limit; i++ {
+		m.textarea.CursorUp()
+	}
+	m.textarea.SetCursor(pos.col)
+	m.cursorLine = m.textarea.Line()
+	m.cursorCol = m.textarea.LineInfo().ColumnOffset
+	m.updateScroll()
+}
+
+// replaceCurrent replaces the current match and re-finds the rest.
+func (m *Model) replaceCurrent() {
+	if len(m.search.matches) == 0 {
+		return
+	}
+	mt := m.search.matches[m.search.current]
+	lines := strings.Split(m.textarea.Value(), "\n")
+	runes := []rune(lines[mt.line])
+	termLen := len([]rune(m.search.term))
+	lines[mt.line] = string(runes[:mt.col]) + m.search.with + string(runes[mt.col+termLen:])
+	m.setValueKeepCursor(strings.Join(lines, "\n"), mt)
+	m.search.count++
+
+	// Continue after the inserted text so a replacement containing the
+	// term isn't matched again.
+	after := match{line: mt.line, col: mt.col + len([]rune(m.search.with))}
+	m.search.matches = findMatches(m.textarea.Value(), m.search.term)
+	m.search.current = 0
+	for i, next := range m.search.matches {
+		if next.line > after.line || (next.line == after.line && next.col >= after.col) {
+			m.search.current = i
+			m.jumpToMatch()
+			return
+		}
+	}
+	m.search.matches = nil
+}
+
+// replaceAll replaces every remaining match from the current one onward.
+func (m *Model) replaceAll() {
+	for len(m.search.matches) > 0 {
+		m.replaceCurrent()
+	}
+}
+
+// setValueKeepCursor swaps the buffer contents without losing the cursor.
+func (m *Model) setValueKeepCursor(content string, pos match) {
+	m.textarea.SetValue(content)
+	m.textarea.CursorStart()
+	for m.textarea.Line() > 0 {
+		m.textarea.CursorUp()
+	}
+	m.lines = strings.Split(content, "\n")
+	m.modified = m.IsDirty()
+	m.moveCursor(pos)
+}
+
+// handleSearchKey handles keys for the find, replace and confirm prompts.
+func (m Model) handleSearchKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	p := m.prompt
+	key := msg.String()
+
+	switch p.kind {
+	case promptFind:
+		switch key {
+		case "esc", "ctrl+c":
+			m.prompt = nil
+			return m, nil
+		case "enter", "down", "ctrl+n":
+			m.stepMatch(1)
+			return m, nil
+		case "up", "ctrl+p":
+			m.stepMatch(-1)
+			return m, nil
+		}
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		if p.input.Value() != m.search.term {
+			m.runSearch(p.input.Value())
+		}
+		return m, cmd
+
+	case promptReplace, promptReplaceWith:
+		switch key {
+		case "esc", "ctrl+c":
+			m.prompt = nil
+			return m, nil
+		case "enter":
+			if p.kind == promptReplace {
+				if p.input.Value() == "" {
+					m.prompt = nil
+					return m, nil
+				}
+				m.search.term = p.input.Value()
+				m.prompt = newPrompt(promptReplaceWith, " With: ", m.search.with)
+				return m, nil
+			}
+			m.search.with = p.input.Value()
+			m.search.count = 0
+			m.runSearch(m.search.term)
+			if len(m.search.matches) == 0 {
+				m.prompt = nil
+				m.message = "No matches for " + m.search.term
+				m.messageErr = true
+				return m, nil
+			}
+			m.prompt = &prompt{kind: promptConfirmReplace}
+			return m, nil
+		}
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		return m, cmd
+
+	case promptConfirmReplace:
+		switch key {
+		case "y", "Y":
+			m.replaceCurrent()
+		case "n", "N":
+			m.stepMatch(1)
+		case "a", "A":
+			m.replaceAll()
+		case "q", "Q", "esc", "ctrl+c":
+			m.search.matches = nil
+		default:
+			return m, nil
+		}
+		if len(m.search.matches) == 0 {
+			m.prompt = nil
+			m.message = "Replaced " + itoa(m.search.count) + " occurrence(s)"
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// highlightMatches marks the search hits in a rendered textarea view while
+// the find or confirm-replace prompt is open, the current hit in its own
+// style. Hits are found in each row's visible text, so one split by a soft
+// wrap is left unmarked.
+func (m Model) highlightMatches(view string) string {
+	if m.prompt == nil || (m.prompt.kind != promptFind && m.prompt.kind != promptConfirmReplace) {
+		return view
+	}
+	term := m.search.term
+	if term == "" || len(m.search.matches) == 0 {
+		return view
+	}
+
+	// The row holding the cursor is the one that changes when the cursor
+	// blinks; the current hit starts under the cursor.
+	alt := m.textarea
+	alt.Cursor.Blink = !alt.Cursor.Blink
+	altRows := strings.Split(alt.View(), "\n")
+	atCurrent := m.search.matches[m.search.current] == match{line: m.textarea.Line(), col: m.textarea.LineInfo().ColumnOffset}
+
+	gutter := lipgloss.Width(m.textarea.Prompt)
+	if m.textarea.ShowLineNumbers {
+		gutter += len(itoa(m.textarea.MaxHeight)) + 2
+	}
+	termWidth := ansi.StringWidth(term)
+
+	rows := strings.Split(view, "\n")
+	for i, row := range rows {
+		plain := ansi.Strip(row)
+		width := ansi.StringWidth(plain)
+		if width <= gutter {
+			continue
+		}
+		text := ansi.Cut(plain, gutter, width)
+
+		cursorCell := -1
+		if atCurrent && i < len(altRows) && altRows[i] != row {
+			runes := []rune(text)
+			col := min(m.textarea.LineInfo().ColumnOffset, len(runes))
+			cursorCell = gutter + ansi.StringWidth(string(runes[:col]))
+		}
+
+		var b strings.Builder
+		prev, cell := 0, gutter
+		for {
+			idx := strings.Index(text, term)
+			if idx < 0 {
+				break
+			}
+			cell += ansi.StringWidth(text[:idx])
+			style := SearchMatchStyle
+			if cell == cursorCell {
+				style = SearchCurrentStyle
+			}
+			b.WriteString(ansi.Cut(row, prev, cell))
+			b.WriteString(style.Render(term))
+			cell += termWidth
+			prev = cell
+			text = text[idx+len(term):]
+		}
+		if prev == 0 {
+			continue
+		}
+		b.WriteString(ansi.Cut(row, prev, width))
+		rows[i] = b.String()
+	}
+	return strings.Join(rows, "\n")
+}
+
+// searchStatus describes the match position for the find prompt.
+func (m Model) searchStatus() string {
+	if m.search.term == "" {
+		return ""
+	}
+	if len(m.search.matches) == 0 {
+		return ErrorStyle.Render(" no matches ")
+	}
+	return StatusPosStyle.Render(" " + itoa(m.search.current+1) + "/" + itoa(len(m.search.matches)) + " ")
+}
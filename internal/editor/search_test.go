@@ -0,0 +1,163 @@
+package editor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+)
+
+func TestFindMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		term    string
+		want    []match
+	}{
+		{"empty term", "abc", "", nil},
+		{"no match", "alpha\nbeta", "gamma", nil},
+		{"every line", "go go\nno\ngo", "go", []match{{0, 0}, {0, 3}, {2, 0}}},
+		{"non-overlapping", "aaaa", "aa", []match{{0, 0}, {0, 2}}},
+		{"rune columns", "héllo wörld", "wö", []match{{0, 6}}},
+		{"regex dot is literal", "abc a.c", "a.c", []match{{0, 4}}},
+		{"regex class is literal", "x1 [0-9]", "[0-9]", []match{{0, 3}}},
+		{"regex anchor is literal", "a^b\n^b", "^b", []match{{0, 1}, {1, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMatches(tt.content, tt.term)
+			if len(got) != len(tt.want) {
+				t.Fatalf("findMatches(%q, %q) = %v, want %v", tt.content, tt.term, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("match %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// searchModel is an editor holding content with a search for term run.
+func searchModel(content, term, with string) Model {
+	m := New()
+	m.SetSize(80, 20)
+	m.SetContent(content)
+	m.search.with = with
+	m.runSearch(term)
+	return m
+}
+
+func TestReplaceCurrent(t *testing.T) {
+	m := searchModel("cat cat\ncat", "cat", "dog")
+	m.replaceCurrent()
+	if got := m.GetContent(); got != "dog cat\ncat" {
+		t.Errorf("content = %q", got)
+	}
+	if len(m.search.matches) != 2 || m.search.matches[m.search.current] != (match{0, 4}) {
+		t.Errorf("matches = %v current %d; want the next hit on line 0", m.search.matches, m.search.current)
+	}
+	if m.search.count != 1 || !m.IsDirty() {
+		t.Errorf("count = %d, dirty = %v", m.search.count, m.IsDirty())
+	}
+}
+
+func TestReplaceCurrent_ReplacementContainsTerm(t *testing.T) {
+	m := searchModel("a a", "a", "aa")
+	m.replaceCurrent()
+	if got := m.GetContent(); got != "aa a" {
+		t.Errorf("content = %q", got)
+	}
+	if m.search.matches[m.search.current] != (match{0, 3}) {
+		t.Errorf("current = %v; the inserted text should be skipped", m.search.matches[m.search.current])
+	}
+}
+
+func TestReplaceCurrent_NoMatch(t *testing.T) {
+	m := searchModel("alpha", "beta", "gamma")
+	m.replaceCurrent()
+	if got := m.GetContent(); got != "alpha" || m.search.count != 0 || m.IsDirty() {
+		t.Errorf("content = %q, count = %d, dirty = %v; want nothing replaced", got, m.search.count, m.IsDirty())
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		name, content, term, with, want string
+		count                           int
+	}{
+		{"every line", "x = 1\ny = x\nx", "x", "z", "z = 1\ny = z\nz", 3},
+		{"grows", "a a", "a", "aa", "aa aa", 2},
+		{"shrinks", "foofoo", "foo", "", "", 2},
+		{"regex is literal", "a.c abc a.c", "a.c", "X", "X abc X", 2},
+		{"no match", "alpha", "beta", "gamma", "alpha", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := searchModel(tt.content, tt.term, tt.with)
+			m.replaceAll()
+			if got := m.GetContent(); got != tt.want {
+				t.Errorf("content = %q, want %q", got, tt.want)
+			}
+			if m.search.count != tt.count {
+				t.Errorf("count = %d, want %d", m.search.count, tt.count)
+			}
+			if len(m.search.matches) != 0 {
+				t.Errorf("matches left: %v", m.search.matches)
+			}
+		})
+	}
+}
+
+func TestReplaceAll_FromCurrent(t *testing.T) {
+	m := searchModel("x x x", "x", "y")
+	m.stepMatch(1)
+	m.replaceAll()
+	if got := m.GetContent(); got != "x y y" {
+		t.Errorf("content = %q; hits before the current one should stay", got)
+	}
+}
+
+func TestHighlightMatches(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.ANSI)
+
+	m := searchModel("one two\ntwo one two", "two", "")
+	m.openFind()
+	m.stepMatch(1) // line 1, col 0
+
+	view := m.renderContent()
+	if got := strings.Count(view, SearchMatchStyle.Render("two")); got != 2 {
+		t.Errorf("%d plain hits highlighted, want 2", got)
+	}
+	if got := strings.Count(view, SearchCurrentStyle.Render("two")); got != 1 {
+		t.Errorf("%d current hits highlighted, want 1", got)
+	}
+	plain := strings.Split(ansi.Strip(view), "\n")
+	if !strings.Contains(plain[2], "two one two") {
+		t.Errorf("highlighting changed the text: %q", plain[2])
+	}
+
+	m.prompt = nil
+	if view := m.renderContent(); strings.Contains(view, SearchMatchStyle.Render("two")) {
+		t.Error("hits stay highlighted after the prompt closes")
+	}
+}
+
+func TestHighlightMatches_LineNumbers(t *testing.T) {
+	defer lipgloss.SetColorProfile(lipgloss.ColorProfile())
+	lipgloss.SetColorProfile(termenv.ANSI)
+
+	m := searchModel("a\nb 1", "1", "")
+	m.ToggleLineNumbers()
+	m.openFind()
+	view := m.renderContent()
+	if got := strings.Count(view, SearchCurrentStyle.Render("1")); got != 1 {
+		t.Errorf("%d current hits highlighted, want the one in the text", got)
+	}
+	if got := strings.Count(view, SearchMatchStyle.Render("1")); got != 0 {
+		t.Errorf("%d gutter line numbers highlighted", got)
+	}
+}
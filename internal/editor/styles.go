@@ -64,6 +64,16 @@ var (
 	CursorLineStyle = lipgloss.NewStyle().
 			Background(Gray800)
 
+	// Search hits
+	SearchMatchStyle = lipgloss.NewStyle().
+				Background(Gray700).
+				Foreground(Gray100)
+
+	SearchCurrentStyle = lipgloss.NewStyle().
+				Background(Amber).
+				Foreground(Gray900).
+				Bold(true)
+
 	// Status bar
 	StatusBarStyle = lipgloss.NewStyle().
 			Background(Gray800).
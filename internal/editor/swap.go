@@ -0,0 +1,97 @@
+package editor
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// AutosaveInterval is how often a dirty buffer is written to its swap file.
+const AutosaveInterval = 30 * time.Second
+
+// editorSeq numbers editor instances so a closed editor's autosave ticks
+// don't drive a newer one.
+var editorSeq atomic.Int64
+
+// autosaveMsg fires the periodic swap write for one editor instance.
+type autosaveMsg struct {
+	id int64
+}
+
+func autosaveTick(id int64) tea.Cmd {
+	return tea.Tick(AutosaveInterval, func(time.Time) tea.Msg {
+		return autosaveMsg{id: id}
+	})
+}
+
+// SwapPath returns the swap file for path: .name.swp beside the file.
+func SwapPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".swp")
+}
+
+// writeSwap saves the buffer to the swap file if it has unsaved changes.
+func (m Model) writeSwap() {
+	if m.filepath == "" || !m.IsDirty() {
+		return
+	}
+	_ = os.WriteFile(SwapPath(m.filepath), []byte(m.textarea.Value()), 0600)
+}
+
+// RemoveSwap deletes the swap file, if any. Called once the buffer is saved
+// or deliberately discarded.
+func (m Model) RemoveSwap() {
+	if m.filepath == "" {
+		return
+	}
+	_ = os.Remove(SwapPath(m.filepath))
+}
+
+// swapNewer reports whether a swap file exists that is newer than path.
+func swapNewer(path string) bool {
+	swap, err := os.Stat(SwapPath(path))
+	if err != nil {
+		return false
+	}
+	file, err := os.Stat(path)
+	if err != nil {
+		return true
+	}
+	return swap.ModTime().After(file.ModTime())
+}
+
+// offerRecovery opens the recover prompt when a newer swap file exists.
+func (m *Model) offerRecovery() {
+	if m.filepath != "" && swapNewer(m.filepath) {
+		m.prompt = &prompt{kind: promptRecover}
+	}
+}
+
+// handleRecoverKey handles the recover/discard/ignore prompt.
+func (m Model) handleRecoverKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "r", "R":
+		data, err := os.ReadFile(SwapPath(m.filepath))
+		m.prompt = nil
+		if err != nil {
+			m.message = "Recovery failed: " + err.Error()
+			m.messageErr = true
+			return m, nil
+		}
+		saved := m.saved
+		m.SetContent(string(data))
+		// Recovered text is unsaved until written to the real file.
+		m.saved = saved
+		m.modified = m.IsDirty()
+		m.message = "Recovered unsaved changes"
+	case "d", "D":
+		m.prompt = nil
+		m.RemoveSwap()
+		m.message = "Swap file discarded"
+	case "i", "I", "esc", "ctrl+c":
+		m.prompt = nil
+	}
+	return m, nil
+}
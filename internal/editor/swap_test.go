@@ -0,0 +1,124 @@
+package editor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// swapFixture writes a file and a newer swap beside it, returning the path.
+func swapFixture(t *testing.T, content, swap string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if swap != "" {
+		if err := os.WriteFile(SwapPath(path), []byte(swap), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func keyRunes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestSwap_WrittenOnlyWhenDirty(t *testing.T) {
+	path := swapFixture(t, "saved\n", "")
+	m, err := NewWithFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, _ := m.Update(autosaveMsg{id: m.id})
+	m = updated.(Model)
+	if _, err := os.Stat(SwapPath(path)); !os.IsNotExist(err) {
+		t.Fatalf("clean buffer wrote a swap file (stat err = %v)", err)
+	}
+
+	m.textarea.SetValue("edited\n")
+	updated, _ = m.Update(autosaveMsg{id: m.id})
+	m = updated.(Model)
+	if data, err := os.ReadFile(SwapPath(path)); err != nil || string(data) != "edited\n" {
+		t.Fatalf("swap = %q, %v; want the edited buffer", data, err)
+	}
+
+	// A tick from another editor instance is ignored
+	m.textarea.SetValue("edited again\n")
+	m.Update(autosaveMsg{id: m.id + 1})
+	if data, _ := os.ReadFile(SwapPath(path)); string(data) != "edited\n" {
+		t.Errorf("foreign tick rewrote the swap: %q", data)
+	}
+}
+
+func TestSwap_Recover(t *testing.T) {
+	path := swapFixture(t, "saved\n", "unsaved\n")
+	m, err := NewWithFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.prompt == nil || m.prompt.kind != promptRecover {
+		t.Fatal("a newer swap file should offer recovery")
+	}
+
+	m, _ = m.handleRecoverKey(keyRunes("r"))
+	if m.GetContent() != "unsaved\n" || !m.IsDirty() {
+		t.Errorf("content = %q, dirty = %v; want the recovered, unsaved text", m.GetContent(), m.IsDirty())
+	}
+
+	// Saving the recovered text retires the swap
+	updated, _ := m.Update(saveResultMsg{content: m.GetContent()})
+	m = updated.(Model)
+	if _, err := os.Stat(SwapPath(path)); !os.IsNotExist(err) {
+		t.Errorf("swap kept after a successful save (stat err = %v)", err)
+	}
+}
+
+func TestSwap_IgnoreKeepsSwap(t *testing.T) {
+	path := swapFixture(t, "saved\n", "unsaved\n")
+	m, err := NewWithFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, _ = m.handleRecoverKey(keyRunes("i"))
+	if m.prompt != nil || m.GetContent() != "saved\n" || m.IsDirty() {
+		t.Errorf("ignore should close the prompt and keep the file text, got %q", m.GetContent())
+	}
+	if data, err := os.ReadFile(SwapPath(path)); err != nil || string(data) != "unsaved\n" {
+		t.Errorf("ignored swap was not kept: %q, %v", data, err)
+	}
+}
+
+func TestSwap_Discard(t *testing.T) {
+	path := swapFixture(t, "saved\n", "unsaved\n")
+	m, err := NewWithFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, _ = m.handleRecoverKey(keyRunes("d"))
+	if _, err := os.Stat(SwapPath(path)); !os.IsNotExist(err) {
+		t.Errorf("discarded swap still present (stat err = %v)", err)
+	}
+
+	// Discarding a dirty buffer at close removes the swap it wrote
+	m.textarea.SetValue("edited\n")
+	m.writeSwap()
+	m.ConfirmClose()
+	if _, cmd := m.handleCloseKey(keyRunes("d")); cmd == nil {
+		t.Fatal("discard should close the editor")
+	}
+	if _, err := os.Stat(SwapPath(path)); !os.IsNotExist(err) {
+		t.Errorf("swap kept after discarding on close (stat err = %v)", err)
+	}
+}
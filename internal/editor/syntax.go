@@ -100,20 +100,59 @@ func NewHighlighter(lang Language) *Highlighter {
 	return &Highlighter{lang: lang}
 }
 
+// TokenKind classifies a highlighted span.
+type TokenKind int
+
+const (
+	TokenText TokenKind = iota
+	TokenKeyword
+	TokenString
+	TokenNumber
+	TokenComment
+)
+
+// Token is a span of a line with its syntax class.
+type Token struct {
+	Kind TokenKind
+	Text string
+}
+
+// tokenStyles maps token kinds to terminal styles.
+var tokenStyles = map[TokenKind]lipgloss.Style{
+	TokenKeyword: KeywordStyle,
+	TokenString:  StringStyle,
+	TokenNumber:  NumberStyle,
+	TokenComment: CommentStyle,
+}
+
 // HighlightLine applies syntax highlighting to a line
 func (h *Highlighter) HighlightLine(line string) string {
+	var b strings.Builder
+	for _, tok := range h.Tokens(line) {
+		if style, ok := tokenStyles[tok.Kind]; ok {
+			b.WriteString(style.Render(tok.Text))
+		} else {
+			b.WriteString(tok.Text)
+		}
+	}
+	return b.String()
+}
+
+// Tokens splits a line into classified spans. Renderers other than the
+// terminal (e.g. HTML export) use this directly.
+func (h *Highlighter) Tokens(line string) []Token {
 	if h.lang == LangPlain {
-		return line
+		return []Token{{Kind: TokenText, Text: line}}
 	}
 
 	// Check for comments first
 	trimmed := strings.TrimSpace(line)
 	if h.isComment(trimmed) {
-		return CommentStyle.Render(line)
+		return []Token{{Kind: TokenComment, Text: line}}
 	}
 
 	// Simple token-based highlighting
-	return h.highlightTokens(line)
+	return h.tokenize(line)
 }
 
 func (h *Highlighter) isComment(line string) bool {
@@ -130,36 +169,37 @@ func (h *Highlighter) isComment(line string) bool {
 	return false
 }
 
-func (h *Highlighter) highlightTokens(line string) string {
+func (h *Highlighter) tokenize(line string) []Token {
 	kws, ok := keywords[h.lang]
 	if !ok {
-		return line
+		return []Token{{Kind: TokenText, Text: line}}
 	}
 
-	result := line
+	var tokens []Token
+	emit := func(kind TokenKind, text string) {
+		// Merge runs of plain text
+		if kind == TokenText && len(tokens) > 0 && tokens[len(tokens)-1].Kind == TokenText {
+			tokens[len(tokens)-1].Text += text
+			return
+		}
+		tokens = append(tokens, Token{Kind: kind, Text: text})
+	}
 
-	// Highlight strings first (simple approach)
-	inString := false
-	stringChar := byte(0)
-	var highlighted strings.Builder
 	i := 0
-
 	for i < len(line) {
 		ch := line[i]
 
 		// Handle string literals
-		if !inString && (ch == '"' || ch == '\'' || ch == '`') {
-			inString = true
-			stringChar = ch
+		if ch == '"' || ch == '\'' || ch == '`' {
 			start := i
 			i++
-			for i < len(line) && (line[i] != stringChar || (i > 0 && line[i-1] == '\\')) {
+			for i < len(line) && (line[i] != ch || line[i-1] == '\\') {
 				i++
 			}
 			if i < len(line) {
 				i++ // include closing quote
 			}
-			highlighted.WriteString(StringStyle.Render(line[start:i]))
+			emit(TokenString, line[start:i])
 			continue
 		}
 
@@ -171,9 +211,9 @@ func (h *Highlighter) highlightTokens(line string) string {
 			}
 			word := line[start:i]
 			if contains(kws, word) {
-				highlighted.WriteString(KeywordStyle.Render(word))
+				emit(TokenKeyword, word)
 			} else {
-				highlighted.WriteString(word)
+				emit(TokenText, word)
 			}
 			continue
 		}
@@ -184,20 +224,43 @@ func (h *Highlighter) highlightTokens(line string) string {
 			for i < len(line) && (isDigit(line[i]) || line[i] == '.' || line[i] == 'x' || line[i] == 'X') {
 				i++
 			}
-			highlighted.WriteString(NumberStyle.Render(line[start:i]))
+			emit(TokenNumber, line[start:i])
 			continue
 		}
 
 		// Regular character
-		highlighted.WriteByte(ch)
+		emit(TokenText, string(ch))
 		i++
 	}
 
-	if highlighted.Len() > 0 {
-		result = highlighted.String()
-	}
+	return tokens
+}
 
-	return result
+// LanguageFromName maps a code fence info string ("go", "py", "bash", ...)
+// to a Language.
+func LanguageFromName(name string) Language {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "golang":
+		name = "go"
+	case "python":
+		name = "py"
+	case "javascript":
+		name = "js"
+	case "typescript":
+		name = "ts"
+	case "rust":
+		name = "rs"
+	case "elixir":
+		name = "ex"
+	case "erlang":
+		name = "erl"
+	case "shell", "bash", "zsh", "console":
+		name = "sh"
+	case "markdown":
+		name = "md"
+	}
+	return DetectLanguage("x." + name)
 }
 
 func isAlpha(ch byte) bool {
@@ -5,12 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 // FactMsg is a domain fact received from the daemon over SSE.
@@ -30,23 +30,22 @@ type FactErrorMsg struct{ Err error }
 
 // Connection manages an SSE subscription to the daemon's /api/facts/stream endpoint.
 type Connection struct {
-	socketPath string
-	baseURL    string
-	factChan   chan FactMsg
-	ctx        context.Context
-	cancel     context.CancelFunc
+	client   *client.Client
+	factChan chan FactMsg
+	err      error // why the stream gave up, set before the channel closes
+	ctx      context.Context
+	cancel   context.CancelFunc
 }
 
-// NewConnection creates a factbus connection. Pass socketPath for Unix socket,
-// or empty string + baseURL for TCP.
-func NewConnection(socketPath, baseURL string) *Connection {
+// NewConnection creates a factbus connection that reaches the daemon the
+// same way c does (Unix socket, or TCP with c's TLS settings).
+func NewConnection(c *client.Client) *Connection {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Connection{
-		socketPath: socketPath,
-		baseURL:    baseURL,
-		factChan:   make(chan FactMsg, 50),
-		ctx:        ctx,
-		cancel:     cancel,
+		client:   c,
+		factChan: make(chan FactMsg, 50),
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 }
 
@@ -63,6 +62,9 @@ func (c *Connection) PollCmd() tea.Cmd {
 		select {
 		case fact, ok := <-c.factChan:
 			if !ok {
+				if c.err != nil {
+					return FactErrorMsg{Err: c.err}
+				}
 				return FactDisconnectedMsg{}
 			}
 			return fact
@@ -88,7 +90,11 @@ func (c *Connection) connectLoop() {
 		default:
 		}
 
-		c.runSSE()
+		// A rejected token won't fix itself; stop and report it
+		if err := c.runSSE(); err != nil {
+			c.err = err
+			return
+		}
 
 		// If context cancelled, exit
 		select {
@@ -107,43 +113,33 @@ func (c *Connection) connectLoop() {
 }
 
 // runSSE establishes one SSE connection and reads until disconnect/error.
-func (c *Connection) runSSE() {
-	url := c.baseURL + "/api/facts/stream"
+func (c *Connection) runSSE() error {
+	url := c.client.BaseURL() + "/api/facts/stream"
 
 	httpReq, err := http.NewRequestWithContext(c.ctx, "GET", url, nil)
 	if err != nil {
-		return
+		return nil
 	}
 	httpReq.Header.Set("Accept", "text/event-stream")
+	c.client.Authorize(httpReq)
 
-	transport := &http.Transport{
-		IdleConnTimeout:       0,
-		ResponseHeaderTimeout: 0,
-		ExpectContinueTimeout: 0,
-	}
-	if c.socketPath != "" {
-		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, "unix", c.socketPath)
-		}
-	}
-
-	httpClient := &http.Client{
-		Transport: transport,
-		Timeout:   0,
-	}
+	httpClient := c.client.StreamHTTPClient()
 
 	resp, err := httpClient.Do(httpReq)
 	if err != nil {
-		return
+		return nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if err := c.client.CheckAuth(resp); err != nil {
+		return err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return
+		return nil
 	}
 
 	c.readSSE(resp.Body)
+	return nil
 }
 
 // readSSE parses SSE lines from the response body.
@@ -6,6 +6,8 @@ import (
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 func TestParsesSSEFact(t *testing.T) {
@@ -23,7 +25,7 @@ func TestParsesSSEFact(t *testing.T) {
 	}))
 	defer server.Close()
 
-	conn := NewConnection("", server.URL)
+	conn := NewConnection(client.New(server.URL))
 	defer conn.Close()
 
 	go conn.connectLoop()
@@ -56,7 +58,7 @@ func TestSkipsCommentsAndHeartbeats(t *testing.T) {
 	}))
 	defer server.Close()
 
-	conn := NewConnection("", server.URL)
+	conn := NewConnection(client.New(server.URL))
 	defer conn.Close()
 
 	go conn.connectLoop()
@@ -84,7 +86,7 @@ func TestSkipsDONE(t *testing.T) {
 	}))
 	defer server.Close()
 
-	conn := NewConnection("", server.URL)
+	conn := NewConnection(client.New(server.URL))
 	defer conn.Close()
 
 	go conn.connectLoop()
@@ -115,7 +117,7 @@ func TestSkipsMalformedJSON(t *testing.T) {
 	}))
 	defer server.Close()
 
-	conn := NewConnection("", server.URL)
+	conn := NewConnection(client.New(server.URL))
 	defer conn.Close()
 
 	go conn.connectLoop()
@@ -131,7 +133,7 @@ func TestSkipsMalformedJSON(t *testing.T) {
 }
 
 func TestPollCmdReturnsContinueWhenEmpty(t *testing.T) {
-	conn := NewConnection("", "http://localhost:99999")
+	conn := NewConnection(client.New("http://localhost:99999"))
 
 	cmd := conn.PollCmd()
 	msg := cmd()
@@ -154,7 +156,7 @@ func TestCloseDisconnects(t *testing.T) {
 	}))
 	defer server.Close()
 
-	conn := NewConnection("", server.URL)
+	conn := NewConnection(client.New(server.URL))
 	go conn.connectLoop()
 
 	// Give time for connection to establish
@@ -176,3 +178,59 @@ func TestCloseDisconnects(t *testing.T) {
 		t.Fatal("Timed out waiting for channel close")
 	}
 }
+
+func TestSendsTokenAndStopsOnRejection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(200)
+		_, _ = fmt.Fprint(w, "data: {\"fact_type\":\"authed\",\"data\":{}}\n\n")
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	c := client.New(server.URL)
+	c.SetToken("good")
+	conn := NewConnection(c)
+	defer conn.Close()
+	go conn.connectLoop()
+
+	select {
+	case fact := <-conn.factChan:
+		if fact.FactType != "authed" {
+			t.Errorf("Expected 'authed', got '%s'", fact.FactType)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for fact")
+	}
+
+	bad := client.New(server.URL)
+	bad.SetToken("bad")
+	rejected := NewConnection(bad)
+	defer rejected.Close()
+	go rejected.connectLoop()
+
+	// The loop gives up instead of retrying, and PollCmd reports why
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case <-deadline:
+			t.Fatal("Timed out waiting for the stream to give up")
+		default:
+		}
+		switch msg := rejected.PollCmd()().(type) {
+		case FactErrorMsg:
+			if msg.Err != client.ErrTokenRejected {
+				t.Errorf("Expected ErrTokenRejected, got %v", msg.Err)
+			}
+			return
+		case FactDisconnectedMsg:
+			t.Fatal("Expected FactErrorMsg, got FactDisconnectedMsg")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -8,12 +8,12 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net"
 	"net/http"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 // StreamEvent is a raw SSE event from the IRC stream.
@@ -36,24 +36,26 @@ type IrcContinueMsg struct{}
 // IrcDisconnectedMsg signals the SSE connection was lost.
 type IrcDisconnectedMsg struct{}
 
+// IrcErrorMsg signals the stream stopped for good, e.g. a rejected token.
+type IrcErrorMsg struct{ Err error }
+
 // Connection manages the SSE subscription to /api/irc/stream.
 type Connection struct {
-	socketPath string
-	baseURL    string
-	eventChan  chan StreamEvent
-	ctx        context.Context
-	cancel     context.CancelFunc
+	client    *client.Client
+	eventChan chan StreamEvent
+	err       error // why the stream gave up, set before the channel closes
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
 // NewConnection creates an IRC stream connection.
-func NewConnection(socketPath, baseURL string) *Connection {
+func NewConnection(c *client.Client) *Connection {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Connection{
-		socketPath: socketPath,
-		baseURL:    baseURL,
-		eventChan:  make(chan StreamEvent, 50),
-		ctx:        ctx,
-		cancel:     cancel,
+		client:    c,
+		eventChan: make(chan StreamEvent, 50),
+		ctx:       ctx,
+		cancel:    cancel,
 	}
 }
 
@@ -70,6 +72,9 @@ func (c *Connection) PollCmd() tea.Cmd {
 		select {
 		case evt, ok := <-c.eventChan:
 			if !ok {
+				if c.err != nil {
+					return IrcErrorMsg{Err: c.err}
+				}
 				return IrcDisconnectedMsg{}
 			}
 			return IrcEventMsg{Event: evt}
@@ -95,7 +100,11 @@ func (c *Connection) connectLoop() {
 		default:
 		}
 
-		c.runSSE()
+		// A rejected token won't fix itself; stop and report it
+		if err := c.runSSE(); err != nil {
+			c.err = err
+			return
+		}
 
 		select {
 		case <-c.ctx.Done():
@@ -112,43 +121,33 @@ func (c *Connection) connectLoop() {
 }
 
 // runSSE establishes one SSE connection and reads until disconnect.
-func (c *Connection) runSSE() {
-	url := c.baseURL + "/api/irc/stream"
+func (c *Connection) runSSE() error {
+	url := c.client.BaseURL() + "/api/irc/stream"
 
 	httpReq, err := http.NewRequestWithContext(c.ctx, "GET", url, nil)
 	if err != nil {
-		return
+		return nil
 	}
 	httpReq.Header.Set("Accept", "text/event-stream")
+	c.client.Authorize(httpReq)
 
-	transport := &http.Transport{
-		IdleConnTimeout:       0,
-		ResponseHeaderTimeout: 0,
-		ExpectContinueTimeout: 0,
-	}
-	if c.socketPath != "" {
-		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, "unix", c.socketPath)
-		}
-	}
-
-	httpClient := &http.Client{
-		Transport: transport,
-		Timeout:   0,
-	}
+	httpClient := c.client.StreamHTTPClient()
 
 	resp, err := httpClient.Do(httpReq)
 	if err != nil {
-		return
+		return nil
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if err := c.client.CheckAuth(resp); err != nil {
+		return err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return
+		return nil
 	}
 
 	c.readSSE(resp.Body)
+	return nil
 }
 
 // readSSE parses SSE lines from the response body.
@@ -0,0 +1,33 @@
+package llm
+
+import "strings"
+
+// SupportsTools reports whether the model can do function calling. known is
+// false when the daemon didn't report capabilities.
+func (m Model) SupportsTools() (supported, known bool) {
+	if m.Capabilities == nil {
+		return false, false
+	}
+	for _, c := range m.Capabilities {
+		switch strings.ToLower(c) {
+		case "tools", "tool_use", "function_calling":
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// IsPaid reports whether the model runs on a commercial provider.
+func (m Model) IsPaid() bool {
+	return IsPaidProvider(m.Provider)
+}
+
+// IsPaidProvider reports whether provider bills per token.
+func IsPaidProvider(provider string) bool {
+	switch provider {
+	case "anthropic", "openai", "google", "groq", "together":
+		return true
+	default:
+		return false
+	}
+}
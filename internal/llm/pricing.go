@@ -0,0 +1,69 @@
+package llm
+
+import "strings"
+
+// Price is what a model costs, in dollars per 1,000 tokens.
+type Price struct {
+	Input  float64 `toml:"input" json:"input"`
+	Output float64 `toml:"output" json:"output"`
+}
+
+// Cost returns the price of a call with the given prompt and completion
+// token counts.
+func (p Price) Cost(promptTokens, completionTokens int) float64 {
+	return float64(promptTokens)/1000*p.Input + float64(completionTokens)/1000*p.Output
+}
+
+// DefaultPrices are list prices for common hosted models, keyed
+// "provider/model". Dated model names match by prefix, so
+// "claude-3-5-haiku-20241022" uses the "claude-3-5-haiku" entry.
+var DefaultPrices = map[string]Price{
+	"anthropic/claude-opus-4":     {Input: 0.015, Output: 0.075},
+	"anthropic/claude-sonnet-4":   {Input: 0.003, Output: 0.015},
+	"anthropic/claude-3-7-sonnet": {Input: 0.003, Output: 0.015},
+	"anthropic/claude-3-5-sonnet": {Input: 0.003, Output: 0.015},
+	"anthropic/claude-3-5-haiku":  {Input: 0.0008, Output: 0.004},
+	"anthropic/claude-3-opus":     {Input: 0.015, Output: 0.075},
+	"anthropic/claude-3-haiku":    {Input: 0.00025, Output: 0.00125},
+
+	"openai/gpt-4.1":      {Input: 0.002, Output: 0.008},
+	"openai/gpt-4.1-mini": {Input: 0.0004, Output: 0.0016},
+	"openai/gpt-4o":       {Input: 0.0025, Output: 0.01},
+	"openai/gpt-4o-mini":  {Input: 0.00015, Output: 0.0006},
+	"openai/gpt-4-turbo":  {Input: 0.01, Output: 0.03},
+	"openai/o3-mini":      {Input: 0.0011, Output: 0.0044},
+
+	"google/gemini-2.0-flash": {Input: 0.0001, Output: 0.0004},
+	"google/gemini-1.5-pro":   {Input: 0.00125, Output: 0.005},
+	"google/gemini-1.5-flash": {Input: 0.000075, Output: 0.0003},
+
+	"groq/llama-3.3-70b-versatile": {Input: 0.00059, Output: 0.00079},
+	"groq/llama-3.1-8b-instant":    {Input: 0.00005, Output: 0.00008},
+}
+
+// LookupPrice finds the price of a model. User overrides (keyed
+// "provider/model" or "model") win over DefaultPrices; defaults also match
+// dated model names by their longest known prefix.
+func LookupPrice(overrides map[string]Price, provider, model string) (Price, bool) {
+	if p, ok := overrides[provider+"/"+model]; ok {
+		return p, true
+	}
+	if p, ok := overrides[model]; ok {
+		return p, true
+	}
+
+	key := provider + "/" + model
+	if p, ok := DefaultPrices[key]; ok {
+		return p, true
+	}
+	best, found := "", false
+	for k := range DefaultPrices {
+		if strings.HasPrefix(key, k) && len(k) > len(best) {
+			best, found = k, true
+		}
+	}
+	if found {
+		return DefaultPrices[best], true
+	}
+	return Price{}, false
+}
@@ -0,0 +1,34 @@
+package llm
+
+import "testing"
+
+func TestLookupPrice(t *testing.T) {
+	overrides := map[string]Price{
+		"openai/gpt-4o": {Input: 1, Output: 2},
+	}
+
+	tests := []struct {
+		provider, model string
+		want            Price
+		ok              bool
+	}{
+		{"openai", "gpt-4o", Price{Input: 1, Output: 2}, true},                          // override
+		{"openai", "gpt-4o-mini-2024-07-18", DefaultPrices["openai/gpt-4o-mini"], true}, // longest prefix
+		{"anthropic", "claude-3-5-haiku-20241022", DefaultPrices["anthropic/claude-3-5-haiku"], true},
+		{"ollama", "llama3", Price{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := LookupPrice(overrides, tt.provider, tt.model)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("LookupPrice(%s, %s) = %v, %v; want %v, %v", tt.provider, tt.model, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPriceCost(t *testing.T) {
+	p := Price{Input: 0.003, Output: 0.015}
+	if got := p.Cost(1000, 2000); got != 0.033 {
+		t.Errorf("Cost(1000, 2000) = %v, want 0.033", got)
+	}
+}
@@ -66,6 +66,10 @@ type Model struct {
 	ContextLength int    `json:"context_length,omitempty"`
 	Quantization  string `json:"quantization_level,omitempty"`
 	Provider      string `json:"provider,omitempty"`
+
+	// Capabilities reported by the daemon, e.g. "tools", "vision".
+	// Nil when the daemon doesn't report them.
+	Capabilities []string `json:"capabilities,omitempty"`
 }
 
 // ChatRequest represents a chat completion request.
@@ -114,6 +118,19 @@ type Provider struct {
 	Type    string `json:"type"`
 	URL     string `json:"url,omitempty"`
 	Enabled bool   `json:"enabled"`
+
+	// Only some daemon versions return this; never display it unmasked.
+	APIKey string `json:"api_key,omitempty"`
+}
+
+// ProviderTestResult is the outcome of a provider credential check.
+type ProviderTestResult struct {
+	OK        bool   `json:"ok"`
+	Model     string `json:"model,omitempty"`      // model used for the probe
+	LatencyMs int    `json:"latency_ms,omitempty"` // round trip of the probe
+	Error     string `json:"error,omitempty"`
+	Reason    string `json:"reason,omitempty"`   // auth, network, rate_limit, ...
+	KeyHint   string `json:"key_hint,omitempty"` // identifies the key under test
 }
 
 // ProvidersResponse represents the list providers response
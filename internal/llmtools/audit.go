@@ -0,0 +1,93 @@
+package llmtools
+
+import (
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hecate-social/hecate-tui/internal/secret"
+)
+
+// Audit decisions record how a tool call was authorized or why it was not.
+const (
+	AuditAllowed  = "allowed"  // permitted by policy without asking
+	AuditApproved = "approved" // approved once by the user
+	AuditSession  = "session"  // approved by a session grant
+	AuditDenied   = "denied"   // denied by the user
+	AuditTimeout  = "timeout"  // approval timed out
+	AuditPolicy   = "policy"   // denied by policy
+	AuditSandbox  = "sandbox"  // path outside the sandbox root
+	AuditUnknown  = "unknown"  // no such tool
+)
+
+// maxAuditArgs caps the argument JSON kept per entry, so a large
+// write_file doesn't bloat the log.
+const maxAuditArgs = 2000
+
+// AuditEntry describes one tool call for the audit log.
+type AuditEntry struct {
+	At       time.Time
+	Tool     string
+	Args     string
+	Decision string
+	Duration time.Duration
+	OK       bool
+	Error    string
+}
+
+// Auditor receives an entry for every tool call the executor sees.
+type Auditor func(AuditEntry)
+
+// SetAuditor sets the callback that records tool calls.
+func (e *Executor) SetAuditor(a Auditor) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.auditor = a
+}
+
+// Audit records a tool call decision. Rejected calls leave duration zero
+// and carry the rejection as their error.
+func (e *Executor) Audit(call ToolCall, decision string, started time.Time, result ToolResult) {
+	e.mu.RLock()
+	a := e.auditor
+	e.mu.RUnlock()
+	if a == nil {
+		return
+	}
+
+	entry := AuditEntry{
+		At:       time.Now(),
+		Tool:     call.Name,
+		Args:     auditArgs(call.Arguments),
+		Decision: decision,
+		OK:       !result.IsError,
+	}
+	if !started.IsZero() {
+		entry.At = started
+		entry.Duration = time.Since(started)
+	}
+	if result.IsError {
+		entry.Error = secret.Redact(result.Content)
+	}
+	a(entry)
+}
+
+// auditArgs compacts raw arguments, masks anything that looks like an API
+// key and trims them to maxAuditArgs runes.
+func auditArgs(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	args := string(raw)
+	var v any
+	if err := json.Unmarshal(raw, &v); err == nil {
+		if b, err := json.Marshal(v); err == nil {
+			args = string(b)
+		}
+	}
+	args = secret.Redact(args)
+	if utf8.RuneCountInString(args) > maxAuditArgs {
+		args = string([]rune(args)[:maxAuditArgs]) + "…"
+	}
+	return args
+}
@@ -0,0 +1,38 @@
+package llmtools
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAudit_RedactsKeys(t *testing.T) {
+	const key = "sk-ant-REDACTED"
+	var got AuditEntry
+	e := NewExecutor(NewRegistry(), NewPermissions())
+	e.SetAuditor(func(a AuditEntry) { got = a })
+
+	call := ToolCall{
+		Name:      "run_command",
+		Arguments: json.RawMessage(`{"command": "curl -H 'x-api-key: ` + key + `' https://api.example"}`),
+	}
+	e.Audit(call, AuditApproved, time.Now(), ToolResult{IsError: true, Content: "401 for key " + key})
+
+	for field, v := range map[string]string{"Args": got.Args, "Error": got.Error} {
+		if strings.Contains(v, key) {
+			t.Errorf("%s kept the key: %s", field, v)
+		}
+		if !strings.Contains(v, "…wxyz") {
+			t.Errorf("%s = %s, want the masked key", field, v)
+		}
+	}
+}
+
+func TestAuditArgs_Trimmed(t *testing.T) {
+	raw, _ := json.Marshal(map[string]string{"content": strings.Repeat("x", 3*maxAuditArgs)})
+	got := auditArgs(raw)
+	if n := len([]rune(got)); n != maxAuditArgs+1 || !strings.HasSuffix(got, "…") {
+		t.Errorf("auditArgs kept %d runes, want %d plus an ellipsis", n, maxAuditArgs)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 )
 
 // ApprovalRequest represents a request for user approval to execute a tool.
@@ -28,6 +30,10 @@ type Executor struct {
 	registry        *Registry
 	permissions     *Permissions
 	approvalHandler ApprovalHandler
+
+	mu      sync.RWMutex
+	root    string // sandbox for path-taking tools; empty follows the working directory
+	auditor Auditor
 }
 
 // NewExecutor creates a new tool executor.
@@ -57,11 +63,28 @@ func (e *Executor) Permissions() *Permissions {
 func (e *Executor) Execute(ctx context.Context, call ToolCall) ToolResult {
 	tool, handler, ok := e.registry.Get(call.Name)
 	if !ok {
-		return ToolResult{
+		result := ToolResult{
 			ToolCallID: call.ID,
 			Content:    fmt.Sprintf("Unknown tool: %s", call.Name),
 			IsError:    true,
 		}
+		e.Audit(call, AuditUnknown, time.Time{}, result)
+		return result
+	}
+
+	if err := e.CheckSandbox(call); err != nil {
+		result := ToolResult{
+			ToolCallID: call.ID,
+			Content:    err.Error(),
+			IsError:    true,
+		}
+		e.Audit(call, AuditSandbox, time.Time{}, result)
+		return result
+	}
+
+	decision := AuditAllowed
+	if e.permissions.SessionGranted(call.Name) {
+		decision = AuditSession
 	}
 
 	// Check base permission for the tool
@@ -95,19 +118,23 @@ func (e *Executor) Execute(ctx context.Context, call ToolCall) ToolResult {
 
 	switch perm {
 	case PermissionDeny:
-		return ToolResult{
+		result := ToolResult{
 			ToolCallID: call.ID,
 			Content:    fmt.Sprintf("Tool '%s' execution denied by policy", call.Name),
 			IsError:    true,
 		}
+		e.Audit(call, AuditPolicy, time.Time{}, result)
+		return result
 
 	case PermissionAsk:
 		if e.approvalHandler == nil {
-			return ToolResult{
+			result := ToolResult{
 				ToolCallID: call.ID,
 				Content:    fmt.Sprintf("Tool '%s' requires approval but no approval handler is configured", call.Name),
 				IsError:    true,
 			}
+			e.Audit(call, AuditPolicy, time.Time{}, result)
+			return result
 		}
 
 		// Request approval
@@ -120,32 +147,35 @@ func (e *Executor) Execute(ctx context.Context, call ToolCall) ToolResult {
 		result := e.approvalHandler(req)
 
 		if !result.Approved {
-			return ToolResult{
+			denied := ToolResult{
 				ToolCallID: call.ID,
 				Content:    "Tool execution denied by user",
 				IsError:    true,
 			}
+			e.Audit(call, AuditDenied, time.Time{}, denied)
+			return denied
 		}
 
+		decision = AuditApproved
 		if result.GrantForSession {
 			e.permissions.GrantForSession(call.Name)
+			decision = AuditSession
 		}
 	}
 
 	// Execute the tool
+	started := time.Now()
 	content, err := handler(ctx, call.Arguments)
-	if err != nil {
-		return ToolResult{
-			ToolCallID: call.ID,
-			Content:    err.Error(),
-			IsError:    true,
-		}
-	}
-
-	return ToolResult{
+	result := ToolResult{
 		ToolCallID: call.ID,
 		Content:    content,
 	}
+	if err != nil {
+		result.Content = err.Error()
+		result.IsError = true
+	}
+	e.Audit(call, decision, started, result)
+	return result
 }
 
 // ExecuteAll runs multiple tool calls and returns all results.
@@ -0,0 +1,153 @@
+package llmtools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SetRoot confines tools that take a path to dir. An empty dir confines them to
+// the working directory at the time of each call, so the sandbox follows
+// /cd and venture switches.
+func (e *Executor) SetRoot(dir string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if dir != "" {
+		dir = filepath.Clean(expandHomePath(dir))
+	}
+	e.root = dir
+}
+
+// Root returns the directory path-taking tools are currently confined to.
+func (e *Executor) Root() string {
+	e.mu.RLock()
+	root := e.root
+	e.mu.RUnlock()
+	if root != "" {
+		return root
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	return cwd
+}
+
+// sandboxedArgs are the arguments that name a location on disk. Any tool
+// declaring one of them is held to the sandbox root.
+var sandboxedArgs = []string{"path", "working_dir"}
+
+// IsSandboxed reports whether tool takes a path the sandbox applies to.
+func IsSandboxed(tool Tool) bool {
+	for _, name := range sandboxedArgs {
+		if _, ok := tool.Parameters.Properties[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfinesOnlyWorkingDir reports whether the sandbox holds no more than
+// the tool's working directory, as for run_command: the command it runs
+// can still reach any path.
+func ConfinesOnlyWorkingDir(tool Tool) bool {
+	_, hasPath := tool.Parameters.Properties["path"]
+	_, hasDir := tool.Parameters.Properties["working_dir"]
+	return hasDir && !hasPath
+}
+
+// CheckSandbox rejects a call whose path arguments resolve outside the
+// sandbox root, following symlinks on both sides. Tools without path
+// arguments, and calls without readable arguments, pass through for the
+// tool itself to validate.
+func (e *Executor) CheckSandbox(call ToolCall) error {
+	tool, _, ok := e.registry.Get(call.Name)
+	if !ok || !IsSandboxed(tool) {
+		return nil
+	}
+
+	var args map[string]any
+	if err := json.Unmarshal(call.Arguments, &args); err != nil {
+		return nil
+	}
+
+	root, err := resolvePath(e.Root())
+	if err != nil {
+		return fmt.Errorf("sandbox root unavailable: %w", err)
+	}
+
+	var targets []string
+	for _, name := range sandboxedArgs {
+		if _, declared := tool.Parameters.Properties[name]; !declared {
+			continue
+		}
+		// An omitted path means the working directory
+		path, _ := args[name].(string)
+		if path == "" {
+			path = "."
+		}
+		targets = append(targets, path)
+		// glob_search joins its pattern onto the base, so "../*" escapes too
+		if name == "path" && call.Name == "glob_search" {
+			if pattern, _ := args["pattern"].(string); pattern != "" {
+				targets = append(targets, filepath.Join(path, pattern))
+			}
+		}
+	}
+
+	for _, t := range targets {
+		if !withinRoot(root, t) {
+			return fmt.Errorf("path %s is outside the sandbox %s", t, root)
+		}
+	}
+	return nil
+}
+
+// withinRoot reports whether path, resolved against the working directory
+// with ".." collapsed and symlinks followed, stays inside root. root must
+// already be resolved.
+func withinRoot(root, path string) bool {
+	resolved, err := resolvePath(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolvePath makes path absolute and follows symlinks. A path that does
+// not exist yet (write_file) is resolved through its deepest existing
+// ancestor, so a new file under a symlinked directory lands where the
+// link points.
+func resolvePath(path string) (string, error) {
+	abs, err := filepath.Abs(expandHomePath(path))
+	if err != nil {
+		return "", err
+	}
+
+	var missing []string
+	dir := abs
+	for {
+		resolved, err := filepath.EvalSymlinks(dir)
+		if err == nil {
+			for i := len(missing) - 1; i >= 0; i-- {
+				resolved = filepath.Join(resolved, missing[i])
+			}
+			return resolved, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return abs, nil
+		}
+		missing = append(missing, filepath.Base(dir))
+		dir = parent
+	}
+}
@@ -0,0 +1,111 @@
+package llmtools
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sandboxFixture returns an executor confined to a fresh root holding
+// src/main.go, plus a sibling directory outside it.
+func sandboxFixture(t *testing.T) (e *Executor, root, outside string) {
+	t.Helper()
+	base := t.TempDir()
+	root = filepath.Join(base, "root")
+	outside = filepath.Join(base, "outside")
+	for _, dir := range []string{filepath.Join(root, "src"), outside} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "src", "main.go"), []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	e = NewExecutor(NewDefaultRegistry(), NewPermissions())
+	e.SetRoot(root)
+	return e, root, outside
+}
+
+func sandboxCall(t *testing.T, name string, args map[string]any) ToolCall {
+	t.Helper()
+	raw, err := json.Marshal(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ToolCall{ID: "call", Name: name, Arguments: raw}
+}
+
+func TestCheckSandbox_Paths(t *testing.T) {
+	e, root, outside := sandboxFixture(t)
+
+	tests := []struct {
+		name string
+		tool string
+		args map[string]any
+		ok   bool
+	}{
+		{"inside", "read_file", map[string]any{"path": filepath.Join(root, "src", "main.go")}, true},
+		{"new file inside", "write_file", map[string]any{"path": filepath.Join(root, "new", "file.txt"), "content": "x"}, true},
+		{"dotdot collapsing inside", "read_file", map[string]any{"path": filepath.Join(root, "src", "..", "src", "main.go")}, true},
+		{"dotdot escape", "read_file", map[string]any{"path": root + "/src/../../outside/secret"}, false},
+		{"absolute outside", "write_file", map[string]any{"path": "/etc/hosts", "content": "x"}, false},
+		{"sibling with root prefix", "read_file", map[string]any{"path": root + "-evil/file"}, false},
+		{"glob pattern escape", "glob_search", map[string]any{"path": root, "pattern": "../outside/*"}, false},
+		{"grep outside", "grep_search", map[string]any{"pattern": "x", "path": outside}, false},
+		{"symbol search outside", "symbol_search", map[string]any{"symbol": "main", "path": "/"}, false},
+		{"code context outside", "code_context", map[string]any{"path": "/etc/passwd", "line": 1}, false},
+		{"code context inside", "code_context", map[string]any{"path": filepath.Join(root, "src", "main.go"), "line": 1}, true},
+		{"working dir outside", "run_command", map[string]any{"command": "ls", "working_dir": outside}, false},
+		{"no path argument", "web_search", map[string]any{"query": "x"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := e.CheckSandbox(sandboxCall(t, tt.tool, tt.args))
+			if (err == nil) != tt.ok {
+				t.Errorf("CheckSandbox = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestCheckSandbox_Symlinks(t *testing.T) {
+	e, root, outside := sandboxFixture(t)
+	if err := os.WriteFile(filepath.Join(outside, "secret"), []byte("s"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	for _, path := range []string{
+		filepath.Join(root, "escape"),
+		filepath.Join(root, "escape", "secret"),
+		filepath.Join(root, "escape", "not-yet-written"),
+	} {
+		err := e.CheckSandbox(sandboxCall(t, "read_file", map[string]any{"path": path}))
+		if err == nil || !strings.Contains(err.Error(), "outside the sandbox") {
+			t.Errorf("%s: CheckSandbox = %v, want outside the sandbox", path, err)
+		}
+	}
+
+	// A root reached through a symlink still admits its own files.
+	link := filepath.Join(filepath.Dir(root), "link-to-root")
+	if err := os.Symlink(root, link); err != nil {
+		t.Fatal(err)
+	}
+	e.SetRoot(link)
+	if err := e.CheckSandbox(sandboxCall(t, "read_file", map[string]any{"path": filepath.Join(root, "src", "main.go")})); err != nil {
+		t.Errorf("symlinked root rejected its own file: %v", err)
+	}
+}
+
+func TestExecute_RejectsOutsideSandbox(t *testing.T) {
+	e, _, outside := sandboxFixture(t)
+	result := e.Execute(t.Context(), sandboxCall(t, "grep_search", map[string]any{"pattern": "x", "path": outside}))
+	if !result.IsError || !strings.Contains(result.Content, "outside the sandbox") {
+		t.Errorf("Execute = %+v, want sandbox rejection", result)
+	}
+}
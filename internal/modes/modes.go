@@ -11,6 +11,8 @@ const (
 	Pair                // Pairing flow — inline wizard
 	Edit                // Built-in editor — file editing overlay
 	Form                // Form input — structured data entry overlay
+	Compose             // Long message composition — full-screen editor overlay
+	View                // Read-only document viewer — full-screen overlay
 )
 
 // String returns the display name for the mode (shown in status bar).
@@ -30,6 +32,10 @@ func (m Mode) String() string {
 		return "EDIT"
 	case Form:
 		return "FORM"
+	case Compose:
+		return "COMPOSE"
+	case View:
+		return "VIEW"
 	default:
 		return "UNKNOWN"
 	}
@@ -41,17 +47,21 @@ func (m Mode) Hints() string {
 	case Normal:
 		return "i:chat  /:cmd  j/k:scroll  r:retry  y:copy  ?:help  q:quit"
 	case Insert:
-		return "Enter:send  Alt+Enter:newline  Tab:model  Esc:normal"
+		return "Enter:send  Alt+Enter:newline  Ctrl+J:grow  Ctrl+O:compose  Tab:model  Esc:normal"
 	case Command:
 		return "Enter:exec  Tab:complete  Esc:cancel"
 	case Browse:
-		return "j/k:nav  Enter:detail  /:filter  Esc:back"
+		return "j/k:nav  Enter:detail  /:filter  </>:width  Esc:clear/back"
 	case Pair:
-		return "p:pair  c:cancel  r:refresh  Esc:back"
+		return "p:pair  c:cancel  r:refresh  </>:resize  Esc:back"
 	case Edit:
 		return "Ctrl+S:save  Ctrl+Q:close  Esc:close"
 	case Form:
 		return "Tab:next  Shift+Tab:prev  Enter:submit  Esc:cancel"
+	case Compose:
+		return "Ctrl+S:send  Esc:back to input"
+	case View:
+		return "j/k:move  Enter:fold  y:copy  Esc:close"
 	default:
 		return ""
 	}
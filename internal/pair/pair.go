@@ -2,6 +2,7 @@ package pair
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/qr"
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
@@ -18,11 +20,24 @@ type PairingState int
 const (
 	StateIdle PairingState = iota
 	StateStarting
-	StateWaiting
+	StateWaiting  // requested, waiting for the realm to approve
+	StateApproved // approved, connection being established
 	StatePaired
 	StateError
 )
 
+const (
+	// pollInterval is how often the pairing status is checked.
+	pollInterval = 2 * time.Second
+
+	// pairingTimeout bounds a session when the daemon gives no expiry.
+	pairingTimeout = 5 * time.Minute
+
+	// maxPollFailures is how many consecutive status errors are tolerated
+	// before the session is reported as failed.
+	maxPollFailures = 3
+)
+
 // Model is the Pair mode overlay — an inline wizard for realm pairing.
 type Model struct {
 	client  *client.Client
@@ -37,7 +52,10 @@ type Model struct {
 	identity     *client.Identity
 	pairingCode  string
 	realmURL     string
+	statusNote   string // latest message from the daemon
 	errorMessage string
+	deadline     time.Time
+	pollFailures int
 }
 
 // Messages for the pairing flow.
@@ -47,14 +65,16 @@ type identityMsg struct {
 }
 
 type pairingStartedMsg struct {
-	code     string
-	realmURL string
-	err      error
+	code      string
+	realmURL  string
+	expiresAt string
+	err       error
 }
 
 type pairingStatusMsg struct {
-	status string
-	err    error
+	status  string
+	message string
+	err     error
 }
 
 type pairingPollMsg struct{}
@@ -105,50 +125,92 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	case pairingStartedMsg:
 		if msg.err != nil {
-			m.state = StateError
-			m.errorMessage = msg.err.Error()
+			m.fail(msg.err.Error())
 		} else {
 			m.state = StateWaiting
 			m.pairingCode = msg.code
 			m.realmURL = msg.realmURL
-			return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
-				return pairingPollMsg{}
-			})
+			m.statusNote = ""
+			m.pollFailures = 0
+			m.deadline = time.Now().Add(pairingTimeout)
+			if t, err := time.Parse(time.RFC3339, msg.expiresAt); err == nil {
+				m.deadline = t
+			}
+			return m, schedulePoll()
 		}
 
 	case pairingStatusMsg:
+		if !m.polling() {
+			break
+		}
 		if msg.err != nil {
-			m.state = StateError
-			m.errorMessage = msg.err.Error()
-		} else {
-			switch msg.status {
-			case "paired":
-				m.state = StatePaired
-				m.pairingCode = ""
-				return m, m.fetchIdentity
-			case "waiting":
-				return m, tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
-					return pairingPollMsg{}
-				})
-			case "expired":
-				m.state = StateError
-				m.errorMessage = "Pairing session expired"
-				m.pairingCode = ""
-			default:
-				m.state = StateIdle
-				m.pairingCode = ""
+			m.pollFailures++
+			if m.pollFailures >= maxPollFailures {
+				m.fail("Lost contact with the daemon: " + msg.err.Error())
+				break
+			}
+			return m, schedulePoll()
+		}
+		m.pollFailures = 0
+		if msg.message != "" {
+			m.statusNote = msg.message
+		}
+		switch msg.status {
+		case "paired", "established":
+			m.state = StatePaired
+			m.pairingCode = ""
+			return m, m.fetchIdentity
+		case "waiting", "requested", "pending":
+			m.state = StateWaiting
+			return m, schedulePoll()
+		case "approved", "establishing":
+			m.state = StateApproved
+			return m, schedulePoll()
+		case "expired":
+			m.fail("Pairing session expired")
+		case "error", "rejected", "denied":
+			reason := "Pairing was rejected"
+			if msg.message != "" {
+				reason = msg.message
 			}
+			m.fail(reason)
+		default:
+			m.state = StateIdle
+			m.pairingCode = ""
 		}
 
 	case pairingPollMsg:
-		if m.state == StateWaiting {
-			return m, m.checkPairingStatus
+		if !m.polling() {
+			break
 		}
+		if !m.deadline.IsZero() && time.Now().After(m.deadline) {
+			m.fail("Timed out waiting for the realm to confirm")
+			return m, m.cancelPairing
+		}
+		return m, m.checkPairingStatus
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
+// polling reports whether a pairing session is in flight.
+func (m Model) polling() bool {
+	return m.state == StateWaiting || m.state == StateApproved
+}
+
+// fail moves to the terminal error state, from which [r] retries.
+func (m *Model) fail(reason string) {
+	m.state = StateError
+	m.errorMessage = reason
+	m.pairingCode = ""
+}
+
+func schedulePoll() tea.Cmd {
+	return tea.Tick(pollInterval, func(t time.Time) tea.Msg {
+		return pairingPollMsg{}
+	})
+}
+
 // HandleKey processes a keypress in Pair mode. Returns true if consumed.
 func (m *Model) HandleKey(key string, msg tea.KeyMsg) (bool, tea.Cmd) {
 	switch key {
@@ -160,16 +222,21 @@ func (m *Model) HandleKey(key string, msg tea.KeyMsg) (bool, tea.Cmd) {
 		}
 		return true, nil
 	case "c":
-		if m.state == StateWaiting {
+		if m.polling() {
 			m.state = StateIdle
 			m.pairingCode = ""
 			return true, m.cancelPairing
 		}
 		return true, nil
 	case "r":
+		if m.state == StateError {
+			m.state = StateStarting
+			m.errorMessage = ""
+			return true, m.startPairing
+		}
 		return true, m.fetchIdentity
 	case "esc":
-		if m.state == StateWaiting {
+		if m.polling() {
 			m.state = StateIdle
 			m.pairingCode = ""
 			return true, m.cancelPairing
@@ -189,12 +256,12 @@ func (m *Model) SetSize(width, height int) {
 // StatusHints returns contextual hints for the status bar based on pairing state.
 func (m Model) StatusHints() string {
 	switch m.state {
-	case StateWaiting:
+	case StateWaiting, StateApproved:
 		return "c:cancel  Esc:back"
 	case StatePaired:
 		return "p:re-pair  r:refresh  Esc:back"
 	case StateError:
-		return "p:retry  Esc:back"
+		return "r:retry  Esc:back"
 	default:
 		return "p:pair  Esc:back"
 	}
@@ -211,7 +278,7 @@ func (m Model) View() string {
 	switch m.state {
 	case StateStarting:
 		b.WriteString(m.renderStarting())
-	case StateWaiting:
+	case StateWaiting, StateApproved:
 		b.WriteString(m.renderWaiting())
 	case StatePaired:
 		b.WriteString(m.renderPaired())
@@ -243,9 +310,8 @@ func (m Model) renderWaiting() string {
 	b.WriteString(s.CardTitle.Render("Pair with Realm"))
 	b.WriteString("\n\n")
 
-	// Status
-	waitingStyle := lipgloss.NewStyle().Foreground(t.Warning).Bold(true)
-	b.WriteString(waitingStyle.Render("Waiting for confirmation..."))
+	// Progress: requested → approved → established
+	b.WriteString(m.renderProgress())
 	b.WriteString("\n\n")
 
 	// Code display
@@ -270,6 +336,11 @@ func (m Model) renderWaiting() string {
 		}
 		b.WriteString(lipgloss.PlaceHorizontal(contentWidth, lipgloss.Center, codeBox))
 		b.WriteString("\n\n")
+
+		if code := m.renderQR(contentWidth); code != "" {
+			b.WriteString(code)
+			b.WriteString("\n\n")
+		}
 	}
 
 	// Steps
@@ -287,13 +358,91 @@ func (m Model) renderWaiting() string {
 	}
 
 	b.WriteString("\n")
-	b.WriteString(m.spinner.View() + " " + s.Subtle.Render("Polling for confirmation..."))
+	polling := "Polling for confirmation..."
+	if m.state == StateApproved {
+		polling = "Approved, establishing connection..."
+	}
+	if !m.deadline.IsZero() {
+		if left := time.Until(m.deadline).Round(time.Second); left > 0 {
+			polling += fmt.Sprintf(" (%s left)", left)
+		}
+	}
+	b.WriteString(m.spinner.View() + " " + s.Subtle.Render(polling))
+	if m.statusNote != "" {
+		b.WriteString("\n" + s.Subtle.Render(m.statusNote))
+	}
 	b.WriteString("\n\n")
 	b.WriteString(s.Subtle.Italic(true).Render("Press [c] or [Esc] to cancel"))
 
 	return b.String()
 }
 
+// qrQuietZone is the light margin, in modules, drawn around the QR code.
+// Four is the minimum the QR specification asks for; scanners can fail to
+// find a code drawn with less.
+const qrQuietZone = 4
+
+// renderQR draws the pairing link as a scannable QR code centred in width
+// columns. It returns a hint instead when the pane is too narrow.
+func (m Model) renderQR(width int) string {
+	code, err := qr.Encode(pairingLink(m.realmURL, m.pairingCode))
+	if err != nil {
+		return ""
+	}
+	if code.RenderedWidth(qrQuietZone) > width {
+		return m.styles.Subtle.Render("Widen the pane with > to show a QR code")
+	}
+
+	// Light modules are drawn as blocks; force the colours so the code
+	// scans as dark-on-light on any terminal theme.
+	style := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FFFFFF")).
+		Background(lipgloss.Color("#000000"))
+	var lines []string
+	for _, line := range strings.Split(code.Render(qrQuietZone), "\n") {
+		lines = append(lines, style.Render(line))
+	}
+	label := m.styles.Subtle.Render("Scan to pair from your phone")
+	return lipgloss.PlaceHorizontal(width, lipgloss.Center,
+		lipgloss.JoinVertical(lipgloss.Center, strings.Join(lines, "\n"), label))
+}
+
+// pairingLink is the text encoded in the QR code: the realm URL carrying
+// the code when there is one, otherwise the bare code.
+func pairingLink(realmURL, code string) string {
+	u, err := url.Parse(realmURL)
+	if realmURL == "" || err != nil || u.Scheme == "" {
+		return code
+	}
+	q := u.Query()
+	q.Set("code", code)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// renderProgress shows the pairing steps with the current one highlighted.
+func (m Model) renderProgress() string {
+	t := m.theme
+	steps := []string{"Requested", "Approved", "Established"}
+	current := 0
+	if m.state == StateApproved {
+		current = 1
+	}
+
+	var parts []string
+	for i, step := range steps {
+		switch {
+		case i < current:
+			parts = append(parts, lipgloss.NewStyle().Foreground(t.Success).Render("✓ "+step))
+		case i == current:
+			parts = append(parts, lipgloss.NewStyle().Foreground(t.Warning).Bold(true).Render("● "+step))
+		default:
+			parts = append(parts, lipgloss.NewStyle().Foreground(t.TextMuted).Render("○ "+step))
+		}
+	}
+	return strings.Join(parts, lipgloss.NewStyle().Foreground(t.TextMuted).Render(" → "))
+}
+
 func (m Model) renderPaired() string {
 	s := m.styles
 	t := m.theme
@@ -358,7 +507,7 @@ func (m Model) renderPaired() string {
 	b.WriteString(connectedBox)
 
 	b.WriteString("\n\n")
-	b.WriteString(s.Subtle.Italic(true).Render("Press [p] to re-pair with a different realm"))
+	b.WriteString(s.Subtle.Italic(true).Render("Press [p] to re-pair with a different realm, [Esc] to return to chat"))
 
 	return b.String()
 }
@@ -378,7 +527,7 @@ func (m Model) renderError() string {
 	b.WriteString(lipgloss.NewStyle().Foreground(t.Error).Render(m.errorMessage))
 	b.WriteString("\n\n")
 
-	b.WriteString(s.Subtle.Italic(true).Render("Press [p] to try again"))
+	b.WriteString(s.Subtle.Italic(true).Render("Press [r] to retry, [Esc] to return to chat"))
 
 	return b.String()
 }
@@ -461,8 +610,9 @@ func (m Model) startPairing() tea.Msg {
 		return pairingStartedMsg{err: err}
 	}
 	return pairingStartedMsg{
-		code:     status.Code,
-		realmURL: status.RealmURL,
+		code:      status.Code,
+		realmURL:  status.RealmURL,
+		expiresAt: status.ExpiresAt,
 	}
 }
 
@@ -471,7 +621,7 @@ func (m Model) checkPairingStatus() tea.Msg {
 	if err != nil {
 		return pairingStatusMsg{err: err}
 	}
-	return pairingStatusMsg{status: status.Status}
+	return pairingStatusMsg{status: status.Status, message: status.Message}
 }
 
 func (m Model) cancelPairing() tea.Msg {
@@ -0,0 +1,70 @@
+package pair
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestPairingProgress(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	m, _ = m.Update(pairingStartedMsg{code: "ABCD-1234", realmURL: "https://macula.io/pair"})
+	if m.state != StateWaiting || m.deadline.IsZero() {
+		t.Fatalf("state = %v, want waiting with a deadline", m.state)
+	}
+
+	// Transient poll errors are retried.
+	for i := 0; i < maxPollFailures-1; i++ {
+		m, _ = m.Update(pairingStatusMsg{err: errors.New("connection refused")})
+	}
+	if m.state != StateWaiting {
+		t.Fatalf("state = %v after transient errors, want waiting", m.state)
+	}
+
+	m, _ = m.Update(pairingStatusMsg{status: "approved"})
+	if m.state != StateApproved {
+		t.Fatalf("state = %v, want approved", m.state)
+	}
+
+	m, _ = m.Update(pairingStatusMsg{status: "established"})
+	if m.state != StatePaired {
+		t.Fatalf("state = %v, want paired", m.state)
+	}
+}
+
+func TestPairingTimeoutAndRetry(t *testing.T) {
+	th := theme.HecateDark()
+	m := New(nil, th, th.ComputeStyles())
+
+	past := time.Now().Add(-time.Minute).Format(time.RFC3339)
+	m, _ = m.Update(pairingStartedMsg{code: "ABCD-1234", expiresAt: past})
+	m, _ = m.Update(pairingPollMsg{})
+	if m.state != StateError || m.errorMessage == "" {
+		t.Fatalf("state = %v, want error after deadline", m.state)
+	}
+
+	if consumed, cmd := m.HandleKey("r", tea.KeyMsg{}); !consumed || cmd == nil || m.state != StateStarting {
+		t.Fatalf("r should retry pairing, state = %v", m.state)
+	}
+}
+
+func TestPairingLink(t *testing.T) {
+	tests := []struct {
+		realmURL, code, want string
+	}{
+		{"https://macula.io/pair", "ABCD-1234", "https://macula.io/pair?code=ABCD-1234"},
+		{"https://macula.io/pair?lang=en", "XY", "https://macula.io/pair?code=XY&lang=en"},
+		{"", "ABCD-1234", "ABCD-1234"},
+		{"macula.io", "ABCD-1234", "ABCD-1234"},
+	}
+	for _, tt := range tests {
+		if got := pairingLink(tt.realmURL, tt.code); got != tt.want {
+			t.Errorf("pairingLink(%q, %q) = %q, want %q", tt.realmURL, tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,439 @@
+// Package qr encodes short strings as QR codes and renders them with
+// Unicode half blocks for display in the terminal.
+//
+// Only what pairing needs is implemented: byte mode, error correction
+// level L and versions 1–6 (up to 134 bytes).
+package qr
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrTooLong is returned when the text does not fit in a version 6 code.
+var ErrTooLong = errors.New("qr: text too long")
+
+// versionInfo holds the level L block layout for one version.
+type versionInfo struct {
+	dataPerBlock int
+	blocks       int
+	ecPerBlock   int
+	alignment    int // centre of the single alignment pattern, 0 for none
+}
+
+var versions = []versionInfo{
+	1: {dataPerBlock: 19, blocks: 1, ecPerBlock: 7},
+	2: {dataPerBlock: 34, blocks: 1, ecPerBlock: 10, alignment: 18},
+	3: {dataPerBlock: 55, blocks: 1, ecPerBlock: 15, alignment: 22},
+	4: {dataPerBlock: 80, blocks: 1, ecPerBlock: 20, alignment: 26},
+	5: {dataPerBlock: 108, blocks: 1, ecPerBlock: 26, alignment: 30},
+	6: {dataPerBlock: 68, blocks: 2, ecPerBlock: 18, alignment: 34},
+}
+
+// Code is an encoded QR symbol. Modules[y][x] is true for dark modules.
+type Code struct {
+	Size    int
+	Modules [][]bool
+}
+
+// Encode returns the smallest code that holds text.
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+	for v := 1; v < len(versions); v++ {
+		info := versions[v]
+		if len(data) <= info.dataPerBlock*info.blocks-2 {
+			return build(v, info, data), nil
+		}
+	}
+	return nil, ErrTooLong
+}
+
+func build(version int, info versionInfo, data []byte) *Code {
+	size := 17 + 4*version
+	c := &code{size: size}
+	c.modules = make([][]bool, size)
+	c.function = make([][]bool, size)
+	for i := range c.modules {
+		c.modules[i] = make([]bool, size)
+		c.function[i] = make([]bool, size)
+	}
+
+	c.drawFunctionPatterns(info)
+	c.drawCodewords(interleave(info, encodeData(info, data)))
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		if p := c.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		c.applyMask(mask) // XOR again to undo
+	}
+	c.applyMask(best)
+	c.drawFormatBits(best)
+
+	return &Code{Size: size, Modules: c.modules}
+}
+
+// encodeData builds the padded byte-mode data codewords.
+func encodeData(info versionInfo, data []byte) []byte {
+	capacity := info.dataPerBlock * info.blocks
+	var bits bitBuffer
+	bits.append(0b0100, 4) // byte mode
+	bits.append(len(data), 8)
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	if pad := capacity*8 - len(bits); pad > 0 {
+		bits.append(0, min(4, pad)) // terminator
+	}
+	for len(bits)%8 != 0 {
+		bits = append(bits, false)
+	}
+
+	out := bits.bytes()
+	for pad := byte(0xEC); len(out) < capacity; pad ^= 0xEC ^ 0x11 {
+		out = append(out, pad)
+	}
+	return out
+}
+
+// interleave splits data into blocks, appends error correction and
+// interleaves the result.
+func interleave(info versionInfo, data []byte) []byte {
+	var blocks, ecs [][]byte
+	for i := 0; i < info.blocks; i++ {
+		block := data[i*info.dataPerBlock : (i+1)*info.dataPerBlock]
+		blocks = append(blocks, block)
+		ecs = append(ecs, reedSolomon(block, info.ecPerBlock))
+	}
+
+	var out []byte
+	for i := 0; i < info.dataPerBlock; i++ {
+		for _, b := range blocks {
+			out = append(out, b[i])
+		}
+	}
+	for i := 0; i < info.ecPerBlock; i++ {
+		for _, e := range ecs {
+			out = append(out, e[i])
+		}
+	}
+	return out
+}
+
+// code is the working state while a symbol is built.
+type code struct {
+	size     int
+	modules  [][]bool
+	function [][]bool
+}
+
+func (c *code) set(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func (c *code) drawFunctionPatterns(info versionInfo) {
+	for i := 0; i < c.size; i++ {
+		c.set(6, i, i%2 == 0)
+		c.set(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.size-4, 3)
+	c.drawFinder(3, c.size-4)
+
+	if a := info.alignment; a != 0 {
+		for dy := -2; dy <= 2; dy++ {
+			for dx := -2; dx <= 2; dx++ {
+				c.set(a+dx, a+dy, max(abs(dx), abs(dy)) != 1)
+			}
+		}
+	}
+
+	// Reserve the format areas; the real bits are drawn after masking.
+	c.drawFormatBits(0)
+}
+
+// drawFinder draws a finder pattern and its separator centred on (cx, cy).
+func (c *code) drawFinder(cx, cy int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			x, y := cx+dx, cy+dy
+			if x < 0 || x >= c.size || y < 0 || y >= c.size {
+				continue
+			}
+			d := max(abs(dx), abs(dy))
+			c.set(x, y, d != 2 && d != 4)
+		}
+	}
+}
+
+// drawFormatBits writes both copies of the level L format information.
+func (c *code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return (bits>>i)&1 != 0 }
+
+	for i := 0; i <= 5; i++ {
+		c.set(8, i, bit(i))
+	}
+	c.set(8, 7, bit(6))
+	c.set(8, 8, bit(7))
+	c.set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.set(14-i, 8, bit(i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.set(c.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.set(8, c.size-15+i, bit(i))
+	}
+	c.set(8, c.size-8, true) // dark module
+}
+
+// formatBits returns the 15-bit BCH-protected format word for level L.
+func formatBits(mask int) int {
+	data := 0b01<<3 | mask
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawCodewords places the data in the zigzag column pairs.
+func (c *code) drawCodewords(data []byte) {
+	i := 0
+	for right := c.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < c.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = c.size - 1 - vert
+				}
+				if c.function[y][x] || i >= len(data)*8 {
+					continue
+				}
+				c.modules[y][x] = (data[i>>3]>>(7-i&7))&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+func (c *code) applyMask(mask int) {
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !c.function[y][x] {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the symbol with the standard mask evaluation rules.
+func (c *code) penalty() int {
+	n := c.size
+	score := 0
+	at := func(x, y int, transpose bool) bool {
+		if transpose {
+			return c.modules[x][y]
+		}
+		return c.modules[y][x]
+	}
+
+	finderLike := []bool{true, false, true, true, true, false, true}
+	for _, transpose := range []bool{false, true} {
+		for y := 0; y < n; y++ {
+			run := 1
+			for x := 1; x <= n; x++ {
+				if x < n && at(x, y, transpose) == at(x-1, y, transpose) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += 3 + run - 5
+				}
+				run = 1
+			}
+
+			// 1:1:3:1:1 finder-like runs with four light modules on a side.
+			for x := 0; x+7 <= n; x++ {
+				match := true
+				for k, dark := range finderLike {
+					if at(x+k, y, transpose) != dark {
+						match = false
+						break
+					}
+				}
+				if match && (c.lightRun(at, x-4, x, y, transpose) || c.lightRun(at, x+7, x+11, y, transpose)) {
+					score += 40
+				}
+			}
+		}
+	}
+
+	dark := 0
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < n && y+1 < n {
+				v := c.modules[y][x]
+				if c.modules[y][x+1] == v && c.modules[y+1][x] == v && c.modules[y+1][x+1] == v {
+					score += 3
+				}
+			}
+		}
+	}
+	percent := dark * 100 / (n * n)
+	score += abs(percent-50) / 5 * 10
+	return score
+}
+
+// lightRun reports whether modules [from, to) of a line are all light,
+// treating the area outside the symbol as light.
+func (c *code) lightRun(at func(x, y int, t bool) bool, from, to, y int, transpose bool) bool {
+	for x := max(from, 0); x < min(to, c.size); x++ {
+		if at(x, y, transpose) {
+			return false
+		}
+	}
+	return true
+}
+
+// Render draws the code with half blocks, two module rows per line, inside
+// a quiet zone of quiet modules. Light modules are drawn as blocks and dark
+// modules as blanks, so the caller should style the result with a light
+// foreground on a dark background; that reads as dark-on-light to a
+// scanner regardless of the terminal's own colours.
+func (q *Code) Render(quiet int) string {
+	n := q.Size + 2*quiet
+	dark := func(x, y int) bool {
+		x, y = x-quiet, y-quiet
+		return x >= 0 && y >= 0 && x < q.Size && y < q.Size && q.Modules[y][x]
+	}
+
+	var b strings.Builder
+	for y := 0; y < n; y += 2 {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		for x := 0; x < n; x++ {
+			top, bottom := !dark(x, y), y+1 < n && !dark(x, y+1)
+			switch {
+			case top && bottom:
+				b.WriteRune('█')
+			case top:
+				b.WriteRune('▀')
+			case bottom:
+				b.WriteRune('▄')
+			default:
+				b.WriteByte(' ')
+			}
+		}
+	}
+	return b.String()
+}
+
+// RenderedWidth is the number of columns Render(quiet) uses.
+func (q *Code) RenderedWidth(quiet int) int {
+	return q.Size + 2*quiet
+}
+
+// RenderedHeight is the number of lines Render(quiet) uses.
+func (q *Code) RenderedHeight(quiet int) int {
+	return (q.Size + 2*quiet + 1) / 2
+}
+
+// bitBuffer accumulates bits most significant first.
+type bitBuffer []bool
+
+func (b *bitBuffer) append(v, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, (v>>i)&1 != 0)
+	}
+}
+
+func (b bitBuffer) bytes() []byte {
+	out := make([]byte, len(b)/8)
+	for i, bit := range b {
+		if bit {
+			out[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return out
+}
+
+// reedSolomon computes n error correction codewords over GF(256).
+func reedSolomon(data []byte, n int) []byte {
+	// Generator polynomial: product of (x - α^i) for i in [0, n).
+	gen := make([]byte, n)
+	gen[n-1] = 1
+	root := byte(1)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			gen[j] = gfMul(gen[j], root)
+			if j+1 < n {
+				gen[j] ^= gen[j+1]
+			}
+		}
+		root = gfMul(root, 0x02)
+	}
+
+	rem := make([]byte, n)
+	for _, b := range data {
+		factor := b ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+		for i := range rem {
+			rem[i] ^= gfMul(gen[i], factor)
+		}
+	}
+	return rem
+}
+
+// gfMul multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMul(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
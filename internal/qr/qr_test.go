@@ -0,0 +1,284 @@
+package qr
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomon(t *testing.T) {
+	// Version 1-M "HELLO WORLD" from the QR specification walkthrough.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := reedSolomon(data, 10); !bytes.Equal(got, want) {
+		t.Errorf("reedSolomon = %v, want %v", got, want)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	want := []int{
+		0b111011111000100, 0b111001011110011, 0b111110110101010, 0b111100010011101,
+		0b110011000101111, 0b110001100011000, 0b110110001000001, 0b110100101110110,
+	}
+	for mask, w := range want {
+		if got := formatBits(mask); got != w {
+			t.Errorf("formatBits(%d) = %015b, want %015b", mask, got, w)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		text string
+		size int
+	}{
+		{"ABCD-1234", 21},
+		{strings.Repeat("x", 17), 21},
+		{strings.Repeat("x", 18), 25},
+		{"https://macula.io/pair?code=ABCD-1234", 29},
+		{strings.Repeat("x", 134), 41},
+	}
+	for _, tt := range tests {
+		c, err := Encode(tt.text)
+		if err != nil {
+			t.Fatalf("Encode(%d bytes): %v", len(tt.text), err)
+		}
+		if c.Size != tt.size {
+			t.Errorf("Encode(%d bytes) size = %d, want %d", len(tt.text), c.Size, tt.size)
+		}
+		// Top-left finder: dark ring, light ring, dark core.
+		for i, want := range []bool{true, false, true, true, true, false, true, false} {
+			if c.Modules[3][i] != want {
+				t.Errorf("finder row module %d = %v, want %v", i, c.Modules[3][i], want)
+			}
+		}
+		if !c.Modules[c.Size-8][8] {
+			t.Error("dark module not set")
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("x", 135)); err != ErrTooLong {
+		t.Errorf("expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestRender(t *testing.T) {
+	c, _ := Encode("hi")
+	out := c.Render(2)
+	lines := strings.Split(out, "\n")
+	if len(lines) != c.RenderedHeight(2) {
+		t.Fatalf("lines = %d, want %d", len(lines), c.RenderedHeight(2))
+	}
+	for _, l := range lines {
+		if n := len([]rune(l)); n != c.RenderedWidth(2) {
+			t.Fatalf("line width = %d, want %d", n, c.RenderedWidth(2))
+		}
+	}
+	// The quiet zone is light, so the first line is solid blocks.
+	if strings.Trim(lines[0], "█") != "" {
+		t.Errorf("quiet zone not light: %q", lines[0])
+	}
+}
+
+func TestEncodeDecodes(t *testing.T) {
+	texts := []string{
+		"",
+		"hi",
+		"ABCD-1234",
+		"https://macula.io/pair?code=ABCD-1234",
+		"héllo wörld ✓",
+		strings.Repeat("x", 17),
+		strings.Repeat("0123456789", 5),
+		strings.Repeat("pairing-", 10),
+		strings.Repeat("y", 106),
+		strings.Repeat("z", 134),
+	}
+	for _, text := range texts {
+		c, err := Encode(text)
+		if err != nil {
+			t.Fatalf("Encode(%d bytes): %v", len(text), err)
+		}
+		got, err := decode(c.Modules)
+		if err != nil {
+			t.Errorf("decode(Encode(%q)): %v", text, err)
+			continue
+		}
+		if got != text {
+			t.Errorf("decode(Encode(%q)) = %q", text, got)
+		}
+	}
+}
+
+// decode reads a level L byte-mode symbol back to text. It is written from
+// the specification rather than from the encoder above, so a placement,
+// masking or error correction slip on either side makes the round trip
+// fail.
+func decode(m [][]bool) (string, error) {
+	n := len(m)
+	version := (n - 17) / 4
+	// Level L blocks: count and data codewords per block, EC codewords per block.
+	layout := map[int][3]int{1: {1, 19, 7}, 2: {1, 34, 10}, 3: {1, 55, 15}, 4: {1, 80, 20}, 5: {1, 108, 26}, 6: {2, 68, 18}}
+	l, ok := layout[version]
+	if !ok || n != 17+4*version {
+		return "", fmt.Errorf("unsupported size %d", n)
+	}
+	blocks, dataLen, ecLen := l[0], l[1], l[2]
+
+	// Format information, first copy around the top-left finder, most
+	// significant bit first.
+	var format int
+	for _, p := range [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}} {
+		format <<= 1
+		if m[p[0]][p[1]] {
+			format |= 1
+		}
+	}
+	format ^= 0x5412
+	if format>>13 != 0b01 {
+		return "", fmt.Errorf("format %015b is not level L", format)
+	}
+	mask := format >> 10 & 7
+
+	// Function modules: finders with separators and format areas,
+	// timing patterns, the alignment pattern and the dark module.
+	function := make([][]bool, n)
+	for i := range function {
+		function[i] = make([]bool, n)
+	}
+	mark := func(r0, c0, h, w int) {
+		for r := r0; r < r0+h; r++ {
+			for c := c0; c < c0+w; c++ {
+				function[r][c] = true
+			}
+		}
+	}
+	mark(0, 0, 9, 9)
+	mark(0, n-8, 9, 8)
+	mark(n-8, 0, 8, 9)
+	mark(6, 0, 1, n)
+	mark(0, 6, n, 1)
+	if version >= 2 {
+		a := 4*version + 10
+		mark(a-2, a-2, 5, 5)
+	}
+
+	// Codewords run up and down two-column strips from the bottom right,
+	// skipping the vertical timing column.
+	var bits []bool
+	up := true
+	for right := n - 1; right > 0; right -= 2 {
+		if right == 6 {
+			right--
+		}
+		for k := 0; k < n; k++ {
+			i := k
+			if up {
+				i = n - 1 - k
+			}
+			for _, j := range []int{right, right - 1} {
+				if function[i][j] {
+					continue
+				}
+				bits = append(bits, m[i][j] != maskedAt(mask, i, j))
+			}
+		}
+		up = !up
+	}
+	total := blocks * (dataLen + ecLen)
+	if len(bits) < total*8 {
+		return "", fmt.Errorf("%d data modules, want at least %d", len(bits), total*8)
+	}
+	words := make([]byte, total)
+	for i := range words {
+		for _, bit := range bits[i*8 : i*8+8] {
+			words[i] <<= 1
+			if bit {
+				words[i] |= 1
+			}
+		}
+	}
+
+	// De-interleave and check each block's syndromes.
+	var data []byte
+	for b := 0; b < blocks; b++ {
+		var block []byte
+		for i := 0; i < dataLen; i++ {
+			block = append(block, words[i*blocks+b])
+		}
+		for i := 0; i < ecLen; i++ {
+			block = append(block, words[blocks*dataLen+i*blocks+b])
+		}
+		for k := 0; k < ecLen; k++ {
+			if s := syndrome(block, k); s != 0 {
+				return "", fmt.Errorf("block %d syndrome %d = %d", b, k, s)
+			}
+		}
+		data = append(data, block[:dataLen]...)
+	}
+
+	if data[0]>>4 != 0b0100 {
+		return "", fmt.Errorf("mode %04b is not byte mode", data[0]>>4)
+	}
+	count := int(data[0]&0x0F)<<4 | int(data[1]>>4)
+	out := make([]byte, count)
+	for i := range out {
+		out[i] = data[1+i]<<4 | data[2+i]>>4
+	}
+	return string(out), nil
+}
+
+// maskedAt applies mask pattern mask to the module at row i, column j.
+func maskedAt(mask, i, j int) bool {
+	switch mask {
+	case 0:
+		return (i+j)%2 == 0
+	case 1:
+		return i%2 == 0
+	case 2:
+		return j%3 == 0
+	case 3:
+		return (i+j)%3 == 0
+	case 4:
+		return (i/2+j/3)%2 == 0
+	case 5:
+		return (i*j)%2+(i*j)%3 == 0
+	case 6:
+		return ((i*j)%2+(i*j)%3)%2 == 0
+	default:
+		return ((i+j)%2+(i*j)%3)%2 == 0
+	}
+}
+
+// syndrome evaluates the codeword polynomial at α^k; it is zero for every
+// k below the EC length when the block is intact.
+func syndrome(block []byte, k int) byte {
+	x := byte(1)
+	for i := 0; i < k; i++ {
+		x = gfDouble(x)
+	}
+	var s byte
+	for _, c := range block {
+		s = gfTimes(s, x) ^ c
+	}
+	return s
+}
+
+func gfDouble(x byte) byte {
+	if x&0x80 != 0 {
+		return x<<1 ^ 0x1D
+	}
+	return x << 1
+}
+
+// gfTimes is shift-and-add multiplication, kept apart from gfMul.
+func gfTimes(a, b byte) byte {
+	var p byte
+	for ; b != 0; b >>= 1 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		a = gfDouble(a)
+	}
+	return p
+}
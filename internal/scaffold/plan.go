@@ -0,0 +1,177 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Step identifies one scaffolding action.
+type Step int
+
+const (
+	StepManifest Step = iota
+	StepAgents
+	StepReadme
+	StepChangelog
+	StepVision
+	StepGitignore
+	StepGitInit
+	StepGitCommit
+)
+
+// Action is one planned scaffolding step. Apply leaves a target that
+// Exists alone unless the action Overwrites it.
+type Action struct {
+	Step      Step
+	Name      string // e.g. "README.md" or "git repository"
+	Exists    bool
+	Overwrite bool
+}
+
+// Plan lists what scaffolding would do under Root, computed without
+// touching the filesystem.
+type Plan struct {
+	Root       string
+	RootExists bool
+	Actions    []Action
+}
+
+// PlanScaffold plans a fresh scaffold: venture.json is always rewritten
+// and the result is committed.
+func PlanScaffold(root string) Plan {
+	p := planFiles(root)
+	p.Actions[0].Overwrite = true
+	p.Actions = append(p.Actions, Action{Step: StepGitCommit, Name: "initial commit"})
+	return p
+}
+
+// PlanRescaffold plans filling in a scaffold's missing files, keeping
+// everything present. It commits only to finish a repository that has no
+// commits yet, as a failed Scaffold leaves it.
+func PlanRescaffold(root string) Plan {
+	p := planFiles(root)
+	if unbornRepo(root) {
+		p.Actions = append(p.Actions, Action{Step: StepGitCommit, Name: "initial commit"})
+	}
+	return p
+}
+
+// planFiles lists the file and repository steps shared by both plans.
+func planFiles(root string) Plan {
+	hecateDir := filepath.Join(root, ".hecate")
+	return Plan{
+		Root:       root,
+		RootExists: exists(root),
+		Actions: []Action{
+			{Step: StepManifest, Name: ".hecate/venture.json", Exists: exists(filepath.Join(hecateDir, "venture.json"))},
+			{Step: StepAgents, Name: ".hecate/agents/", Exists: exists(filepath.Join(hecateDir, "agents"))},
+			{Step: StepReadme, Name: "README.md", Exists: exists(filepath.Join(root, "README.md"))},
+			{Step: StepChangelog, Name: "CHANGELOG.md", Exists: exists(filepath.Join(root, "CHANGELOG.md"))},
+			{Step: StepVision, Name: "VISION.md", Exists: exists(filepath.Join(root, "VISION.md"))},
+			{Step: StepGitignore, Name: ".gitignore", Exists: exists(filepath.Join(root, ".gitignore"))},
+			{Step: StepGitInit, Name: "git repository", Exists: exists(filepath.Join(root, ".git"))},
+		},
+	}
+}
+
+// Apply carries out the plan. Targets kept because they already exist are
+// listed in Result.Existing.
+func (p Plan) Apply(manifest VentureManifest) Result {
+	root := p.Root
+	result := Result{
+		HecateDir: filepath.Join(root, ".hecate"),
+	}
+	if err := os.MkdirAll(result.HecateDir, 0755); err != nil {
+		result.Error = fmt.Errorf("create .hecate directory: %w", err)
+		return result
+	}
+
+	agentsDir := filepath.Join(result.HecateDir, "agents")
+	data := TemplateData{
+		Name:        manifest.Name,
+		Brief:       manifest.Brief,
+		RepoURL:     inferRepoURL(root),
+		Date:        time.Now().Format("2006-01-02"),
+		InitiatedBy: manifest.InitiatedBy,
+	}
+	haveRepo := false
+
+	for _, a := range p.Actions {
+		if a.Exists && !a.Overwrite {
+			result.Existing = append(result.Existing, a.Name)
+			if a.Step == StepGitInit {
+				haveRepo = true
+			}
+			continue
+		}
+
+		switch a.Step {
+		case StepManifest:
+			if err := writeVentureManifest(result.HecateDir, manifest); err != nil {
+				result.Error = fmt.Errorf("write venture.json: %w", err)
+				return result
+			}
+			result.ManifestCreated = true
+		case StepAgents:
+			if err := cloneAgents(agentsDir); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to clone agents: %v", err))
+			} else {
+				result.AgentsCloned = true
+			}
+		case StepReadme, StepChangelog, StepVision:
+			if err := generateFromTemplate(root, agentsDir, a.Name, data); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create %s: %v", a.Name, err))
+			} else {
+				*templateFlag(&result, a.Step) = true
+			}
+		case StepGitignore:
+			if err := createGitignore(root); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create .gitignore: %v", err))
+			} else {
+				result.GitignoreCreated = true
+			}
+		case StepGitInit:
+			if err := gitInit(root); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to git init: %v", err))
+			} else {
+				result.GitInitialized = true
+				haveRepo = true
+			}
+		case StepGitCommit:
+			if !haveRepo {
+				continue
+			}
+			// A repository initialized without its first commit is
+			// ambiguous, so a failure here is a hard one.
+			if err := gitCommit(root, manifest.Name); err != nil {
+				result.Error = err
+				return result
+			}
+			result.GitCommitted = true
+		}
+	}
+
+	result.Success = true
+	return result
+}
+
+// templateFlag returns the Result field recording that a template step
+// created its file.
+func templateFlag(result *Result, step Step) *bool {
+	switch step {
+	case StepReadme:
+		return &result.ReadmeCreated
+	case StepChangelog:
+		return &result.ChangelogCreated
+	default:
+		return &result.VisionCreated
+	}
+}
+
+// exists reports whether path is present on disk.
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
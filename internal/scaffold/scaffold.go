@@ -32,16 +32,21 @@ type TemplateData struct {
 	InitiatedBy string
 }
 
-// Result holds the result of scaffolding.
+// Result holds the result of scaffolding. Error is a hard failure that
+// left the scaffold incomplete; Warnings are optional steps that failed
+// without affecting the rest.
 type Result struct {
 	Success          bool
 	HecateDir        string
+	ManifestCreated  bool
 	AgentsCloned     bool
 	ReadmeCreated    bool
 	ChangelogCreated bool
 	VisionCreated    bool
+	GitignoreCreated bool
 	GitInitialized   bool
 	GitCommitted     bool
+	Existing         []string // items left untouched because they were already present
 	Warnings         []string
 	Error            error
 }
@@ -54,84 +59,20 @@ const (
 // It creates:
 //   - .hecate/venture.json
 //   - .hecate/agents/ (cloned from hecate-agents)
-//   - README.md (from template)
-//   - CHANGELOG.md (from template)
+//   - README.md, CHANGELOG.md and VISION.md (from templates)
+//   - .gitignore, a git repository and its first commit
+//
+// Existing files other than venture.json are kept.
 func Scaffold(root string, manifest VentureManifest) Result {
-	result := Result{
-		HecateDir: filepath.Join(root, ".hecate"),
-	}
-
-	// 1. Create .hecate directory
-	if err := os.MkdirAll(result.HecateDir, 0755); err != nil {
-		result.Error = fmt.Errorf("create .hecate directory: %w", err)
-		return result
-	}
-
-	// 2. Write venture.json
-	if err := writeVentureManifest(result.HecateDir, manifest); err != nil {
-		result.Error = fmt.Errorf("write venture.json: %w", err)
-		return result
-	}
-
-	// 3. Clone hecate-agents
-	agentsDir := filepath.Join(result.HecateDir, "agents")
-	if err := cloneAgents(agentsDir); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to clone agents: %v", err))
-	} else {
-		result.AgentsCloned = true
-	}
-
-	// 4. Prepare template data
-	data := TemplateData{
-		Name:        manifest.Name,
-		Brief:       manifest.Brief,
-		RepoURL:     inferRepoURL(root),
-		Date:        time.Now().Format("2006-01-02"),
-		InitiatedBy: manifest.InitiatedBy,
-	}
-
-	// 5. Generate README.md
-	if err := generateFromTemplate(root, agentsDir, "README.md", data); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create README.md: %v", err))
-	} else {
-		result.ReadmeCreated = true
-	}
-
-	// 6. Generate CHANGELOG.md
-	if err := generateFromTemplate(root, agentsDir, "CHANGELOG.md", data); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create CHANGELOG.md: %v", err))
-	} else {
-		result.ChangelogCreated = true
-	}
-
-	// 7. Generate VISION.md
-	if err := generateFromTemplate(root, agentsDir, "VISION.md", data); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create VISION.md: %v", err))
-	} else {
-		result.VisionCreated = true
-	}
-
-	// 8. Create .gitignore
-	if err := createGitignore(root); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to create .gitignore: %v", err))
-	}
-
-	// 9. Initialize git repository
-	if err := gitInit(root); err != nil {
-		result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to git init: %v", err))
-	} else {
-		result.GitInitialized = true
-
-		// 10. Git add and commit
-		if err := gitCommit(root, manifest.Name); err != nil {
-			result.Warnings = append(result.Warnings, fmt.Sprintf("Failed to git commit: %v", err))
-		} else {
-			result.GitCommitted = true
-		}
-	}
+	return PlanScaffold(root).Apply(manifest)
+}
 
-	result.Success = true
-	return result
+// Rescaffold fills in whatever parts of the venture scaffold are missing
+// under root, leaving anything already present untouched. Unlike Scaffold
+// it commits only when the repository has no commits yet; otherwise the
+// repository may hold unrelated work.
+func Rescaffold(root string, manifest VentureManifest) Result {
+	return PlanRescaffold(root).Apply(manifest)
 }
 
 func createGitignore(root string) error {
@@ -190,6 +131,24 @@ func gitInit(root string) error {
 	return nil
 }
 
+// unbornRepo reports whether root is itself a git repository with no
+// commits yet.
+func unbornRepo(root string) bool {
+	out, err := exec.Command("git", "-C", root, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return false
+	}
+	want, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return false
+	}
+	// A parent repository doesn't count; its history is not ours to start
+	if top, err := filepath.EvalSymlinks(strings.TrimSpace(string(out))); err != nil || top != want {
+		return false
+	}
+	return exec.Command("git", "-C", root, "rev-parse", "--verify", "--quiet", "HEAD").Run() != nil
+}
+
 func gitCommit(root, ventureName string) error {
 	// Stage all files
 	addCmd := exec.Command("git", "add", ".")
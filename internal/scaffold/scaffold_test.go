@@ -0,0 +1,184 @@
+package scaffold
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRescaffold_KeepsExistingFiles(t *testing.T) {
+	root := t.TempDir()
+	// Pre-create agents and .git so nothing reaches the network or runs git init.
+	for _, dir := range []string{".hecate/agents", ".git"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	readme := filepath.Join(root, "README.md")
+	if err := os.WriteFile(readme, []byte("mine\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := Rescaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if result.Error != nil || !result.Success {
+		t.Fatalf("Rescaffold failed: %v", result.Error)
+	}
+	if !result.ManifestCreated || !result.ChangelogCreated || !result.VisionCreated || !result.GitignoreCreated {
+		t.Errorf("missing files were not all created: %+v", result)
+	}
+	if result.ReadmeCreated || result.AgentsCloned || result.GitInitialized {
+		t.Errorf("existing items reported as created: %+v", result)
+	}
+	if data, _ := os.ReadFile(readme); string(data) != "mine\n" {
+		t.Errorf("README.md was overwritten: %q", data)
+	}
+
+	// A second run finds everything in place.
+	again := Rescaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if len(again.Existing) != 7 {
+		t.Errorf("second run Existing = %v, want all 7 items", again.Existing)
+	}
+}
+
+// ventureDir returns a root whose agents are already in place, so
+// Scaffold does not try to clone them.
+func ventureDir(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, ".hecate", "agents"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestScaffold_PreservesExistingFiles(t *testing.T) {
+	root := ventureDir(t)
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	vision := filepath.Join(root, "VISION.md")
+	if err := os.WriteFile(vision, []byte("our vision\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// No git on PATH: the commit step fails, but files are still written.
+	t.Setenv("PATH", t.TempDir())
+
+	result := Scaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if data, _ := os.ReadFile(vision); string(data) != "our vision\n" {
+		t.Errorf("VISION.md was overwritten: %q", data)
+	}
+	for _, name := range []string{"README.md", "CHANGELOG.md", ".gitignore", ".hecate/venture.json"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Errorf("%s not created: %v", name, err)
+		}
+	}
+	if !result.ManifestCreated {
+		t.Error("ManifestCreated = false, want true")
+	}
+}
+
+func TestScaffold_FailedCommitIsHardFailure(t *testing.T) {
+	root := ventureDir(t)
+	// An existing .git skips git init; with no git binary the commit fails.
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", t.TempDir())
+
+	result := Scaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if result.Error == nil {
+		t.Fatal("Error = nil, want the commit failure")
+	}
+	if result.Success || result.GitCommitted {
+		t.Errorf("Success = %v, GitCommitted = %v; want both false", result.Success, result.GitCommitted)
+	}
+	if result.GitInitialized || len(result.Existing) == 0 || result.Existing[len(result.Existing)-1] != "git repository" {
+		t.Errorf("existing repository should be kept, got GitInitialized = %v, Existing = %v", result.GitInitialized, result.Existing)
+	}
+}
+
+func TestScaffold_MissingGitIsWarning(t *testing.T) {
+	root := ventureDir(t)
+	t.Setenv("PATH", t.TempDir())
+
+	result := Scaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if result.Error != nil || !result.Success {
+		t.Fatalf("Scaffold failed hard: %v", result.Error)
+	}
+	if result.GitInitialized || len(result.Warnings) == 0 {
+		t.Errorf("want git init reported as a warning, got %+v", result)
+	}
+}
+
+func TestPlanScaffold_DoesNotTouchDisk(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "new-venture")
+
+	plan := PlanScaffold(root)
+	if plan.RootExists {
+		t.Error("RootExists = true for a missing directory")
+	}
+	if got, want := len(plan.Actions), 8; got != want {
+		t.Fatalf("planned %d actions, want %d", got, want)
+	}
+	for _, a := range plan.Actions {
+		if a.Exists {
+			t.Errorf("%s marked as existing in an empty root", a.Name)
+		}
+	}
+	if last := plan.Actions[len(plan.Actions)-1]; last.Step != StepGitCommit {
+		t.Errorf("last action = %v, want the commit", last.Name)
+	}
+	if _, err := os.Stat(root); !os.IsNotExist(err) {
+		t.Errorf("planning created %s", root)
+	}
+	if len(PlanRescaffold(root).Actions) != 7 {
+		t.Error("rescaffold plan should not commit")
+	}
+}
+
+// gitRepo initializes a real repository under root with a committer
+// identity, skipping the test when git is unavailable.
+func gitRepo(t *testing.T, root string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	for _, kv := range [][2]string{
+		{"GIT_AUTHOR_NAME", "test"}, {"GIT_AUTHOR_EMAIL", "test@example.com"},
+		{"GIT_COMMITTER_NAME", "test"}, {"GIT_COMMITTER_EMAIL", "test@example.com"},
+		{"GIT_CONFIG_GLOBAL", os.DevNull}, {"GIT_CONFIG_NOSYSTEM", "1"},
+	} {
+		t.Setenv(kv[0], kv[1])
+	}
+	if out, err := exec.Command("git", "init", root).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+}
+
+func TestRescaffold_FinishesRepoWithoutCommits(t *testing.T) {
+	// A Scaffold whose commit failed leaves .git with no HEAD; running
+	// /venture scaffold again must make the initial commit.
+	root := ventureDir(t)
+	gitRepo(t, root)
+
+	result := Rescaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if result.Error != nil || !result.Success {
+		t.Fatalf("Rescaffold failed: %v", result.Error)
+	}
+	if !result.GitCommitted {
+		t.Error("GitCommitted = false, want the initial commit made")
+	}
+	if err := exec.Command("git", "-C", root, "rev-parse", "--verify", "HEAD").Run(); err != nil {
+		t.Errorf("repository still has no HEAD: %v", err)
+	}
+
+	// With history in place, rescaffolding commits nothing.
+	if err := os.Remove(filepath.Join(root, "CHANGELOG.md")); err != nil {
+		t.Fatal(err)
+	}
+	again := Rescaffold(root, VentureManifest{VentureID: "ven-1", Name: "shop", Root: root})
+	if again.GitCommitted || !again.ChangelogCreated {
+		t.Errorf("second run: GitCommitted = %v, ChangelogCreated = %v", again.GitCommitted, again.ChangelogCreated)
+	}
+}
@@ -0,0 +1,62 @@
+// Package secret keeps API keys and tokens out of the chat transcript.
+package secret
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Mask renders a key or token as its prefix and last four characters
+// (sk-…wxyz) so it can be recognised without being leaked. Short values are
+// hidden entirely.
+func Mask(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	runes := []rune(value)
+	if len(runes) <= 8 {
+		return "…"
+	}
+
+	prefix := ""
+	if i := strings.IndexAny(value, "-_"); i > 0 && i <= 6 {
+		prefix = value[:i+1]
+	}
+	return prefix + "…" + string(runes[len(runes)-4:])
+}
+
+// keyPattern matches the key formats of the providers we support.
+var keyPattern = regexp.MustCompile(
+	`\b(?:sk-ant-[A-Za-z0-9_\-]{16,}|sk-[A-Za-z0-9_\-]{16,}|gsk_[A-Za-z0-9]{16,}|AIza[A-Za-z0-9_\-]{30,})`,
+)
+
+// Redact masks anything in text that looks like a provider API key.
+func Redact(text string) string {
+	return keyPattern.ReplaceAllStringFunc(text, Mask)
+}
+
+// secretParams are URL query parameters that carry credentials.
+var secretParams = []string{"token", "key", "api_key", "apikey", "access_token"}
+
+// MaskURL hides the password and credential query parameters of a URL.
+// Unparseable input is returned unchanged.
+func MaskURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	if pw, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), Mask(pw))
+	}
+	q := u.Query()
+	for _, p := range secretParams {
+		if v := q.Get(p); v != "" {
+			q.Set(p, Mask(v))
+		}
+	}
+	u.RawQuery = q.Encode()
+	// Keep the ellipsis readable rather than percent-encoded.
+	return strings.ReplaceAll(u.String(), "%E2%80%A6", "…")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/modes"
@@ -27,10 +28,17 @@ type Model struct {
 	InputLen      int    // character count for Insert mode
 	SessionTokens int    // cumulative tokens for session
 
+	// Streaming progress, refreshed on every chat tick
+	Streaming     bool
+	StreamChunks  int
+	StreamElapsed time.Duration
+	StreamFrame   int
+
 	// Venture context
 	VentureName string // current venture name (empty if none)
 	ActivePhase string // current ALC phase: "dna", "anp", "tni", "dno"
 	AgentCount  int    // number of active agents
+	ActiveRole  string // short ALC role label, e.g. "DnA" (empty if none)
 
 	// Flash notification (temporary, overrides hints when set)
 	FlashMsg string
@@ -68,34 +76,7 @@ func (m Model) View() string {
 	modeStyle := m.modeStyle()
 	modeLabel := modeStyle.Render(" " + m.Mode.String() + " ")
 
-	// Model indicator with provider and status LED
-	modelSection := ""
-	if m.ModelName != "" {
-		name := m.ModelName
-		if len(name) > 20 {
-			name = name[:17] + "..."
-		}
-
-		modelLED := ""
-		switch m.ModelStatus {
-		case "loading":
-			modelLED = m.styles.StatusWarning.Render("◐") + " "
-		case "error":
-			modelLED = m.styles.StatusError.Render("●") + " "
-		default:
-			modelLED = m.styles.StatusOK.Render("●") + " "
-		}
-
-		providerLabel := ""
-		if m.ModelProvider != "" {
-			if m.isPaidProvider() {
-				providerLabel = m.styles.StatusWarning.Render(" [" + m.ModelProvider + " $]")
-			} else {
-				providerLabel = m.styles.Subtle.Render(" [" + m.ModelProvider + "]")
-			}
-		}
-		modelSection = "  " + modelLED + m.styles.Subtle.Render(name) + providerLabel
-	}
+	modelSection := m.modelSection()
 
 	// Token count (only show if non-zero and using paid provider)
 	tokenSection := ""
@@ -103,7 +84,7 @@ func (m Model) View() string {
 		tokenSection = m.styles.Subtle.Render(fmt.Sprintf("  %s tok", formatTokenCount(m.SessionTokens)))
 	}
 
-	line1 := modeLabel + modelSection + tokenSection
+	line1 := modeLabel + modelSection + tokenSection + m.streamSection()
 
 	// ── Line 2: cwd + hints ──
 	cwdSection := ""
@@ -111,6 +92,9 @@ func (m Model) View() string {
 		cwd := shortenPath(m.Cwd, 40)
 		cwdSection = " " + m.styles.Subtle.Render(cwd)
 	}
+	if m.ActiveRole != "" {
+		cwdSection += "  " + m.styles.Subtle.Render("role ") + m.styles.Bold.Render(m.ActiveRole)
+	}
 
 	// Flash notification takes priority over hints
 	var hints string
@@ -122,7 +106,7 @@ func (m Model) View() string {
 			errMsg = errMsg[:47] + "..."
 		}
 		hints = m.styles.StatusError.Render(" ✗ " + errMsg)
-	} else if m.ModelStatus == "loading" {
+	} else if m.ModelStatus == "loading" && !m.Streaming {
 		hints = m.styles.StatusWarning.Render(" ◐ Loading model...")
 	} else {
 		hintsText := m.Mode.Hints()
@@ -145,6 +129,65 @@ func (m Model) View() string {
 	return barStyle.Render(line1) + "\n" + barStyle.Render(line2)
 }
 
+// modelSection renders the model indicator with provider and status LED.
+func (m Model) modelSection() string {
+	if m.ModelName == "" {
+		return ""
+	}
+	name := m.ModelName
+	if len(name) > 20 {
+		name = name[:17] + "..."
+	}
+
+	modelLED := ""
+	switch m.ModelStatus {
+	case "loading":
+		modelLED = m.styles.StatusWarning.Render("◐") + " "
+	case "error":
+		modelLED = m.styles.StatusError.Render("●") + " "
+	default:
+		modelLED = m.styles.StatusOK.Render("●") + " "
+	}
+
+	providerLabel := ""
+	if m.ModelProvider != "" {
+		if m.isPaidProvider() {
+			providerLabel = m.styles.StatusWarning.Render(" [" + m.ModelProvider + " $]")
+		} else {
+			providerLabel = m.styles.Subtle.Render(" [" + m.ModelProvider + "]")
+		}
+	}
+	return "  " + modelLED + m.styles.Subtle.Render(name) + providerLabel
+}
+
+// ModelRegion returns the columns [start, end) of the model indicator on the
+// first status bar line, or (0, 0) when no model is shown.
+func (m Model) ModelRegion() (start, end int) {
+	section := m.modelSection()
+	if section == "" {
+		return 0, 0
+	}
+	start = lipgloss.Width(m.modeStyle().Render(" "+m.Mode.String()+" ")) + 2
+	return start, start - 2 + lipgloss.Width(section)
+}
+
+// streamSection renders the live streaming indicator. Elapsed time is shown
+// in whole seconds so the bar only changes once a second plus the spinner.
+func (m Model) streamSection() string {
+	if !m.Streaming {
+		return ""
+	}
+	frame := streamSpinner[m.StreamFrame%len(streamSpinner)]
+	text := fmt.Sprintf(" streaming… %ds", int(m.StreamElapsed.Seconds()))
+	if m.StreamChunks > 0 {
+		text = fmt.Sprintf(" streaming… %s chunks  %ds", formatTokenCount(m.StreamChunks), int(m.StreamElapsed.Seconds()))
+	}
+	return "  " + m.styles.StatusWarning.Render(frame) + m.styles.Subtle.Render(text)
+}
+
+// streamSpinner is the status bar's streaming animation.
+var streamSpinner = []string{"◐", "◓", "◑", "◒"}
+
 func (m Model) modeStyle() lipgloss.Style {
 	switch m.Mode {
 	case modes.Normal:
@@ -161,6 +204,10 @@ func (m Model) modeStyle() lipgloss.Style {
 		return m.styles.EditMode
 	case modes.Form:
 		return m.styles.CommandMode // Reuse command style for forms
+	case modes.Compose:
+		return m.styles.InsertMode
+	case modes.View:
+		return m.styles.BrowseMode
 	default:
 		return m.styles.NormalMode
 	}
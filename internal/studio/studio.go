@@ -4,6 +4,8 @@
 package studio
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/client"
 	"github.com/hecate-social/hecate-tui/internal/commands"
@@ -20,6 +22,7 @@ type Studio interface {
 	// Identity
 	Name() string      // "LLM", "Development", etc.
 	ShortName() string // Short tab label: "LLM", "Dev", "Ops", "Social", "Arcade"
+	Aliases() []string // Other names /studio accepts, e.g. "ops"
 	Icon() string      // Emoji for tab bar
 
 	// Lifecycle — standard Bubble Tea model methods
@@ -63,6 +66,12 @@ type StatusInfo struct {
 	InputLen      int // character count for Insert mode
 	SessionTokens int // cumulative tokens for session
 	OnlineCount   int // channel members / players online
+
+	// Streaming progress (LLM studio)
+	Streaming     bool
+	StreamChunks  int           // chunks received so far
+	StreamElapsed time.Duration // time since the request was sent
+	StreamFrame   int           // spinner frame, advanced by the chat tick
 }
 
 // Context holds shared resources passed to studios at construction time.
@@ -75,6 +84,12 @@ type Context struct {
 	FactBus *factbus.Connection
 }
 
+// DaemonHealthMsg is sent to the active studio when the daemon goes down
+// or comes back, so open views can flag the outage and refetch.
+type DaemonHealthMsg struct {
+	Up bool
+}
+
 // SwitchStudioMsg tells the shell to switch to a different studio by index.
 type SwitchStudioMsg struct {
 	Index int
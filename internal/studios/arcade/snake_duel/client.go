@@ -5,11 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net"
 	"net/http"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 // Bubble Tea message types for match lifecycle.
@@ -23,22 +23,21 @@ type MatchStartFailedMsg struct{ Err error }
 // MatchStream manages an SSE connection to a single match.
 // Unlike the IRC stream, matches are ephemeral — no auto-reconnect.
 type MatchStream struct {
-	socketPath string
-	baseURL    string
-	eventChan  chan GameState
-	ctx        context.Context
-	cancel     context.CancelFunc
+	client    *client.Client
+	eventChan chan GameState
+	err       error // set before the channel closes if the daemon refused us
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
 // NewMatchStream creates a stream for a specific match.
-func NewMatchStream(socketPath, baseURL string) *MatchStream {
+func NewMatchStream(c *client.Client) *MatchStream {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &MatchStream{
-		socketPath: socketPath,
-		baseURL:    baseURL,
-		eventChan:  make(chan GameState, 20),
-		ctx:        ctx,
-		cancel:     cancel,
+		client:    c,
+		eventChan: make(chan GameState, 20),
+		ctx:       ctx,
+		cancel:    cancel,
 	}
 }
 
@@ -55,6 +54,9 @@ func (s *MatchStream) PollCmd() tea.Cmd {
 		select {
 		case state, ok := <-s.eventChan:
 			if !ok {
+				if s.err != nil {
+					return MatchErrorMsg{Err: s.err}
+				}
 				return MatchDoneMsg{}
 			}
 			return MatchStateMsg{State: state}
@@ -73,30 +75,16 @@ func (s *MatchStream) Close() {
 func (s *MatchStream) readLoop(matchID string) {
 	defer close(s.eventChan)
 
-	url := s.baseURL + "/api/arcade/snake-duel/matches/" + matchID + "/stream"
+	url := s.client.BaseURL() + "/api/arcade/snake-duel/matches/" + matchID + "/stream"
 
 	req, err := http.NewRequestWithContext(s.ctx, "GET", url, nil)
 	if err != nil {
 		return
 	}
 	req.Header.Set("Accept", "text/event-stream")
+	s.client.Authorize(req)
 
-	transport := &http.Transport{
-		IdleConnTimeout:       0,
-		ResponseHeaderTimeout: 0,
-		ExpectContinueTimeout: 0,
-	}
-	if s.socketPath != "" {
-		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, "unix", s.socketPath)
-		}
-	}
-
-	httpClient := &http.Client{
-		Transport: transport,
-		Timeout:   0,
-	}
+	httpClient := s.client.StreamHTTPClient()
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -104,6 +92,10 @@ func (s *MatchStream) readLoop(matchID string) {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if err := s.client.CheckAuth(resp); err != nil {
+		s.err = err
+		return
+	}
 	if resp.StatusCode != http.StatusOK {
 		return
 	}
@@ -150,7 +142,7 @@ func (s *MatchStream) readSSE(body io.Reader) {
 
 // StartMatch sends POST to the daemon to create a new match.
 // Returns a tea.Cmd for async execution.
-func StartMatch(socketPath, baseURL string, af1, af2, tickMs int) tea.Cmd {
+func StartMatch(c *client.Client, af1, af2, tickMs int) tea.Cmd {
 	return func() tea.Msg {
 		body := map[string]interface{}{
 			"af1":     af1,
@@ -162,28 +154,24 @@ func StartMatch(socketPath, baseURL string, af1, af2, tickMs int) tea.Cmd {
 			return MatchStartFailedMsg{Err: err}
 		}
 
-		url := baseURL + "/api/arcade/snake-duel/matches"
+		url := c.BaseURL() + "/api/arcade/snake-duel/matches"
 		req, err := http.NewRequest("POST", url, strings.NewReader(string(jsonBody)))
 		if err != nil {
 			return MatchStartFailedMsg{Err: err}
 		}
 		req.Header.Set("Content-Type", "application/json")
+		c.Authorize(req)
 
-		transport := &http.Transport{}
-		if socketPath != "" {
-			transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-				var d net.Dialer
-				return d.DialContext(ctx, "unix", socketPath)
-			}
-		}
-
-		httpClient := &http.Client{Transport: transport}
-		resp, err := httpClient.Do(req)
+		resp, err := c.HTTPClient().Do(req)
 		if err != nil {
 			return MatchStartFailedMsg{Err: err}
 		}
 		defer func() { _ = resp.Body.Close() }()
 
+		if err := c.CheckAuth(resp); err != nil {
+			return MatchStartFailedMsg{Err: err}
+		}
+
 		respBody, err := io.ReadAll(resp.Body)
 		if err != nil {
 			return MatchStartFailedMsg{Err: err}
@@ -114,10 +114,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case MatchStartedMsg:
 		m.matchID = msg.MatchID
 		m.phase = "playing"
-		m.stream = NewMatchStream(
-			m.ctx.Client.SocketPath(),
-			m.ctx.Client.BaseURL(),
-		)
+		m.stream = NewMatchStream(m.ctx.Client)
 		return m.stream.Connect(m.matchID)
 
 	case MatchStartFailedMsg:
@@ -170,11 +167,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 func (m *Model) startNewMatch() tea.Cmd {
 	m.phase = "connecting"
 	m.err = nil
-	return StartMatch(
-		m.ctx.Client.SocketPath(),
-		m.ctx.Client.BaseURL(),
-		m.af1, m.af2, m.tickMs,
-	)
+	return StartMatch(m.ctx.Client, m.af1, m.af2, m.tickMs)
 }
 
 // pollStream returns a command to poll the SSE stream after a small delay.
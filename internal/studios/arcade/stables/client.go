@@ -5,11 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"io"
-	"net"
 	"net/http"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
 )
 
 // Bubble Tea message types for stables lifecycle.
@@ -35,16 +35,19 @@ type HeroPromotedMsg struct{ Hero PromoteResponse }
 type HeroPromoteErrMsg struct{ Err error }
 type HeroDuelStartedMsg struct{ MatchID string }
 type HeroDuelStartErrMsg struct{ Err error }
+type HeroVsHeroStartedMsg struct{ MatchID string }
+type HeroVsHeroStartErrMsg struct{ Err error }
 
 // Training SSE stream messages
 type TrainingUpdateMsg struct{ Progress TrainingProgress }
 type TrainingStreamContinueMsg struct{}
 type TrainingStreamDoneMsg struct{}
+type TrainingStreamErrMsg struct{ Err error }
 
 // FetchStables retrieves all stables from the daemon.
-func FetchStables(socketPath, baseURL string) tea.Cmd {
+func FetchStables(c *client.Client) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/stables")
+		body, err := doGet(c, "/api/arcade/gladiators/stables")
 		if err != nil {
 			return StablesListErrMsg{Err: err}
 		}
@@ -57,9 +60,9 @@ func FetchStables(socketPath, baseURL string) tea.Cmd {
 }
 
 // FetchStable retrieves a single stable by ID.
-func FetchStable(socketPath, baseURL, stableID string) tea.Cmd {
+func FetchStable(c *client.Client, stableID string) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/stables/"+stableID)
+		body, err := doGet(c, "/api/arcade/gladiators/stables/"+stableID)
 		if err != nil {
 			return StableDetailErrMsg{Err: err}
 		}
@@ -72,9 +75,9 @@ func FetchStable(socketPath, baseURL, stableID string) tea.Cmd {
 }
 
 // FetchChampion retrieves the champion for a stable.
-func FetchChampion(socketPath, baseURL, stableID string) tea.Cmd {
+func FetchChampion(c *client.Client, stableID string) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/stables/"+stableID+"/champion")
+		body, err := doGet(c, "/api/arcade/gladiators/stables/"+stableID+"/champion")
 		if err != nil {
 			return ChampionErrMsg{Err: err}
 		}
@@ -87,9 +90,9 @@ func FetchChampion(socketPath, baseURL, stableID string) tea.Cmd {
 }
 
 // FetchGenerations retrieves training history for a stable.
-func FetchGenerations(socketPath, baseURL, stableID string) tea.Cmd {
+func FetchGenerations(c *client.Client, stableID string) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/stables/"+stableID+"/generations")
+		body, err := doGet(c, "/api/arcade/gladiators/stables/"+stableID+"/generations")
 		if err != nil {
 			return GenerationsErrMsg{Err: err}
 		}
@@ -102,9 +105,9 @@ func FetchGenerations(socketPath, baseURL, stableID string) tea.Cmd {
 }
 
 // InitiateStable creates a new training stable.
-func InitiateStable(socketPath, baseURL string, req InitiateStableRequest) tea.Cmd {
+func InitiateStable(c *client.Client, req InitiateStableRequest) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doPost(socketPath, baseURL, "/api/arcade/gladiators/stables", req)
+		body, err := doPost(c, "/api/arcade/gladiators/stables", req)
 		if err != nil {
 			return StableCreateErrMsg{Err: err}
 		}
@@ -120,9 +123,9 @@ func InitiateStable(socketPath, baseURL string, req InitiateStableRequest) tea.C
 }
 
 // HaltTraining stops a running training process.
-func HaltTraining(socketPath, baseURL, stableID string) tea.Cmd {
+func HaltTraining(c *client.Client, stableID string) tea.Cmd {
 	return func() tea.Msg {
-		_, err := doPost(socketPath, baseURL, "/api/arcade/gladiators/stables/"+stableID+"/halt", nil)
+		_, err := doPost(c, "/api/arcade/gladiators/stables/"+stableID+"/halt", nil)
 		if err != nil {
 			return TrainingHaltErrMsg{Err: err}
 		}
@@ -131,10 +134,10 @@ func HaltTraining(socketPath, baseURL, stableID string) tea.Cmd {
 }
 
 // StartChampionDuel starts a match between the stable's champion and an AI opponent.
-func StartChampionDuel(socketPath, baseURL, stableID string, opponentAF, tickMs int) tea.Cmd {
+func StartChampionDuel(c *client.Client, stableID string, opponentAF, tickMs int) tea.Cmd {
 	return func() tea.Msg {
 		payload := map[string]int{"opponent_af": opponentAF, "tick_ms": tickMs}
-		body, err := doPost(socketPath, baseURL, "/api/arcade/gladiators/stables/"+stableID+"/duel", payload)
+		body, err := doPost(c, "/api/arcade/gladiators/stables/"+stableID+"/duel", payload)
 		if err != nil {
 			return DuelStartErrMsg{Err: err}
 		}
@@ -150,9 +153,9 @@ func StartChampionDuel(socketPath, baseURL, stableID string, opponentAF, tickMs
 }
 
 // FetchHeroes retrieves all heroes.
-func FetchHeroes(socketPath, baseURL string) tea.Cmd {
+func FetchHeroes(c *client.Client) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/heroes")
+		body, err := doGet(c, "/api/arcade/gladiators/heroes")
 		if err != nil {
 			return HeroesListErrMsg{Err: err}
 		}
@@ -165,9 +168,9 @@ func FetchHeroes(socketPath, baseURL string) tea.Cmd {
 }
 
 // FetchHero retrieves a single hero by ID.
-func FetchHero(socketPath, baseURL, heroID string) tea.Cmd {
+func FetchHero(c *client.Client, heroID string) tea.Cmd {
 	return func() tea.Msg {
-		body, err := doGet(socketPath, baseURL, "/api/arcade/gladiators/heroes/"+heroID)
+		body, err := doGet(c, "/api/arcade/gladiators/heroes/"+heroID)
 		if err != nil {
 			return HeroDetailErrMsg{Err: err}
 		}
@@ -180,10 +183,10 @@ func FetchHero(socketPath, baseURL, heroID string) tea.Cmd {
 }
 
 // PromoteChampion promotes a stable's champion to a permanent hero.
-func PromoteChampion(socketPath, baseURL, stableID, name string) tea.Cmd {
+func PromoteChampion(c *client.Client, stableID, name string) tea.Cmd {
 	return func() tea.Msg {
 		payload := map[string]string{"stable_id": stableID, "name": name}
-		body, err := doPost(socketPath, baseURL, "/api/arcade/gladiators/heroes", payload)
+		body, err := doPost(c, "/api/arcade/gladiators/heroes", payload)
 		if err != nil {
 			return HeroPromoteErrMsg{Err: err}
 		}
@@ -196,10 +199,10 @@ func PromoteChampion(socketPath, baseURL, stableID, name string) tea.Cmd {
 }
 
 // StartHeroDuel starts a duel between a hero and an AI opponent.
-func StartHeroDuel(socketPath, baseURL, heroID string, opponentAF, tickMs int) tea.Cmd {
+func StartHeroDuel(c *client.Client, heroID string, opponentAF, tickMs int) tea.Cmd {
 	return func() tea.Msg {
 		payload := map[string]int{"opponent_af": opponentAF, "tick_ms": tickMs}
-		body, err := doPost(socketPath, baseURL, "/api/arcade/gladiators/heroes/"+heroID, payload)
+		body, err := doPost(c, "/api/arcade/gladiators/heroes/"+heroID, payload)
 		if err != nil {
 			return HeroDuelStartErrMsg{Err: err}
 		}
@@ -214,24 +217,44 @@ func StartHeroDuel(socketPath, baseURL, heroID string, opponentAF, tickMs int) t
 	}
 }
 
+// StartHeroVsHeroDuel starts a duel between two heroes, each snake driven
+// by its hero's network. hero1 plays as player1.
+func StartHeroVsHeroDuel(c *client.Client, hero1ID, hero2ID string, tickMs int) tea.Cmd {
+	return func() tea.Msg {
+		payload := map[string]int{"tick_ms": tickMs}
+		path := "/api/arcade/gladiators/heroes/" + hero1ID + "/vs/" + hero2ID
+		body, err := doPost(c, path, payload)
+		if err != nil {
+			return HeroVsHeroStartErrMsg{Err: err}
+		}
+		var resp DuelResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return HeroVsHeroStartErrMsg{Err: err}
+		}
+		if resp.MatchID == "" {
+			return HeroVsHeroStartErrMsg{Err: stableErr("daemon returned empty match_id")}
+		}
+		return HeroVsHeroStartedMsg{MatchID: resp.MatchID}
+	}
+}
+
 // TrainingStream manages an SSE connection to a training progress stream.
 type TrainingStream struct {
-	socketPath string
-	baseURL    string
-	eventChan  chan TrainingProgress
-	ctx        context.Context
-	cancel     context.CancelFunc
+	client    *client.Client
+	eventChan chan TrainingProgress
+	err       error // set before the channel closes if the daemon refused us
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
 // NewTrainingStream creates a new SSE stream for training progress.
-func NewTrainingStream(socketPath, baseURL string) *TrainingStream {
+func NewTrainingStream(c *client.Client) *TrainingStream {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &TrainingStream{
-		socketPath: socketPath,
-		baseURL:    baseURL,
-		eventChan:  make(chan TrainingProgress, 20),
-		ctx:        ctx,
-		cancel:     cancel,
+		client:    c,
+		eventChan: make(chan TrainingProgress, 20),
+		ctx:       ctx,
+		cancel:    cancel,
 	}
 }
 
@@ -247,6 +270,9 @@ func (s *TrainingStream) PollCmd() tea.Cmd {
 		select {
 		case progress, ok := <-s.eventChan:
 			if !ok {
+				if s.err != nil {
+					return TrainingStreamErrMsg{Err: s.err}
+				}
 				return TrainingStreamDoneMsg{}
 			}
 			return TrainingUpdateMsg{Progress: progress}
@@ -264,30 +290,16 @@ func (s *TrainingStream) Close() {
 func (s *TrainingStream) readLoop(stableID string) {
 	defer close(s.eventChan)
 
-	url := s.baseURL + "/api/arcade/gladiators/stables/" + stableID + "/stream"
+	url := s.client.BaseURL() + "/api/arcade/gladiators/stables/" + stableID + "/stream"
 
 	req, err := http.NewRequestWithContext(s.ctx, "GET", url, nil)
 	if err != nil {
 		return
 	}
 	req.Header.Set("Accept", "text/event-stream")
+	s.client.Authorize(req)
 
-	transport := &http.Transport{
-		IdleConnTimeout:       0,
-		ResponseHeaderTimeout: 0,
-		ExpectContinueTimeout: 0,
-	}
-	if s.socketPath != "" {
-		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, "unix", s.socketPath)
-		}
-	}
-
-	httpClient := &http.Client{
-		Transport: transport,
-		Timeout:   0,
-	}
+	httpClient := s.client.StreamHTTPClient()
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -295,6 +307,10 @@ func (s *TrainingStream) readLoop(stableID string) {
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if err := s.client.CheckAuth(resp); err != nil {
+		s.err = err
+		return
+	}
 	if resp.StatusCode != http.StatusOK {
 		return
 	}
@@ -335,28 +351,15 @@ func (s *TrainingStream) readLoop(stableID string) {
 
 // HTTP helpers
 
-func newHTTPClient(socketPath string) *http.Client {
-	transport := &http.Transport{}
-	if socketPath != "" {
-		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
-			var d net.Dialer
-			return d.DialContext(ctx, "unix", socketPath)
-		}
-	}
-	return &http.Client{Transport: transport}
-}
-
-func doGet(socketPath, baseURL, path string) ([]byte, error) {
-	httpClient := newHTTPClient(socketPath)
-	resp, err := httpClient.Get(baseURL + path)
+func doGet(c *client.Client, path string) ([]byte, error) {
+	req, err := http.NewRequest("GET", c.BaseURL()+path, nil)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = resp.Body.Close() }()
-	return io.ReadAll(resp.Body)
+	return doRequest(c, req)
 }
 
-func doPost(socketPath, baseURL, path string, payload interface{}) ([]byte, error) {
+func doPost(c *client.Client, path string, payload interface{}) ([]byte, error) {
 	var bodyReader io.Reader
 	if payload != nil {
 		jsonBody, err := json.Marshal(payload)
@@ -366,20 +369,27 @@ func doPost(socketPath, baseURL, path string, payload interface{}) ([]byte, erro
 		bodyReader = strings.NewReader(string(jsonBody))
 	}
 
-	req, err := http.NewRequest("POST", baseURL+path, bodyReader)
+	req, err := http.NewRequest("POST", c.BaseURL()+path, bodyReader)
 	if err != nil {
 		return nil, err
 	}
 	if payload != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	return doRequest(c, req)
+}
 
-	httpClient := newHTTPClient(socketPath)
-	resp, err := httpClient.Do(req)
+// doRequest sends req with the client's bearer token and returns the body.
+func doRequest(c *client.Client, req *http.Request) ([]byte, error) {
+	c.Authorize(req)
+	resp, err := c.HTTPClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
+	if err := c.CheckAuth(resp); err != nil {
+		return nil, err
+	}
 	return io.ReadAll(resp.Body)
 }
 
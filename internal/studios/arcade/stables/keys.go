@@ -1,6 +1,8 @@
 package stables
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -23,6 +25,10 @@ func (m *Model) handleKey(msg tea.KeyMsg) tea.Cmd {
 		return m.handleHeroDetailKey(key)
 	case phasePromote:
 		return m.handlePromoteKey(key)
+	case phaseSavePreset:
+		return m.handleSavePresetKey(key)
+	case phaseHeroVsHero:
+		return m.handleHeroVsHeroKey(key)
 	case phaseHeroDuel:
 		return m.handleHeroDuelKey(key)
 	}
@@ -56,13 +62,14 @@ func (m *Model) handleListKey(key string) tea.Cmd {
 		m.err = nil
 
 	case "r":
-		return FetchStables(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchStables(m.ctx.Client)
 
 	case "H":
 		m.phase = phaseHeroes
 		m.heroIndex = 0
+		m.versusPick = nil
 		m.err = nil
-		return FetchHeroes(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchHeroes(m.ctx.Client)
 	}
 
 	return nil
@@ -89,16 +96,35 @@ func (m *Model) handleFormKey(key string) tea.Cmd {
 		m.adjustFormField(-1)
 
 	case "p":
-		// Cycle through presets
-		presetWeights := [][7]float64{
-			{0.1, 50.0, 200.0, 50.0, 100.0, 0.5, -0.2},  // balanced
-			{0.1, 20.0, 400.0, 50.0, 250.0, 0.5, -0.2},   // aggressive
-			{0.1, 150.0, 50.0, 50.0, 100.0, 3.0, -0.2},   // forager
-			{0.8, 50.0, 200.0, 50.0, 20.0, 0.5, -1.5},    // survivor
-			{0.1, 0.0, 500.0, 0.0, 300.0, 0.0, 0.0},      // assassin
+		m.cyclePreset()
+
+	case "j", "down":
+		if m.formShowWeights && m.formWeightFocus < len(m.formWeights)-1 {
+			m.formWeightFocus++
+		}
+
+	case "k", "up":
+		if m.formShowWeights && m.formWeightFocus > 0 {
+			m.formWeightFocus--
+		}
+
+	case "l", "right":
+		if m.formShowWeights {
+			m.adjustWeight(1)
+		}
+
+	case "h", "left":
+		if m.formShowWeights {
+			m.adjustWeight(-1)
 		}
-		m.formPreset = (m.formPreset + 1) % len(presetWeights)
-		m.formWeights = presetWeights[m.formPreset]
+
+	case "S":
+		m.phase = phaseSavePreset
+		m.presetName = ""
+		if p := m.currentPreset(); p.custom {
+			m.presetName = p.name
+		}
+		m.err = nil
 
 	case "w":
 		m.formShowWeights = !m.formShowWeights
@@ -115,16 +141,9 @@ func (m *Model) handleFormKey(key string) tea.Cmd {
 
 // adjustFormField adjusts the focused form field by a step.
 func (m *Model) adjustFormField(dir int) {
-	switch m.formFocused {
-	case 0: // Population: step by 10, min 10, max 500
-		m.formFields[0] = clamp(m.formFields[0]+dir*10, 10, 500)
-	case 1: // Max generations: step by 10, min 10, max 1000
-		m.formFields[1] = clamp(m.formFields[1]+dir*10, 10, 1000)
-	case 2: // Opponent AF: step by 5, min 0, max 100
-		m.formFields[2] = clamp(m.formFields[2]+dir*5, 0, 100)
-	case 3: // Episodes per eval: step by 1, min 1, max 20
-		m.formFields[3] = clamp(m.formFields[3]+dir*1, 1, 20)
-	}
+	i := m.formFocused
+	m.formFields[i] = clamp(m.formFields[i]+dir*formFieldSteps[i], formFieldBounds[i][0], formFieldBounds[i][1])
+	m.formErrs[i] = ""
 }
 
 // handleDetailKey processes keys on the stable detail view.
@@ -134,24 +153,22 @@ func (m *Model) handleDetailKey(key string) tea.Cmd {
 		m.closeTrainingStream()
 		m.phase = phaseList
 		m.err = nil
-		return FetchStables(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchStables(m.ctx.Client)
 
 	case "d":
 		if m.selectedStable.Status == "completed" && m.champion != nil {
 			return StartChampionDuel(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedStable.StableID,
 				m.selectedStable.OpponentAF,
-				100,
+				duelTickMs,
 			)
 		}
 
 	case "h":
 		if m.selectedStable.Status == "training" {
 			return HaltTraining(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedStable.StableID,
 			)
 		}
@@ -180,6 +197,10 @@ func (m *Model) handleDetailKey(key string) tea.Cmd {
 
 // handleDuelKey processes keys during a champion duel.
 func (m *Model) handleDuelKey(key string) tea.Cmd {
+	if cmd, ok := m.handlePlaybackKey(key); ok {
+		return cmd
+	}
+
 	switch key {
 	case "esc":
 		if m.duelStream != nil {
@@ -194,11 +215,10 @@ func (m *Model) handleDuelKey(key string) tea.Cmd {
 		// Only allow new duel if current one is finished
 		if m.duelState.Status == "finished" {
 			return StartChampionDuel(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedStable.StableID,
 				m.selectedStable.OpponentAF,
-				100,
+				duelTickMs,
 			)
 		}
 	}
@@ -210,9 +230,14 @@ func (m *Model) handleDuelKey(key string) tea.Cmd {
 func (m *Model) handleHeroesKey(key string) tea.Cmd {
 	switch key {
 	case "esc":
+		if m.versusPick != nil {
+			m.versusPick = nil
+			m.err = nil
+			return nil
+		}
 		m.phase = phaseList
 		m.err = nil
-		return FetchStables(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchStables(m.ctx.Client)
 
 	case "j", "down":
 		if m.heroIndex < len(m.heroes)-1 {
@@ -230,11 +255,18 @@ func (m *Model) handleHeroesKey(key string) tea.Cmd {
 			m.selectedHero = &hero
 			m.phase = phaseHeroDetail
 			m.err = nil
-			return FetchHero(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL(), hero.HeroID)
+			return FetchHero(m.ctx.Client, hero.HeroID)
 		}
 
+	case "v":
+		return m.pickVersusHero()
+
+	case "s":
+		m.heroSort = (m.heroSort + 1) % heroSortCount
+		m.sortHeroes()
+
 	case "r":
-		return FetchHeroes(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchHeroes(m.ctx.Client)
 	}
 
 	return nil
@@ -246,16 +278,15 @@ func (m *Model) handleHeroDetailKey(key string) tea.Cmd {
 	case "esc":
 		m.phase = phaseHeroes
 		m.err = nil
-		return FetchHeroes(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchHeroes(m.ctx.Client)
 
 	case "d":
 		if m.selectedHero != nil {
 			return StartHeroDuel(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedHero.HeroID,
 				50,
-				100,
+				duelTickMs,
 			)
 		}
 	}
@@ -275,8 +306,7 @@ func (m *Model) handlePromoteKey(key string) tea.Cmd {
 	case "enter":
 		if m.promoteName != "" {
 			return PromoteChampion(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedStable.StableID,
 				m.promoteName,
 			)
@@ -297,8 +327,47 @@ func (m *Model) handlePromoteKey(key string) tea.Cmd {
 	return nil
 }
 
+// handleSavePresetKey processes keys on the save preset prompt.
+func (m *Model) handleSavePresetKey(key string) tea.Cmd {
+	switch key {
+	case "esc":
+		m.phase = phaseNewStable
+		m.presetName = ""
+		m.err = nil
+		return nil
+
+	case "enter":
+		if strings.TrimSpace(m.presetName) == "" {
+			return nil
+		}
+		if err := m.savePreset(m.presetName); err != nil {
+			m.err = err
+			return nil
+		}
+		m.phase = phaseNewStable
+		m.presetName = ""
+		m.err = nil
+
+	case "backspace":
+		if len(m.presetName) > 0 {
+			m.presetName = m.presetName[:len(m.presetName)-1]
+		}
+
+	default:
+		if len(key) == 1 && len(m.presetName) < 24 {
+			m.presetName += key
+		}
+	}
+
+	return nil
+}
+
 // handleHeroDuelKey processes keys during a hero duel.
 func (m *Model) handleHeroDuelKey(key string) tea.Cmd {
+	if cmd, ok := m.handlePlaybackKey(key); ok {
+		return cmd
+	}
+
 	switch key {
 	case "esc":
 		if m.duelStream != nil {
@@ -312,11 +381,10 @@ func (m *Model) handleHeroDuelKey(key string) tea.Cmd {
 	case "n":
 		if m.duelState.Status == "finished" && m.selectedHero != nil {
 			return StartHeroDuel(
-				m.ctx.Client.SocketPath(),
-				m.ctx.Client.BaseURL(),
+				m.ctx.Client,
 				m.selectedHero.HeroID,
 				50,
-				100,
+				duelTickMs,
 			)
 		}
 	}
@@ -324,6 +392,62 @@ func (m *Model) handleHeroDuelKey(key string) tea.Cmd {
 	return nil
 }
 
+// pickVersusHero marks the highlighted hero as the first contender, or
+// starts the duel once a different second hero is picked.
+func (m *Model) pickVersusHero() tea.Cmd {
+	if len(m.heroes) == 0 {
+		return nil
+	}
+	hero := m.heroes[m.heroIndex]
+	if m.versusPick == nil {
+		m.versusPick = &hero
+		m.err = nil
+		return nil
+	}
+	if m.versusPick.HeroID == hero.HeroID {
+		m.err = stableErr("pick a different hero as the opponent")
+		return nil
+	}
+	m.versusHeroes = [2]Hero{*m.versusPick, hero}
+	m.versusPick = nil
+	m.err = nil
+	return m.startHeroVsHero()
+}
+
+func (m *Model) startHeroVsHero() tea.Cmd {
+	return StartHeroVsHeroDuel(
+		m.ctx.Client,
+		m.versusHeroes[0].HeroID,
+		m.versusHeroes[1].HeroID,
+		duelTickMs,
+	)
+}
+
+// handleHeroVsHeroKey processes keys during a hero-vs-hero duel.
+func (m *Model) handleHeroVsHeroKey(key string) tea.Cmd {
+	if cmd, ok := m.handlePlaybackKey(key); ok {
+		return cmd
+	}
+
+	switch key {
+	case "esc":
+		if m.duelStream != nil {
+			m.duelStream.Close()
+			m.duelStream = nil
+		}
+		m.phase = phaseHeroes
+		m.err = nil
+		return FetchHeroes(m.ctx.Client)
+
+	case "n":
+		if m.duelState.Status == "finished" {
+			return m.startHeroVsHero()
+		}
+	}
+
+	return nil
+}
+
 func clamp(v, min, max int) int {
 	if v < min {
 		return min
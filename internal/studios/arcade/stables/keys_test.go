@@ -0,0 +1,26 @@
+package stables
+
+import "testing"
+
+func TestPickVersusHero(t *testing.T) {
+	m := &Model{phase: phaseHeroes, heroes: []Hero{{HeroID: "h1", Name: "Ada"}, {HeroID: "h2", Name: "Bo"}}}
+
+	m.pickVersusHero()
+	if m.versusPick == nil || m.versusPick.HeroID != "h1" {
+		t.Fatalf("first pick = %v, want h1", m.versusPick)
+	}
+
+	// Picking the same hero again is refused and keeps the first pick.
+	if cmd := m.pickVersusHero(); cmd != nil || m.err == nil {
+		t.Fatal("picking the same hero twice should report an error")
+	}
+	if m.versusPick == nil {
+		t.Fatal("first pick was dropped")
+	}
+
+	// Esc cancels the pick without leaving the heroes list.
+	m.handleHeroesKey("esc")
+	if m.versusPick != nil || m.phase != phaseHeroes {
+		t.Errorf("esc: pick = %v, phase = %s", m.versusPick, m.phase)
+	}
+}
@@ -0,0 +1,203 @@
+package stables
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/config"
+)
+
+// Elo parameters for hero ratings.
+const (
+	baseRating = 1200.0
+	eloK       = 32.0
+)
+
+// heroSort orders the heroes list.
+type heroSort int
+
+const (
+	sortByRating heroSort = iota
+	sortByWinRate
+	sortByFitness
+	sortByName
+	heroSortCount
+)
+
+func (s heroSort) String() string {
+	switch s {
+	case sortByWinRate:
+		return "win rate"
+	case sortByFitness:
+		return "fitness"
+	case sortByName:
+		return "name"
+	default:
+		return "local rating"
+	}
+}
+
+// heroRatings replays recorded hero-vs-hero duels in order and returns
+// each hero's Elo rating. The records are local to this machine, unlike the
+// daemon's W/L/D, so views label the rating as local. Heroes without duels
+// are absent; use rating().
+func heroRatings(records []config.HeroDuelRecord) map[string]float64 {
+	ratings := make(map[string]float64)
+	get := func(id string) float64 {
+		if r, ok := ratings[id]; ok {
+			return r
+		}
+		return baseRating
+	}
+	for _, d := range records {
+		var score float64 // player1's result: 1 win, 0.5 draw, 0 loss
+		switch d.Winner {
+		case "player1":
+			score = 1
+		case "player2":
+			score = 0
+		case "draw":
+			score = 0.5
+		default:
+			continue
+		}
+		r1, r2 := get(d.Hero1ID), get(d.Hero2ID)
+		expected := 1 / (1 + math.Pow(10, (r2-r1)/400))
+		delta := eloK * (score - expected)
+		ratings[d.Hero1ID] = r1 + delta
+		ratings[d.Hero2ID] = r2 - delta
+	}
+	return ratings
+}
+
+// rating returns the hero's Elo rating, or the base rating if unranked.
+func (m *Model) rating(heroID string) float64 {
+	if r, ok := m.heroRatings[heroID]; ok {
+		return r
+	}
+	return baseRating
+}
+
+// winRate is wins over decided and drawn games, counting draws as half.
+func winRate(h Hero) float64 {
+	games := h.Wins + h.Losses + h.Draws
+	if games == 0 {
+		return 0
+	}
+	return (float64(h.Wins) + float64(h.Draws)/2) / float64(games)
+}
+
+// sortHeroes orders m.heroes by the current sort, keeping the highlighted
+// hero selected.
+func (m *Model) sortHeroes() {
+	var selectedID string
+	if m.heroIndex < len(m.heroes) {
+		selectedID = m.heroes[m.heroIndex].HeroID
+	}
+
+	less := func(a, b Hero) bool {
+		switch m.heroSort {
+		case sortByWinRate:
+			if wa, wb := winRate(a), winRate(b); wa != wb {
+				return wa > wb
+			}
+		case sortByFitness:
+			if a.Fitness != b.Fitness {
+				return a.Fitness > b.Fitness
+			}
+		case sortByName:
+		default:
+			if ra, rb := m.rating(a.HeroID), m.rating(b.HeroID); ra != rb {
+				return ra > rb
+			}
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	}
+	sort.SliceStable(m.heroes, func(i, j int) bool { return less(m.heroes[i], m.heroes[j]) })
+
+	for i, h := range m.heroes {
+		if h.HeroID == selectedID {
+			m.heroIndex = i
+			break
+		}
+	}
+}
+
+// ratingRanks returns each hero's leaderboard position by rating,
+// independent of the list's current sort.
+func (m *Model) ratingRanks() map[string]int {
+	ids := make([]string, len(m.heroes))
+	for i, h := range m.heroes {
+		ids[i] = h.HeroID
+	}
+	sort.SliceStable(ids, func(i, j int) bool { return m.rating(ids[i]) > m.rating(ids[j]) })
+	ranks := make(map[string]int, len(ids))
+	for i, id := range ids {
+		ranks[id] = i + 1
+	}
+	return ranks
+}
+
+// headToHead is a hero's record against one opponent.
+type headToHead struct {
+	opponentID          string
+	wins, losses, draws int
+}
+
+// headToHeadRecords summarizes heroID's recorded duels per opponent,
+// most-played first.
+func headToHeadRecords(records []config.HeroDuelRecord, heroID string) []headToHead {
+	byOpponent := make(map[string]*headToHead)
+	var order []string
+	for _, d := range records {
+		var opponent string
+		var won, lost bool
+		switch heroID {
+		case d.Hero1ID:
+			opponent, won, lost = d.Hero2ID, d.Winner == "player1", d.Winner == "player2"
+		case d.Hero2ID:
+			opponent, won, lost = d.Hero1ID, d.Winner == "player2", d.Winner == "player1"
+		default:
+			continue
+		}
+		h, ok := byOpponent[opponent]
+		if !ok {
+			h = &headToHead{opponentID: opponent}
+			byOpponent[opponent] = h
+			order = append(order, opponent)
+		}
+		switch {
+		case won:
+			h.wins++
+		case lost:
+			h.losses++
+		case d.Winner == "draw":
+			h.draws++
+		}
+	}
+
+	out := make([]headToHead, 0, len(order))
+	for _, id := range order {
+		out = append(out, *byOpponent[id])
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].wins+out[i].losses+out[i].draws > out[j].wins+out[j].losses+out[j].draws
+	})
+	return out
+}
+
+// recordHeroDuel stores a finished hero-vs-hero duel and refreshes ratings.
+func (m *Model) recordHeroDuel(winner string) error {
+	records, err := config.RecordHeroDuel(config.HeroDuelRecord{
+		MatchID: m.duelMatchID,
+		Hero1ID: m.versusHeroes[0].HeroID,
+		Hero2ID: m.versusHeroes[1].HeroID,
+		Winner:  winner,
+		At:      time.Now(),
+	})
+	m.heroDuels = records
+	m.heroRatings = heroRatings(records)
+	return err
+}
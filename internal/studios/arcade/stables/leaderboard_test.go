@@ -0,0 +1,62 @@
+package stables
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/config"
+)
+
+func TestHeroRatings(t *testing.T) {
+	records := []config.HeroDuelRecord{
+		{Hero1ID: "a", Hero2ID: "b", Winner: "player1"},
+		{Hero1ID: "b", Hero2ID: "a", Winner: "draw"},
+		{Hero1ID: "a", Hero2ID: "c", Winner: "aborted"}, // ignored
+	}
+	r := heroRatings(records)
+
+	// Rating changes are zero-sum between the two heroes.
+	if got := r["a"] + r["b"]; math.Abs(got-2*baseRating) > 1e-9 {
+		t.Errorf("ratings not zero-sum: a+b = %v", got)
+	}
+	if r["a"] <= baseRating || r["b"] >= baseRating {
+		t.Errorf("a = %.1f, b = %.1f; want a ahead", r["a"], r["b"])
+	}
+	if _, ok := r["c"]; ok {
+		t.Error("unfinished duel should not rate c")
+	}
+}
+
+func TestHeadToHeadRecords(t *testing.T) {
+	records := []config.HeroDuelRecord{
+		{Hero1ID: "a", Hero2ID: "b", Winner: "player1"},
+		{Hero1ID: "c", Hero2ID: "a", Winner: "player1"},
+		{Hero1ID: "b", Hero2ID: "a", Winner: "player1"},
+		{Hero1ID: "b", Hero2ID: "a", Winner: "draw"},
+	}
+	got := headToHeadRecords(records, "a")
+	want := []headToHead{
+		{opponentID: "b", wins: 1, losses: 1, draws: 1},
+		{opponentID: "c", losses: 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("record %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSortHeroesKeepsSelection(t *testing.T) {
+	m := &Model{
+		heroes:      []Hero{{HeroID: "a", Name: "Ada"}, {HeroID: "b", Name: "Bo"}},
+		heroRatings: map[string]float64{"b": 1300},
+		heroIndex:   0,
+	}
+	m.sortHeroes()
+	if m.heroes[0].HeroID != "b" || m.heroes[m.heroIndex].HeroID != "a" {
+		t.Errorf("order %v, selected %s", m.heroes, m.heroes[m.heroIndex].HeroID)
+	}
+}
@@ -1,9 +1,11 @@
 package stables
 
 import (
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/studio"
 	"github.com/hecate-social/hecate-tui/internal/studios/arcade/snake_duel"
 )
@@ -18,6 +20,8 @@ const (
 	phasePromote    = "promote"
 	phaseHeroDetail = "hero_detail"
 	phaseHeroDuel   = "hero_duel"
+	phaseSavePreset = "save_preset"
+	phaseHeroVsHero = "hero_vs_hero"
 )
 
 // Model is the Bubble Tea model for the Stables sub-app.
@@ -37,35 +41,48 @@ type Model struct {
 	listLoaded bool
 
 	// New stable form state
-	formFields    [4]int    // population, maxGens, opponentAF, episodesPerEval
-	formLabels    [4]string // labels for each field
-	formFocused   int       // which field has focus
-	formSeedID    string    // optional seed stable ID
+	formFields  [4]int    // population, maxGens, opponentAF, episodesPerEval
+	formLabels  [4]string // labels for each field
+	formFocused int       // which field has focus
+	formSeedID  string    // optional seed stable ID
+	formErrs    [4]string // per-field validation messages
 
 	// Detail view state
-	selectedStable Stable
-	champion       *Champion
-	generations    []GenerationStats
-	trainingStream *TrainingStream
-	lastProgress   *TrainingProgress
+	selectedStable  Stable
+	champion        *Champion
+	generations     []GenerationStats
+	trainingStream  *TrainingStream
+	lastProgress    *TrainingProgress
+	trainingRetries int // reconnect attempts since the stream dropped; 0 when live
 
 	// Duel view state (reuses snake_duel)
 	duelMatchID string
 	duelStream  *snake_duel.MatchStream
 	duelState   snake_duel.GameState
+	duelFrames  []snake_duel.GameState // streamed frames not yet shown
+	duelSpeed   int                    // index into duelSpeeds
+	duelPaused  bool
+	duelTickSeq int // current playback loop; older ticks are dropped
 
 	// Fitness weight form state
-	formPreset      int        // index into preset names (0=balanced)
+	formPreset      int        // index into presets() (0=balanced)
 	formShowWeights bool       // toggle advanced section
 	formWeights     [7]float64 // survival, food, win, draw, kill, proximity, circle
 	formWeightNames [7]string
 	formWeightFocus int // which weight field has focus in advanced mode
+	savedPresets    []config.FitnessPreset
+	presetName      string // text input for a new preset name
 
 	// Hero state
 	heroes       []Hero
 	heroIndex    int
 	selectedHero *Hero
-	promoteName  string // text input for hero name
+	promoteName  string  // text input for hero name
+	versusPick   *Hero   // first hero picked for a hero-vs-hero duel
+	versusHeroes [2]Hero // heroes in the current hero-vs-hero duel
+	heroDuels    []config.HeroDuelRecord
+	heroRatings  map[string]float64
+	heroSort     heroSort
 
 	// Navigation
 	wantsBack bool
@@ -76,19 +93,24 @@ type Model struct {
 
 // New creates a new Stables model.
 func New(ctx *studio.Context) *Model {
-	return &Model{
-		ctx:   ctx,
-		phase: phaseList,
-		formFields: [4]int{50, 100, 50, 3},
+	m := &Model{
+		ctx:             ctx,
+		phase:           phaseList,
+		formFields:      [4]int{50, 100, 50, 3},
 		formLabels:      [4]string{"Population", "Max Generations", "Opponent AF", "Episodes/Eval"},
-		formWeights:     [7]float64{0.1, 50.0, 200.0, 50.0, 100.0, 0.5, -0.2},
+		formWeights:     builtinPresets[0].weights,
 		formWeightNames: [7]string{"Survival", "Food", "Win Bonus", "Draw Bonus", "Kill Bonus", "Proximity", "Circle Penalty"},
+		savedPresets:    config.LoadFitnessPresets(),
+		duelSpeed:       defaultDuelSpeed,
 	}
+	m.heroDuels = config.LoadHeroDuels()
+	m.heroRatings = heroRatings(m.heroDuels)
+	return m
 }
 
 // Init returns the initial command — fetch stables list.
 func (m *Model) Init() tea.Cmd {
-	return FetchStables(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+	return FetchStables(m.ctx.Client)
 }
 
 // SetSize updates the layout dimensions.
@@ -107,6 +129,11 @@ func (m *Model) ClearWantsBack() {
 	m.wantsBack = false
 }
 
+// TextEntry reports whether a name prompt has focus.
+func (m *Model) TextEntry() bool {
+	return m.phase == phasePromote || m.phase == phaseSavePreset
+}
+
 // View renders the current phase.
 func (m *Model) View() string {
 	return m.view()
@@ -118,7 +145,10 @@ func (m *Model) Hints() string {
 	case phaseList:
 		return "j/k:navigate  Enter:open  n:new stable  r:refresh  esc:back"
 	case phaseNewStable:
-		return "Tab/S-Tab:fields  +/-:adjust  Enter:create  esc:cancel"
+		if m.formShowWeights {
+			return "Tab/S-Tab:fields  +/-:adjust  j/k:weight  h/l:tune  p:preset  S:save  Enter:create  esc:cancel"
+		}
+		return "Tab/S-Tab:fields  +/-:adjust  p:preset  w:weights  S:save  Enter:create  esc:cancel"
 	case phaseDetail:
 		if m.selectedStable.Status == "training" {
 			return "h:halt  r:refresh  esc:back"
@@ -128,15 +158,22 @@ func (m *Model) Hints() string {
 		}
 		return "s:seed new  r:refresh  esc:back"
 	case phaseDuel:
-		return "esc:stop duel"
+		return "+/-:speed  space:pause  .:step  esc:stop duel"
 	case phaseHeroes:
-		return "j/k:navigate  Enter:view  esc:back to stables"
+		if m.versusPick != nil {
+			return "j/k:navigate  v:pick opponent  esc:cancel pick"
+		}
+		return "j/k:navigate  Enter:view  v:hero vs hero  s:sort  esc:back to stables"
 	case phaseHeroDetail:
 		return "d:duel  esc:back to heroes"
 	case phasePromote:
 		return "type name  Enter:confirm  esc:cancel"
+	case phaseSavePreset:
+		return "type name  Enter:save  esc:cancel"
 	case phaseHeroDuel:
-		return "esc:back to hero"
+		return "+/-:speed  space:pause  .:step  esc:back to hero"
+	case phaseHeroVsHero:
+		return "+/-:speed  space:pause  .:step  esc:back to heroes"
 	default:
 		return ""
 	}
@@ -202,7 +239,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return nil
 
 	case GenerationsMsg:
-		m.generations = msg.Generations
+		m.generations = mergeGenerations(m.generations, msg.Generations)
 		return nil
 
 	case GenerationsErrMsg:
@@ -213,7 +250,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case StableCreatedMsg:
 		m.err = nil
 		m.phase = phaseList
-		return FetchStables(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchStables(m.ctx.Client)
 
 	case StableCreateErrMsg:
 		m.err = msg.Err
@@ -232,6 +269,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	// Training SSE stream
 	case TrainingUpdateMsg:
 		m.lastProgress = &msg.Progress
+		m.trainingRetries = 0
+		m.recordProgress(msg.Progress)
 		if !msg.Progress.Running {
 			m.selectedStable.Status = msg.Progress.Status
 			m.closeTrainingStream()
@@ -243,8 +282,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return m.pollTrainingStream()
 
 	case TrainingStreamDoneMsg:
+		return m.handleTrainingDisconnect()
+
+	case TrainingStreamErrMsg:
+		// Reconnecting with the same rejected token would only fail again
 		m.closeTrainingStream()
-		return m.refreshDetail()
+		m.err = msg.Err
+		return nil
+
+	case trainingReconnectMsg:
+		return m.reconnectTraining(msg)
 
 	case trainingPollTickMsg:
 		if m.trainingStream != nil {
@@ -256,11 +303,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case DuelStartedMsg:
 		m.duelMatchID = msg.MatchID
 		m.phase = phaseDuel
+		m.resetPlayback()
 		m.duelStream = snake_duel.NewMatchStream(
-			m.ctx.Client.SocketPath(),
-			m.ctx.Client.BaseURL(),
+			m.ctx.Client,
 		)
-		return m.duelStream.Connect(m.duelMatchID)
+		return tea.Batch(m.duelStream.Connect(m.duelMatchID), m.schedulePlayback())
 
 	case DuelStartErrMsg:
 		m.err = msg.Err
@@ -270,6 +317,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case HeroesListMsg:
 		m.heroes = msg.Heroes
 		m.err = nil
+		m.sortHeroes()
 		if m.heroIndex >= len(m.heroes) && len(m.heroes) > 0 {
 			m.heroIndex = len(m.heroes) - 1
 		}
@@ -292,7 +340,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.phase = phaseHeroes
 		m.promoteName = ""
 		m.err = nil
-		return FetchHeroes(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL())
+		return FetchHeroes(m.ctx.Client)
 
 	case HeroPromoteErrMsg:
 		m.err = msg.Err
@@ -301,20 +349,39 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case HeroDuelStartedMsg:
 		m.duelMatchID = msg.MatchID
 		m.phase = phaseHeroDuel
+		m.resetPlayback()
 		m.duelStream = snake_duel.NewMatchStream(
-			m.ctx.Client.SocketPath(),
-			m.ctx.Client.BaseURL(),
+			m.ctx.Client,
 		)
-		return m.duelStream.Connect(m.duelMatchID)
+		return tea.Batch(m.duelStream.Connect(m.duelMatchID), m.schedulePlayback())
 
 	case HeroDuelStartErrMsg:
 		m.err = msg.Err
 		return nil
 
+	case HeroVsHeroStartedMsg:
+		m.duelMatchID = msg.MatchID
+		m.phase = phaseHeroVsHero
+		m.resetPlayback()
+		m.duelStream = snake_duel.NewMatchStream(
+			m.ctx.Client,
+		)
+		return tea.Batch(m.duelStream.Connect(m.duelMatchID), m.schedulePlayback())
+
+	case HeroVsHeroStartErrMsg:
+		m.err = msg.Err
+		return nil
+
 	// Duel stream messages (forwarded from snake_duel's MatchStream)
 	case snake_duel.MatchStateMsg:
-		m.duelState = msg.State
+		// Frames are shown by the playback loop at the chosen speed.
+		m.queueDuelFrame(msg.State)
 		if msg.State.Status == "finished" {
+			if m.phase == phaseHeroVsHero {
+				if err := m.recordHeroDuel(msg.State.Winner); err != nil {
+					m.err = err
+				}
+			}
 			if m.duelStream != nil {
 				m.duelStream.Close()
 				m.duelStream = nil
@@ -333,6 +400,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 		return nil
 
+	case duelPlaybackTickMsg:
+		return m.handlePlaybackTick(msg)
+
 	case duelPollTickMsg:
 		if m.duelStream != nil {
 			return m.duelStream.PollCmd()
@@ -352,22 +422,22 @@ func (m *Model) openDetail() tea.Cmd {
 	m.champion = nil
 	m.generations = nil
 	m.lastProgress = nil
+	m.trainingRetries = 0
 	m.phase = phaseDetail
 	m.err = nil
 
-	sp := m.ctx.Client.SocketPath()
-	bu := m.ctx.Client.BaseURL()
+	c := m.ctx.Client
 	sid := m.selectedStable.StableID
 
 	cmds := []tea.Cmd{
-		FetchStable(sp, bu, sid),
-		FetchChampion(sp, bu, sid),
-		FetchGenerations(sp, bu, sid),
+		FetchStable(c, sid),
+		FetchChampion(c, sid),
+		FetchGenerations(c, sid),
 	}
 
 	// Auto-connect to training stream if still training
 	if m.selectedStable.Status == "training" {
-		m.trainingStream = NewTrainingStream(sp, bu)
+		m.trainingStream = NewTrainingStream(c)
 		cmds = append(cmds, m.trainingStream.Connect(sid))
 	}
 
@@ -376,18 +446,23 @@ func (m *Model) openDetail() tea.Cmd {
 
 // refreshDetail re-fetches detail data.
 func (m *Model) refreshDetail() tea.Cmd {
-	sp := m.ctx.Client.SocketPath()
-	bu := m.ctx.Client.BaseURL()
+	c := m.ctx.Client
 	sid := m.selectedStable.StableID
 	return tea.Batch(
-		FetchStable(sp, bu, sid),
-		FetchChampion(sp, bu, sid),
-		FetchGenerations(sp, bu, sid),
+		FetchStable(c, sid),
+		FetchChampion(c, sid),
+		FetchGenerations(c, sid),
 	)
 }
 
 // createStable initiates a new stable from form values.
 func (m *Model) createStable() tea.Cmd {
+	var err error
+	if m.formErrs, err = m.validateStableForm(); err != nil {
+		m.err = err
+		return nil
+	}
+
 	req := InitiateStableRequest{
 		PopulationSize:  m.formFields[0],
 		MaxGenerations:  m.formFields[1],
@@ -396,27 +471,25 @@ func (m *Model) createStable() tea.Cmd {
 		SeedStableID:    m.formSeedID,
 	}
 
-	// Add training config with fitness weights if not balanced (default)
-	presetNames := []string{"balanced", "aggressive", "forager", "survivor", "assassin"}
-	if m.formPreset > 0 || m.formShowWeights {
-		tc := &TrainingConfig{}
-		if m.formPreset > 0 && m.formPreset < len(presetNames) {
-			tc.FitnessPreset = presetNames[m.formPreset]
-		} else if m.formShowWeights {
-			tc.FitnessWeights = &FitnessWeights{
-				SurvivalWeight:  m.formWeights[0],
-				FoodWeight:      m.formWeights[1],
-				WinBonus:        m.formWeights[2],
-				DrawBonus:       m.formWeights[3],
-				KillBonus:       m.formWeights[4],
-				ProximityWeight: m.formWeights[5],
-				CirclePenalty:   m.formWeights[6],
-			}
-		}
-		req.TrainingConfig = tc
+	// Built-in presets go by name; saved presets and edited weights are
+	// sent explicitly. Unmodified Balanced is the daemon default.
+	preset := m.currentPreset()
+	switch {
+	case m.weightsModified() || preset.custom:
+		req.TrainingConfig = &TrainingConfig{FitnessWeights: &FitnessWeights{
+			SurvivalWeight:  m.formWeights[0],
+			FoodWeight:      m.formWeights[1],
+			WinBonus:        m.formWeights[2],
+			DrawBonus:       m.formWeights[3],
+			KillBonus:       m.formWeights[4],
+			ProximityWeight: m.formWeights[5],
+			CirclePenalty:   m.formWeights[6],
+		}}
+	case m.formPreset > 0:
+		req.TrainingConfig = &TrainingConfig{FitnessPreset: strings.ToLower(preset.name)}
 	}
 
-	return InitiateStable(m.ctx.Client.SocketPath(), m.ctx.Client.BaseURL(), req)
+	return InitiateStable(m.ctx.Client, req)
 }
 
 // closeTrainingStream cleans up the training SSE stream.
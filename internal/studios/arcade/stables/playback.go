@@ -0,0 +1,131 @@
+package stables
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/studios/arcade/snake_duel"
+)
+
+// duelTickMs is the daemon tick requested for duels; playback at 1x
+// shows frames at the same pace.
+const duelTickMs = 100
+
+// duelSpeeds are the playback multipliers cycled with +/-.
+var duelSpeeds = []float64{0.25, 0.5, 1, 2, 4}
+
+// defaultDuelSpeed is the index of 1x in duelSpeeds.
+const defaultDuelSpeed = 2
+
+// duelPlaybackTickMsg advances playback by one frame. seq ties the tick to
+// the playback loop that scheduled it so stale loops die out after a
+// speed change or pause.
+type duelPlaybackTickMsg struct{ seq int }
+
+// resetPlayback clears buffered frames and returns to 1x, unpaused.
+func (m *Model) resetPlayback() {
+	m.duelFrames = nil
+	m.duelPaused = false
+	m.duelSpeed = defaultDuelSpeed
+	m.duelTickSeq++
+}
+
+// duelInterval is the time between frames at the current speed.
+func (m *Model) duelInterval() time.Duration {
+	return time.Duration(float64(duelTickMs*time.Millisecond) / duelSpeeds[m.duelSpeed])
+}
+
+// restartPlayback starts a new playback loop, orphaning any pending tick.
+func (m *Model) restartPlayback() tea.Cmd {
+	m.duelTickSeq++
+	return m.schedulePlayback()
+}
+
+func (m *Model) schedulePlayback() tea.Cmd {
+	if m.duelPaused {
+		return nil
+	}
+	seq := m.duelTickSeq
+	return tea.Tick(m.duelInterval(), func(_ time.Time) tea.Msg {
+		return duelPlaybackTickMsg{seq: seq}
+	})
+}
+
+// queueDuelFrame buffers a streamed frame for playback.
+func (m *Model) queueDuelFrame(state snake_duel.GameState) {
+	m.duelFrames = append(m.duelFrames, state)
+}
+
+// stepDuel shows the next buffered frame, if any.
+func (m *Model) stepDuel() {
+	if len(m.duelFrames) == 0 {
+		return
+	}
+	m.duelState = m.duelFrames[0]
+	m.duelFrames = m.duelFrames[1:]
+}
+
+// handlePlaybackTick advances one frame and keeps the loop going while
+// there is anything left to show.
+func (m *Model) handlePlaybackTick(msg duelPlaybackTickMsg) tea.Cmd {
+	if msg.seq != m.duelTickSeq || m.duelPaused {
+		return nil
+	}
+	if m.phase != phaseDuel && m.phase != phaseHeroDuel && m.phase != phaseHeroVsHero {
+		return nil
+	}
+	m.stepDuel()
+	if m.duelStream == nil && len(m.duelFrames) == 0 {
+		return nil
+	}
+	return m.schedulePlayback()
+}
+
+// handlePlaybackKey applies the speed and pause controls shared by both
+// duel phases. handled is false for keys it doesn't own.
+func (m *Model) handlePlaybackKey(key string) (cmd tea.Cmd, handled bool) {
+	switch key {
+	case "+", "=":
+		if m.duelSpeed < len(duelSpeeds)-1 {
+			m.duelSpeed++
+			return m.restartPlayback(), true
+		}
+		return nil, true
+
+	case "-":
+		if m.duelSpeed > 0 {
+			m.duelSpeed--
+			return m.restartPlayback(), true
+		}
+		return nil, true
+
+	case " ":
+		m.duelPaused = !m.duelPaused
+		return m.restartPlayback(), true
+
+	case ".":
+		if m.duelPaused {
+			m.stepDuel()
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// playbackLabel describes the playback state for the duel header.
+func (m *Model) playbackLabel() string {
+	label := formatSpeed(duelSpeeds[m.duelSpeed])
+	if m.duelPaused {
+		label += " paused"
+		if n := len(m.duelFrames); n > 0 {
+			label += fmt.Sprintf(" (%d buffered)", n)
+		}
+	}
+	return label
+}
+
+// formatSpeed renders a multiplier as "0.25x", "1x", "4x".
+func formatSpeed(s float64) string {
+	return fmt.Sprintf("%gx", s)
+}
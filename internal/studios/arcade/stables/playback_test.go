@@ -0,0 +1,48 @@
+package stables
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/studios/arcade/snake_duel"
+)
+
+func TestDuelPlayback(t *testing.T) {
+	m := &Model{phase: phaseDuel, duelSpeed: defaultDuelSpeed}
+	for i := 1; i <= 3; i++ {
+		m.queueDuelFrame(snake_duel.GameState{Tick: i})
+	}
+
+	if got := m.duelInterval(); got != duelTickMs*time.Millisecond {
+		t.Errorf("1x interval = %v", got)
+	}
+	m.handlePlaybackKey("+")
+	if got := m.duelInterval(); got != duelTickMs*time.Millisecond/2 {
+		t.Errorf("2x interval = %v", got)
+	}
+
+	// A tick from the loop replaced by the speed change is ignored.
+	m.handlePlaybackTick(duelPlaybackTickMsg{seq: m.duelTickSeq - 1})
+	if m.duelState.Tick != 0 {
+		t.Fatalf("stale tick advanced playback to T%d", m.duelState.Tick)
+	}
+	m.handlePlaybackTick(duelPlaybackTickMsg{seq: m.duelTickSeq})
+	if m.duelState.Tick != 1 {
+		t.Fatalf("tick showed T%d, want T1", m.duelState.Tick)
+	}
+
+	if cmd, _ := m.handlePlaybackKey(" "); cmd != nil || !m.duelPaused {
+		t.Fatal("space should pause without scheduling a tick")
+	}
+	m.handlePlaybackTick(duelPlaybackTickMsg{seq: m.duelTickSeq})
+	if m.duelState.Tick != 1 {
+		t.Error("paused playback advanced on a tick")
+	}
+	m.handlePlaybackKey(".")
+	if m.duelState.Tick != 2 {
+		t.Errorf("step showed T%d, want T2", m.duelState.Tick)
+	}
+	if got := m.playbackLabel(); got != "2x paused (1 buffered)" {
+		t.Errorf("playbackLabel = %q", got)
+	}
+}
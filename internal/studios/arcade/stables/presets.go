@@ -0,0 +1,100 @@
+package stables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hecate-social/hecate-tui/internal/config"
+)
+
+// fitnessPreset is a named set of fitness weights (survival, food, win,
+// draw, kill, proximity, circle).
+type fitnessPreset struct {
+	name    string
+	weights [7]float64
+	custom  bool // saved by the user rather than built in
+}
+
+// builtinPresets are the presets the daemon knows by name.
+var builtinPresets = []fitnessPreset{
+	{name: "Balanced", weights: [7]float64{0.1, 50.0, 200.0, 50.0, 100.0, 0.5, -0.2}},
+	{name: "Aggressive", weights: [7]float64{0.1, 20.0, 400.0, 50.0, 250.0, 0.5, -0.2}},
+	{name: "Forager", weights: [7]float64{0.1, 150.0, 50.0, 50.0, 100.0, 3.0, -0.2}},
+	{name: "Survivor", weights: [7]float64{0.8, 50.0, 200.0, 50.0, 20.0, 0.5, -1.5}},
+	{name: "Assassin", weights: [7]float64{0.1, 0.0, 500.0, 0.0, 300.0, 0.0, 0.0}},
+}
+
+// weightBounds is the allowed range of each fitness weight.
+var weightBounds = [7][2]float64{
+	{0.0, 1.0}, {0.0, 200.0}, {0.0, 500.0}, {0.0, 200.0},
+	{0.0, 300.0}, {0.0, 5.0}, {-2.0, 0.0},
+}
+
+// presets returns the built-in presets followed by the user's saved ones.
+func (m *Model) presets() []fitnessPreset {
+	all := append([]fitnessPreset(nil), builtinPresets...)
+	for _, p := range m.savedPresets {
+		fp := fitnessPreset{name: p.Name, custom: true}
+		copy(fp.weights[:], p.Weights)
+		all = append(all, fp)
+	}
+	return all
+}
+
+// currentPreset returns the selected preset, falling back to Balanced if
+// the saved presets changed underneath the index.
+func (m *Model) currentPreset() fitnessPreset {
+	all := m.presets()
+	if m.formPreset < 0 || m.formPreset >= len(all) {
+		return all[0]
+	}
+	return all[m.formPreset]
+}
+
+// weightsModified reports whether the form weights differ from the
+// selected preset.
+func (m *Model) weightsModified() bool {
+	return m.formWeights != m.currentPreset().weights
+}
+
+// cyclePreset selects the next preset and loads its weights.
+func (m *Model) cyclePreset() {
+	all := m.presets()
+	m.formPreset = (m.formPreset + 1) % len(all)
+	m.formWeights = all[m.formPreset].weights
+}
+
+// adjustWeight moves the focused weight by a twentieth of its range.
+func (m *Model) adjustWeight(dir int) {
+	i := m.formWeightFocus
+	lo, hi := weightBounds[i][0], weightBounds[i][1]
+	v := m.formWeights[i] + float64(dir)*(hi-lo)/20
+	if v < lo {
+		v = lo
+	}
+	if v > hi {
+		v = hi
+	}
+	m.formWeights[i] = v
+}
+
+// savePreset stores the form weights under name and selects the result.
+func (m *Model) savePreset(name string) error {
+	name = strings.TrimSpace(name)
+	for _, p := range builtinPresets {
+		if strings.EqualFold(p.name, name) {
+			return fmt.Errorf("%q is a built-in preset; pick another name", p.name)
+		}
+	}
+	if err := config.SaveFitnessPreset(config.FitnessPreset{Name: name, Weights: m.formWeights[:]}); err != nil {
+		return err
+	}
+	m.savedPresets = config.LoadFitnessPresets()
+	for i, p := range m.presets() {
+		if p.custom && strings.EqualFold(p.name, name) {
+			m.formPreset = i
+			break
+		}
+	}
+	return nil
+}
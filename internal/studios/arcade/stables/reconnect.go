@@ -0,0 +1,95 @@
+package stables
+
+import (
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Training stream reconnect backoff: 1s, 2s, 4s... capped.
+const (
+	trainingRetryBase = time.Second
+	trainingRetryMax  = 15 * time.Second
+)
+
+// trainingReconnectMsg re-subscribes to the training stream of stableID.
+type trainingReconnectMsg struct{ stableID string }
+
+// trainingRetryDelay is the wait before the given reconnect attempt.
+func trainingRetryDelay(attempt int) time.Duration {
+	d := trainingRetryBase
+	for i := 1; i < attempt && d < trainingRetryMax; i++ {
+		d *= 2
+	}
+	if d > trainingRetryMax {
+		d = trainingRetryMax
+	}
+	return d
+}
+
+// handleTrainingDisconnect is called when the training stream closes. A
+// stream that ends while the stable is still training has dropped, so
+// re-check the stable and schedule a reconnect.
+func (m *Model) handleTrainingDisconnect() tea.Cmd {
+	m.closeTrainingStream()
+	if m.phase != phaseDetail || m.selectedStable.Status != "training" {
+		return m.refreshDetail()
+	}
+
+	m.trainingRetries++
+	stableID := m.selectedStable.StableID
+	return tea.Batch(
+		m.refreshDetail(),
+		tea.Tick(trainingRetryDelay(m.trainingRetries), func(_ time.Time) tea.Msg {
+			return trainingReconnectMsg{stableID: stableID}
+		}),
+	)
+}
+
+// reconnectTraining opens a fresh training stream if the stable is still
+// on screen and training.
+func (m *Model) reconnectTraining(msg trainingReconnectMsg) tea.Cmd {
+	if m.phase != phaseDetail || m.trainingStream != nil ||
+		m.selectedStable.StableID != msg.stableID || m.selectedStable.Status != "training" {
+		m.trainingRetries = 0
+		return nil
+	}
+	m.trainingStream = NewTrainingStream(m.ctx.Client)
+	return m.trainingStream.Connect(msg.stableID)
+}
+
+// recordProgress appends a live progress frame to the generation history
+// so the fitness chart keeps growing between fetches.
+func (m *Model) recordProgress(p TrainingProgress) {
+	if n := len(m.generations); n > 0 && m.generations[n-1].Generation >= p.Generation {
+		return
+	}
+	m.generations = append(m.generations, GenerationStats{
+		StableID:     p.StableID,
+		Generation:   p.Generation,
+		BestFitness:  p.BestFitness,
+		AvgFitness:   p.AvgFitness,
+		WorstFitness: p.WorstFitness,
+		Timestamp:    time.Now().UnixMilli(),
+	})
+}
+
+// mergeGenerations combines fetched history with what was already seen,
+// preferring fetched entries, so a short or failed fetch after a reconnect
+// doesn't reset the chart.
+func mergeGenerations(have, fetched []GenerationStats) []GenerationStats {
+	byGen := make(map[int]GenerationStats, len(have)+len(fetched))
+	for _, g := range have {
+		byGen[g.Generation] = g
+	}
+	for _, g := range fetched {
+		byGen[g.Generation] = g
+	}
+	merged := make([]GenerationStats, 0, len(byGen))
+	for _, g := range byGen {
+		merged = append(merged, g)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Generation < merged[j].Generation })
+	return merged
+}
@@ -0,0 +1,35 @@
+package stables
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTrainingRetryDelay(t *testing.T) {
+	tests := map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		4:  8 * time.Second,
+		10: trainingRetryMax,
+	}
+	for attempt, want := range tests {
+		if got := trainingRetryDelay(attempt); got != want {
+			t.Errorf("trainingRetryDelay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestGenerationHistorySurvivesReconnect(t *testing.T) {
+	m := &Model{generations: []GenerationStats{{Generation: 1}, {Generation: 2}}}
+	m.recordProgress(TrainingProgress{Generation: 3, BestFitness: 9})
+	m.recordProgress(TrainingProgress{Generation: 3}) // duplicate frame
+	if len(m.generations) != 3 {
+		t.Fatalf("got %d generations, want 3", len(m.generations))
+	}
+
+	// A fetch that lags behind the stream must not drop live entries.
+	m.generations = mergeGenerations(m.generations, []GenerationStats{{Generation: 1, BestFitness: 4}})
+	if len(m.generations) != 3 || m.generations[0].BestFitness != 4 || m.generations[2].BestFitness != 9 {
+		t.Errorf("merged history = %+v", m.generations)
+	}
+}
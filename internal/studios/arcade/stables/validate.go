@@ -0,0 +1,43 @@
+package stables
+
+import "fmt"
+
+// formFieldBounds are the allowed ranges of the new stable form fields
+// (population, max generations, opponent AF, episodes per eval).
+var formFieldBounds = [4][2]int{{10, 500}, {10, 1000}, {0, 100}, {1, 20}}
+
+// formFieldSteps are the +/- increments of each form field.
+var formFieldSteps = [4]int{10, 10, 5, 1}
+
+// tuningBudget is the most tuning cost a stable may spend on weights.
+const tuningBudget = 100.0
+
+// validateStableForm checks the form before it is submitted. fieldErrs
+// holds a message for each offending field; err summarizes the first
+// problem found.
+func (m *Model) validateStableForm() (fieldErrs [4]string, err error) {
+	for i, v := range m.formFields {
+		lo, hi := formFieldBounds[i][0], formFieldBounds[i][1]
+		if v < lo || v > hi {
+			fieldErrs[i] = fmt.Sprintf("must be between %d and %d", lo, hi)
+			if err == nil {
+				err = fmt.Errorf("%s %s", m.formLabels[i], fieldErrs[i])
+			}
+		}
+	}
+	if err != nil {
+		return fieldErrs, err
+	}
+
+	for i, w := range m.formWeights {
+		lo, hi := weightBounds[i][0], weightBounds[i][1]
+		if w < lo || w > hi {
+			return fieldErrs, fmt.Errorf("%s weight %.1f is outside %.1f to %.1f", m.formWeightNames[i], w, lo, hi)
+		}
+	}
+
+	if cost := m.computeTuningCost(); cost > tuningBudget {
+		return fieldErrs, fmt.Errorf("tuning cost %.0f exceeds the budget of %.0f; lower the weights or pick another preset", cost, tuningBudget)
+	}
+	return fieldErrs, nil
+}
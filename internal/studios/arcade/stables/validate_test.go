@@ -0,0 +1,49 @@
+package stables
+
+import (
+	"strings"
+	"testing"
+)
+
+func newFormModel() *Model {
+	return &Model{
+		formFields:      [4]int{50, 100, 50, 3},
+		formLabels:      [4]string{"Population", "Max Generations", "Opponent AF", "Episodes/Eval"},
+		formWeights:     builtinPresets[0].weights,
+		formWeightNames: [7]string{"Survival", "Food", "Win Bonus", "Draw Bonus", "Kill Bonus", "Proximity", "Circle Penalty"},
+	}
+}
+
+func TestValidateStableForm(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(m *Model)
+		field   int // offending field, or -1
+		wantErr string
+	}{
+		{"defaults", func(m *Model) {}, -1, ""},
+		{"every built-in preset", func(m *Model) { m.formWeights = builtinPresets[4].weights }, -1, ""},
+		{"population too small", func(m *Model) { m.formFields[0] = 5 }, 0, "Population must be between 10 and 500"},
+		{"episodes too many", func(m *Model) { m.formFields[3] = 50 }, 3, "Episodes/Eval must be between 1 and 20"},
+		{"weight out of range", func(m *Model) { m.formWeights[6] = 1 }, -1, "Circle Penalty weight"},
+	}
+	for _, tt := range tests {
+		m := newFormModel()
+		tt.edit(m)
+		fieldErrs, err := m.validateStableForm()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.wantErr)
+		}
+		for i, fe := range fieldErrs {
+			if (fe != "") != (i == tt.field) {
+				t.Errorf("%s: field %d error = %q", tt.name, i, fe)
+			}
+		}
+	}
+}
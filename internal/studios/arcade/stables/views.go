@@ -2,21 +2,24 @@ package stables
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/studios/arcade/snake_duel"
 	"github.com/hecate-social/hecate-tui/internal/theme"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 )
 
 // Colors for stables UI.
 var (
-	colorTraining  = lipgloss.Color("#fbbf24") // amber
-	colorCompleted = lipgloss.Color("#34d399") // green
-	colorHalted    = lipgloss.Color("#f87171") // red
-	colorFitness   = lipgloss.Color("#60a5fa") // blue
-	colorChampion  = lipgloss.Color("#a78bfa") // purple
+	colorTraining   = lipgloss.Color("#fbbf24") // amber
+	colorCompleted  = lipgloss.Color("#34d399") // green
+	colorHalted     = lipgloss.Color("#f87171") // red
+	colorFitness    = lipgloss.Color("#60a5fa") // blue
+	colorChampion   = lipgloss.Color("#a78bfa") // purple
+	colorAvgFitness = lipgloss.Color("#2dd4bf") // teal
 )
 
 // view dispatches to the current phase view.
@@ -40,6 +43,10 @@ func (m *Model) view() string {
 		return m.viewHeroDetail()
 	case phasePromote:
 		return m.viewPromote()
+	case phaseSavePreset:
+		return m.viewSavePreset()
+	case phaseHeroVsHero:
+		return m.viewHeroVsHero()
 	case phaseHeroDuel:
 		return m.viewHeroDuel()
 	default:
@@ -165,16 +172,23 @@ func (m *Model) viewNewStable() string {
 	var fields []string
 	for i, label := range m.formLabels {
 		focused := i == m.formFocused
-		fields = append(fields, m.renderFormField(t, label, m.formFields[i], focused))
+		fields = append(fields, m.renderFormField(t, label, m.formFields[i], focused, m.formErrs[i]))
 	}
 	form := strings.Join(fields, "\n")
 
 	// Preset selector
-	presetNames := []string{"Balanced", "Aggressive", "Forager", "Survivor", "Assassin"}
+	preset := m.currentPreset()
+	presetLabel := "Preset: " + preset.name
+	if preset.custom {
+		presetLabel += " (saved)"
+	}
+	if m.weightsModified() {
+		presetLabel += " *"
+	}
 	presetLine := lipgloss.NewStyle().Foreground(colorChampion).Bold(true).
-		Render("Preset: " + presetNames[m.formPreset])
+		Render(presetLabel)
 	presetHint := lipgloss.NewStyle().Foreground(t.TextMuted).
-		Render("  (p to cycle)")
+		Render(fmt.Sprintf("  (p to cycle %d, S to save)", len(m.presets())))
 
 	// Budget bar
 	budgetLine := m.renderBudgetBar(t)
@@ -190,7 +204,7 @@ func (m *Model) viewNewStable() string {
 
 	hints := lipgloss.NewStyle().
 		Foreground(t.TextMuted).Italic(true).
-		Render("Tab:next field  +/-:adjust  Enter:create  esc:cancel")
+		Render("Tab:next field  +/-:adjust  p:preset  w:weights  S:save preset  Enter:create  esc:cancel")
 
 	parts := title + "\n" + subtitle + "\n\n" + form
 	if errStr != "" {
@@ -202,7 +216,7 @@ func (m *Model) viewNewStable() string {
 }
 
 // renderFormField renders a single form field.
-func (m *Model) renderFormField(t *theme.Theme, label string, value int, focused bool) string {
+func (m *Model) renderFormField(t *theme.Theme, label string, value int, focused bool, fieldErr string) string {
 	labelStyle := lipgloss.NewStyle().Foreground(t.TextDim).Width(20)
 	valueStyle := lipgloss.NewStyle().Foreground(t.Text).Bold(true)
 
@@ -216,7 +230,11 @@ func (m *Model) renderFormField(t *theme.Theme, label string, value int, focused
 		indicator = "> "
 	}
 
-	return indicator + labelStyle.Render(label+":") + " " + valueStyle.Render(fmt.Sprintf("%d", value))
+	line := indicator + labelStyle.Render(label+":") + " " + valueStyle.Render(fmt.Sprintf("%d", value))
+	if fieldErr != "" {
+		line += "  " + lipgloss.NewStyle().Foreground(colorHalted).Render(fieldErr)
+	}
+	return line
 }
 
 // viewDetail renders the stable detail / training monitor.
@@ -250,6 +268,8 @@ func (m *Model) viewDetail() string {
 	// Training progress (live SSE)
 	if m.lastProgress != nil && m.selectedStable.Status == "training" {
 		sections = append(sections, "", m.renderTrainingProgress(t))
+	} else if m.trainingRetries > 0 {
+		sections = append(sections, "", m.renderReconnecting())
 	}
 
 	// Fitness chart (text-based sparkline from generation history)
@@ -296,84 +316,137 @@ func (m *Model) renderTrainingProgress(t *theme.Theme) string {
 		Render(fmt.Sprintf("Generation %d / %d", p.Generation, m.selectedStable.MaxGenerations))
 
 	bar := renderProgressBar(p.Generation, m.selectedStable.MaxGenerations, 30, t)
+	etaText := "ETA estimating…"
+	if eta, ok := trainingETA(m.selectedStable.StartedAt, p.Generation, m.selectedStable.MaxGenerations, time.Now()); ok {
+		etaText = "ETA " + formatDuration(eta)
+	}
+	bar += "  " + lipgloss.NewStyle().Foreground(t.TextDim).Render(etaText)
 
 	fitnessLine := lipgloss.NewStyle().Foreground(colorFitness).
 		Render(fmt.Sprintf("Best: %.2f  Avg: %.2f  Worst: %.2f",
 			p.BestFitness, p.AvgFitness, p.WorstFitness))
 
+	if m.trainingRetries > 0 {
+		genInfo += "  " + m.renderReconnecting()
+	}
+
 	return genInfo + "\n" + bar + "\n" + fitnessLine
 }
 
+// renderReconnecting marks a dropped training stream being retried.
+func (m *Model) renderReconnecting() string {
+	return lipgloss.NewStyle().Foreground(colorHalted).Italic(true).
+		Render(fmt.Sprintf("reconnecting… (attempt %d)", m.trainingRetries))
+}
+
 // renderProgressBar renders a text progress bar.
 func renderProgressBar(current, total, width int, t *theme.Theme) string {
-	if total == 0 {
-		total = 1
+	return ui.ProgressBar(current, total, width, colorTraining, t.Border)
+}
+
+// sparkBlocks are the sparkline levels from lowest to highest.
+var sparkBlocks = []string{"_", ".", "-", "~", "=", "+", "#", "@"}
+
+// renderFitnessChart renders best fitness over generations as a text
+// sparkline, with average fitness as a second series when the daemon
+// reports it. The y-axis shows the shared range and the x-axis the
+// generations plotted.
+func (m *Model) renderFitnessChart(t *theme.Theme) string {
+	// Take last N generations that fit in width
+	maxWidth := 50
+	gens := m.generations
+	if len(gens) > maxWidth {
+		gens = gens[len(gens)-maxWidth:]
 	}
-	filled := (current * width) / total
-	if filled > width {
-		filled = width
+
+	best := make([]float64, len(gens))
+	avg := make([]float64, len(gens))
+	hasAvg := false
+	for i, g := range gens {
+		best[i] = g.BestFitness
+		avg[i] = g.AvgFitness
+		if g.AvgFitness != 0 {
+			hasAvg = true
+		}
 	}
-	empty := width - filled
 
-	bar := lipgloss.NewStyle().Foreground(colorTraining).
-		Render(strings.Repeat("=", filled))
-	bar += lipgloss.NewStyle().Foreground(t.Border).
-		Render(strings.Repeat("-", empty))
+	// Find min/max for normalization across every plotted series
+	minF, maxF := best[0], best[0]
+	series := best
+	if hasAvg {
+		series = append(append([]float64(nil), best...), avg...)
+	}
+	for _, v := range series {
+		minF = math.Min(minF, v)
+		maxF = math.Max(maxF, v)
+	}
 
-	pct := (current * 100) / total
-	return fmt.Sprintf("[%s] %d%%", bar, pct)
-}
+	bestStyle := lipgloss.NewStyle().Foreground(colorFitness)
+	avgStyle := lipgloss.NewStyle().Foreground(colorAvgFitness)
+	axisStyle := lipgloss.NewStyle().Foreground(t.TextDim)
 
-// renderFitnessChart renders a simple text sparkline of best fitness over generations.
-func (m *Model) renderFitnessChart(t *theme.Theme) string {
 	label := lipgloss.NewStyle().Foreground(t.TextDim).Bold(true).
-		Render("Fitness History")
+		Render("Fitness History") + "  " + bestStyle.Render("━ best")
+	if hasAvg {
+		label += "  " + avgStyle.Render("━ avg")
+	}
 
-	blocks := []string{"_", ".", "-", "~", "=", "+", "#", "@"}
+	// y-axis tick labels share a right-aligned column
+	top := fmt.Sprintf("%.1f", maxF)
+	bottom := fmt.Sprintf("%.1f", minF)
+	labelWidth := max(len(top), len(bottom))
+	pad := strings.Repeat(" ", labelWidth)
 
-	// Find min/max for normalization
-	minF, maxF := m.generations[0].BestFitness, m.generations[0].BestFitness
-	for _, g := range m.generations {
-		if g.BestFitness < minF {
-			minF = g.BestFitness
-		}
-		if g.BestFitness > maxF {
-			maxF = g.BestFitness
-		}
+	lines := []string{
+		label,
+		axisStyle.Render(fmt.Sprintf("%*s ┬", labelWidth, top)),
+		axisStyle.Render(pad+" │ ") + bestStyle.Render(sparkline(best, minF, maxF)),
 	}
+	if hasAvg {
+		lines = append(lines, axisStyle.Render(pad+" │ ")+avgStyle.Render(sparkline(avg, minF, maxF)))
+	}
+	lines = append(lines,
+		axisStyle.Render(fmt.Sprintf("%*s ┴%s", labelWidth, bottom, strings.Repeat("─", len(gens)+1))),
+		axisStyle.Render(pad+"  "+generationAxis(gens[0].Generation, gens[len(gens)-1].Generation, len(gens))),
+	)
+	return strings.Join(lines, "\n")
+}
 
+// sparkline maps values onto sparkBlocks within [minF, maxF].
+func sparkline(values []float64, minF, maxF float64) string {
 	rangeF := maxF - minF
 	if rangeF < 0.01 {
 		rangeF = 1.0
 	}
 
-	// Take last N generations that fit in width
-	maxWidth := 50
-	gens := m.generations
-	if len(gens) > maxWidth {
-		gens = gens[len(gens)-maxWidth:]
-	}
-
-	var sparkline strings.Builder
-	for _, g := range gens {
-		normalized := (g.BestFitness - minF) / rangeF
-		idx := int(normalized * float64(len(blocks)-1))
-		if idx >= len(blocks) {
-			idx = len(blocks) - 1
+	var b strings.Builder
+	for _, v := range values {
+		normalized := (v - minF) / rangeF
+		idx := int(normalized * float64(len(sparkBlocks)-1))
+		if idx >= len(sparkBlocks) {
+			idx = len(sparkBlocks) - 1
 		}
 		if idx < 0 {
 			idx = 0
 		}
-		sparkline.WriteString(blocks[idx])
+		b.WriteString(sparkBlocks[idx])
 	}
+	return b.String()
+}
 
-	chart := lipgloss.NewStyle().Foreground(colorFitness).
-		Render(sparkline.String())
-
-	rangeInfo := lipgloss.NewStyle().Foreground(t.TextDim).
-		Render(fmt.Sprintf("  [%.1f - %.1f]", minF, maxF))
-
-	return label + "\n" + chart + rangeInfo
+// generationAxis labels the first and last plotted generation, spread
+// across width columns.
+func generationAxis(first, last, width int) string {
+	left := fmt.Sprintf("gen %d", first)
+	if first == last {
+		return left
+	}
+	right := fmt.Sprintf("%d", last)
+	gap := width - len(left) - len(right)
+	if gap < 1 {
+		gap = 1
+	}
+	return left + strings.Repeat(" ", gap) + right
 }
 
 // renderChampionCard shows champion info.
@@ -407,6 +480,7 @@ func (m *Model) viewDuel() string {
 
 	header := lipgloss.NewStyle().Foreground(t.Primary).Bold(true).
 		Render("Champion Duel")
+	header += "  " + lipgloss.NewStyle().Foreground(colorTraining).Render(m.playbackLabel())
 
 	stableInfo := lipgloss.NewStyle().Foreground(colorChampion).
 		Render("Stable: " + truncateID(m.selectedStable.StableID))
@@ -438,7 +512,7 @@ func (m *Model) viewDuel() string {
 	}
 
 	hints := lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
-		Render("esc:back to detail")
+		Render("+/-:speed  space:pause  .:step  esc:back to detail")
 	if m.duelState.Status == "finished" {
 		hints = lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
 			Render("n:new duel  esc:back to detail")
@@ -477,7 +551,7 @@ func (m *Model) renderDuelResult(t *theme.Theme) string {
 // renderBudgetBar shows the tuning cost budget usage.
 func (m *Model) renderBudgetBar(t *theme.Theme) string {
 	cost := m.computeTuningCost()
-	budget := 100.0
+	budget := tuningBudget
 	used := int(cost * 30 / budget)
 	if used > 30 {
 		used = 30
@@ -508,7 +582,7 @@ func (m *Model) renderBudgetBar(t *theme.Theme) string {
 // renderWeightsSection shows the advanced weights editor.
 func (m *Model) renderWeightsSection(t *theme.Theme) string {
 	title := lipgloss.NewStyle().Foreground(t.TextDim).Bold(true).
-		Render("Advanced Weights (w to hide)")
+		Render("Advanced Weights (j/k select, h/l adjust, w to hide)")
 
 	var fields []string
 	for i, name := range m.formWeightNames {
@@ -532,11 +606,8 @@ func (m *Model) renderWeightsSection(t *theme.Theme) string {
 
 // computeTuningCost calculates the tuning cost for current form weights.
 func (m *Model) computeTuningCost() float64 {
-	defaults := [7]float64{0.1, 50.0, 200.0, 50.0, 100.0, 0.5, -0.2}
-	bounds := [7][2]float64{
-		{0.0, 1.0}, {0.0, 200.0}, {0.0, 500.0}, {0.0, 200.0},
-		{0.0, 300.0}, {0.0, 5.0}, {-2.0, 0.0},
-	}
+	defaults := builtinPresets[0].weights
+	bounds := weightBounds
 	impacts := [7]float64{1.0, 2.0, 3.0, 1.5, 2.5, 1.0, 0.5}
 
 	var total float64
@@ -554,6 +625,10 @@ func (m *Model) computeTuningCost() float64 {
 	return total
 }
 
+// localStatsNote tells daemon-reported records apart from the ratings kept
+// on this machine, which only count hero-vs-hero duels played here.
+const localStatsNote = "W/L/D and Win% are from the daemon. Rank and Local Elo count only\nhero-vs-hero duels played on this machine."
+
 // viewHeroes renders the heroes list.
 func (m *Model) viewHeroes() string {
 	t := m.ctx.Theme
@@ -564,7 +639,7 @@ func (m *Model) viewHeroes() string {
 
 	subtitle := lipgloss.NewStyle().
 		Foreground(t.TextDim).
-		Render("Promoted champions for permanent competition")
+		Render("Promoted champions for permanent competition  ·  sorted by " + m.heroSort.String())
 
 	var content string
 	if len(m.heroes) == 0 {
@@ -574,8 +649,9 @@ func (m *Model) viewHeroes() string {
 	} else {
 		headerStyle := lipgloss.NewStyle().Foreground(t.TextDim).Bold(true)
 		header := headerStyle.Render(fmt.Sprintf(
-			"  %-16s %8s %6s %4s %4s %4s",
-			"Name", "Fitness", "Gen", "W", "L", "D"))
+			"  %4s %-16s %9s %8s %6s %4s %4s %4s %5s",
+			"Rank", "Name", "Local Elo", "Fitness", "Gen", "W", "L", "D", "Win%"))
+		ranks := m.ratingRanks()
 
 		var rows []string
 		rows = append(rows, header)
@@ -587,22 +663,34 @@ func (m *Model) viewHeroes() string {
 				style = style.Foreground(t.Primary).Bold(true)
 				indicator = ">"
 			}
+			if m.versusPick != nil && m.versusPick.HeroID == h.HeroID {
+				style = style.Foreground(colorChampion).Bold(true)
+				indicator = "*"
+			}
 			name := h.Name
 			if len(name) > 16 {
 				name = name[:14] + ".."
 			}
-			row := fmt.Sprintf("%s %-16s %8.1f %6d %4d %4d %4d",
-				indicator, name, h.Fitness, h.Generation, h.Wins, h.Losses, h.Draws)
+			row := fmt.Sprintf("%s %4s %-16s %9.0f %8.1f %6d %4d %4d %4d %4.0f%%",
+				indicator, fmt.Sprintf("#%d", ranks[h.HeroID]), name, m.rating(h.HeroID),
+				h.Fitness, h.Generation, h.Wins, h.Losses, h.Draws, winRate(h)*100)
 			rows = append(rows, style.Render(row))
 		}
+		rows = append(rows, "", lipgloss.NewStyle().Foreground(t.TextMuted).Render(localStatsNote))
 		content = strings.Join(rows, "\n")
 	}
 
 	errStr := m.renderError(t)
 
+	hintText := "j/k:navigate  Enter:view  v:hero vs hero  s:sort  r:refresh  esc:back to stables"
+	if m.versusPick != nil {
+		subtitle += "\n" + lipgloss.NewStyle().Foreground(colorChampion).
+			Render("Pick an opponent for "+m.versusPick.Name)
+		hintText = "j/k:navigate  v:pick opponent  esc:cancel pick"
+	}
 	hints := lipgloss.NewStyle().
 		Foreground(t.TextMuted).Italic(true).
-		Render("j/k:navigate  Enter:view  r:refresh  esc:back to stables")
+		Render(hintText)
 
 	parts := title + "\n" + subtitle + "\n\n" + content
 	if errStr != "" {
@@ -634,7 +722,7 @@ func (m *Model) viewHeroDetail() string {
 		Render(fmt.Sprintf("Generation: %d  Origin: %s", h.Generation, truncateID(h.OriginStableID)))
 
 	record := lipgloss.NewStyle().Foreground(t.Text).
-		Render(fmt.Sprintf("W:%d  L:%d  D:%d", h.Wins, h.Losses, h.Draws))
+		Render(fmt.Sprintf("Daemon W:%d  L:%d  D:%d", h.Wins, h.Losses, h.Draws))
 
 	cardStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -642,7 +730,11 @@ func (m *Model) viewHeroDetail() string {
 		Padding(0, 1).
 		Width(40)
 
-	card := cardStyle.Render(title + "\n" + fitness + "  " + gen + "\n" + record)
+	rating := lipgloss.NewStyle().Foreground(colorChampion).
+		Render(fmt.Sprintf("Local Elo: %.0f", m.rating(h.HeroID)))
+
+	card := cardStyle.Render(title + "\n" + fitness + "  " + gen + "\n" + record + "\n" + rating +
+		m.renderHeadToHead(t, h.HeroID))
 
 	hints := lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
 		Render("d:duel vs AI  esc:back to heroes")
@@ -693,6 +785,41 @@ func (m *Model) viewPromote() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, parts)
 }
 
+// viewSavePreset renders the name prompt for saving the form weights.
+func (m *Model) viewSavePreset() string {
+	t := m.ctx.Theme
+
+	title := lipgloss.NewStyle().Foreground(colorChampion).Bold(true).
+		Render("Save Fitness Preset")
+
+	subtitle := m.renderBudgetBar(t)
+
+	nameLabel := lipgloss.NewStyle().Foreground(t.Primary).
+		Render("Preset Name: ")
+
+	nameVal := m.presetName
+	if nameVal == "" {
+		nameVal = lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).Render("type a name...")
+	} else {
+		nameVal = lipgloss.NewStyle().Foreground(t.Text).Bold(true).Render(nameVal)
+	}
+
+	cursor := lipgloss.NewStyle().Foreground(t.Primary).Render("_")
+
+	errStr := m.renderError(t)
+
+	hints := lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
+		Render("Enter:save  esc:cancel  (an existing name is overwritten)")
+
+	parts := title + "\n" + subtitle + "\n\n" + m.renderWeightsSection(t) + "\n\n" + nameLabel + nameVal + cursor
+	if errStr != "" {
+		parts += "\n\n" + errStr
+	}
+	parts += "\n\n" + hints
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, parts)
+}
+
 // viewHeroDuel renders a hero duel using the same duel renderer.
 func (m *Model) viewHeroDuel() string {
 	t := m.ctx.Theme
@@ -704,6 +831,7 @@ func (m *Model) viewHeroDuel() string {
 
 	header := lipgloss.NewStyle().Foreground(t.Primary).Bold(true).
 		Render("Hero Duel")
+	header += "  " + lipgloss.NewStyle().Foreground(colorTraining).Render(m.playbackLabel())
 
 	heroInfo := lipgloss.NewStyle().Foreground(colorChampion).
 		Render("Hero: " + heroName)
@@ -735,7 +863,7 @@ func (m *Model) viewHeroDuel() string {
 	}
 
 	hints := lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
-		Render("esc:back to hero")
+		Render("+/-:speed  space:pause  .:step  esc:back to hero")
 	if m.duelState.Status == "finished" {
 		hints = lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
 			Render("n:new duel  esc:back to hero")
@@ -754,6 +882,107 @@ func (m *Model) viewHeroDuel() string {
 	return parts
 }
 
+// renderHeadToHead lists the hero's record against each opponent it has
+// met in a hero-vs-hero duel.
+func (m *Model) renderHeadToHead(t *theme.Theme, heroID string) string {
+	records := headToHeadRecords(m.heroDuels, heroID)
+	if len(records) == 0 {
+		return ""
+	}
+	names := make(map[string]string, len(m.heroes))
+	for _, h := range m.heroes {
+		names[h.HeroID] = h.Name
+	}
+
+	lines := []string{"", lipgloss.NewStyle().Foreground(t.TextDim).Bold(true).Render("Head to head (this machine)")}
+	for _, r := range records {
+		name := names[r.opponentID]
+		if name == "" {
+			name = truncateID(r.opponentID)
+		}
+		lines = append(lines, lipgloss.NewStyle().Foreground(t.Text).
+			Render(fmt.Sprintf("  vs %-16s W:%d  L:%d  D:%d", name, r.wins, r.losses, r.draws)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// viewHeroVsHero renders a duel between two heroes.
+func (m *Model) viewHeroVsHero() string {
+	t := m.ctx.Theme
+	h1, h2 := m.versusHeroes[0], m.versusHeroes[1]
+
+	header := lipgloss.NewStyle().Foreground(t.Primary).Bold(true).
+		Render("Hero vs Hero")
+	header += "  " + lipgloss.NewStyle().Foreground(colorTraining).Render(m.playbackLabel())
+
+	sep := "  "
+
+	matchup := lipgloss.NewStyle().Foreground(lipgloss.Color("#60a5fa")).Bold(true).Render(h1.Name) +
+		lipgloss.NewStyle().Foreground(t.TextDim).Render(" vs ") +
+		lipgloss.NewStyle().Foreground(lipgloss.Color("#f87171")).Bold(true).Render(h2.Name)
+
+	var statusStr string
+	switch m.duelState.Status {
+	case "finished":
+		statusStr = renderVersusResult(t, m.duelState.Winner, h1.Name, h2.Name)
+	case "":
+		statusStr = lipgloss.NewStyle().Foreground(t.TextDim).Italic(true).
+			Render("Starting duel...")
+	}
+
+	grid := snake_duel.RenderGrid(m.duelState)
+
+	var scoreStr string
+	if m.duelState.Status != "" {
+		p1 := lipgloss.NewStyle().Foreground(lipgloss.Color("#60a5fa")).Bold(true).
+			Render(fmt.Sprintf("%s:%d", h1.Name, m.duelState.Snake1.Score))
+		p2 := lipgloss.NewStyle().Foreground(lipgloss.Color("#f87171")).Bold(true).
+			Render(fmt.Sprintf("%s:%d", h2.Name, m.duelState.Snake2.Score))
+		tick := lipgloss.NewStyle().Foreground(t.TextMuted).
+			Render(fmt.Sprintf("T%d", m.duelState.Tick))
+		scoreStr = p1 + sep + p2 + sep + tick
+	}
+
+	hints := lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
+		Render("+/-:speed  space:pause  .:step  esc:back to heroes")
+	if m.duelState.Status == "finished" {
+		hints = lipgloss.NewStyle().Foreground(t.TextMuted).Italic(true).
+			Render("n:rematch  esc:back to heroes")
+	}
+
+	parts := header + sep + matchup + "\n"
+	if scoreStr != "" {
+		parts += scoreStr + "\n"
+	}
+	parts += grid + "\n"
+	if statusStr != "" {
+		parts += statusStr + "\n"
+	}
+	if errStr := m.renderError(t); errStr != "" {
+		parts += errStr + "\n"
+	}
+	parts += hints
+
+	return parts
+}
+
+// renderVersusResult names the winning hero of a hero-vs-hero duel.
+func renderVersusResult(t *theme.Theme, winner, name1, name2 string) string {
+	switch winner {
+	case "player1":
+		return lipgloss.NewStyle().Foreground(colorCompleted).Bold(true).
+			Render(name1 + " Wins!")
+	case "player2":
+		return lipgloss.NewStyle().Foreground(colorCompleted).Bold(true).
+			Render(name2 + " Wins!")
+	case "draw":
+		return lipgloss.NewStyle().Foreground(colorTraining).Bold(true).
+			Render("Draw!")
+	default:
+		return lipgloss.NewStyle().Foreground(t.TextDim).Render("Game Over")
+	}
+}
+
 // renderError renders an error message if present.
 func (m *Model) renderError(t *theme.Theme) string {
 	if m.err == nil {
@@ -777,7 +1006,29 @@ func formatDuration(d time.Duration) string {
 		return fmt.Sprintf("%.0fs", d.Seconds())
 	}
 	if d < time.Hour {
-		return fmt.Sprintf("%.0fm%.0fs", d.Minutes(), d.Seconds()-d.Minutes()*60)
+		return fmt.Sprintf("%dm%ds", int(d/time.Minute), int(d%time.Minute/time.Second))
+	}
+	return fmt.Sprintf("%dh%dm", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// minETAGenerations is how many generations must finish before an ETA is
+// shown; the first ones include startup cost and are a poor estimate.
+const minETAGenerations = 2
+
+// trainingETA estimates the time left from the average time per
+// generation so far. ok is false when there is too little data.
+func trainingETA(startedAt int64, generation, maxGenerations int, now time.Time) (eta time.Duration, ok bool) {
+	if startedAt <= 0 || generation < minETAGenerations {
+		return 0, false
+	}
+	elapsed := now.Sub(time.UnixMilli(startedAt))
+	if elapsed <= 0 {
+		return 0, false
+	}
+	remaining := maxGenerations - generation
+	if remaining <= 0 {
+		return 0, true
 	}
-	return fmt.Sprintf("%.0fh%.0fm", d.Hours(), d.Minutes()-d.Hours()*60)
+	perGen := elapsed / time.Duration(generation)
+	return perGen * time.Duration(remaining), true
 }
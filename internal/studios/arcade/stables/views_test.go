@@ -0,0 +1,82 @@
+package stables
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hecate-social/hecate-tui/internal/studio"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestTrainingETA(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start.Add(10 * time.Minute)
+
+	tests := []struct {
+		name      string
+		started   int64
+		gen, max  int
+		want      time.Duration
+		wantKnown bool
+	}{
+		{"no start time", 0, 5, 10, 0, false},
+		{"too few generations", start.UnixMilli(), 1, 10, 0, false},
+		{"halfway", start.UnixMilli(), 5, 10, 10 * time.Minute, true},
+		{"quarter", start.UnixMilli(), 20, 100, 40 * time.Minute, true},
+		{"finished", start.UnixMilli(), 10, 10, 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := trainingETA(tt.started, tt.gen, tt.max, now)
+		if ok != tt.wantKnown || got != tt.want {
+			t.Errorf("%s: trainingETA = %v, %v; want %v, %v", tt.name, got, ok, tt.want, tt.wantKnown)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		45 * time.Second: "45s",
+		90 * time.Second: "1m30s",
+		time.Hour + 59*time.Minute + 59*time.Second: "1h59m",
+	}
+	for d, want := range tests {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]float64{0, 50, 100}, 0, 100); got != "_~@" {
+		t.Errorf("sparkline = %q, want %q", got, "_~@")
+	}
+	// A flat series must not divide by zero.
+	if got := sparkline([]float64{5, 5}, 5, 5); got != "__" {
+		t.Errorf("flat sparkline = %q", got)
+	}
+}
+
+func TestGenerationAxis(t *testing.T) {
+	if got := generationAxis(1, 20, 12); got != "gen 1     20" {
+		t.Errorf("generationAxis = %q", got)
+	}
+	if got := generationAxis(3, 3, 2); got != "gen 3" {
+		t.Errorf("single generation axis = %q", got)
+	}
+}
+
+func TestViewHeroes_LabelsLocalStats(t *testing.T) {
+	m := &Model{
+		ctx:    &studio.Context{Theme: theme.HecateDark()},
+		width:  120,
+		height: 40,
+		heroes: []Hero{{HeroID: "a", Name: "Ada", Wins: 3}},
+	}
+	view := m.viewHeroes()
+	for _, want := range []string{"Local Elo", "are from the daemon", "played on this machine"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("heroes view missing %q", want)
+		}
+	}
+}
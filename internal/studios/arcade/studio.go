@@ -56,6 +56,7 @@ func New(ctx *studio.Context) *Studio {
 
 func (s *Studio) Name() string      { return "Arcade" }
 func (s *Studio) ShortName() string { return "Arcade" }
+func (s *Studio) Aliases() []string { return nil }
 func (s *Studio) Icon() string      { return "\U0001F3AE" }
 func (s *Studio) Focused() bool     { return s.focused }
 
@@ -75,6 +76,10 @@ func (s *Studio) SetSize(width, height int) {
 }
 
 func (s *Studio) Mode() modes.Mode {
+	// Name prompts need every key, including the shell's q and [ ].
+	if s.activeApp == "stables" && s.stables != nil && s.stables.TextEntry() {
+		return modes.Insert
+	}
 	return modes.Normal
 }
 
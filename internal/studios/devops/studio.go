@@ -64,6 +64,7 @@ func New(ctx *studio.Context) *Studio {
 
 func (s *Studio) Name() string      { return "DevOps" }
 func (s *Studio) ShortName() string { return "DevOps" }
+func (s *Studio) Aliases() []string { return []string{"dev"} }
 func (s *Studio) Icon() string      { return "\U0001F527" }
 func (s *Studio) Focused() bool     { return s.focused }
 
@@ -0,0 +1,58 @@
+package llm
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultAutosaveInterval is how often a streaming response is saved when
+// ui.autosave_interval is unset.
+const defaultAutosaveInterval = 30 * time.Second
+
+// autosaveTickMsg fires the periodic save of a response in flight. gen
+// ties it to the loop that scheduled it.
+type autosaveTickMsg struct{ gen int }
+
+// autosaveInterval returns the configured autosave period, or 0 when
+// autosave is turned off.
+func (s *Studio) autosaveInterval() time.Duration {
+	switch n := s.cfg.UI.AutosaveInterval; {
+	case n < 0:
+		return 0
+	case n == 0:
+		return defaultAutosaveInterval
+	default:
+		return time.Duration(n) * time.Second
+	}
+}
+
+// autosaveTick starts a fresh autosave loop. Init runs on every return to
+// the studio, and ticks are dropped while another studio is active, so
+// each start retires the previous loop rather than stacking on it.
+func (s *Studio) autosaveTick() tea.Cmd {
+	s.autosaveGen++
+	return s.scheduleAutosave()
+}
+
+// scheduleAutosave queues the next tick of the current loop.
+func (s *Studio) scheduleAutosave() tea.Cmd {
+	interval := s.autosaveInterval()
+	if interval == 0 {
+		return nil
+	}
+	gen := s.autosaveGen
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return autosaveTickMsg{gen: gen}
+	})
+}
+
+// autosave snapshots the conversation while a response streams, so a crash
+// loses at most one interval of it. Finished turns are saved as they
+// complete, and that save replaces the partial one.
+func (s *Studio) autosave() {
+	if !s.chat.IsStreaming() {
+		return
+	}
+	s.writeConversation(s.chat.PartialResponse())
+}
@@ -0,0 +1,87 @@
+package llm
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/ui"
+)
+
+// callFormID identifies the /call argument form in FormResult.
+const callFormID = "rpc_call"
+
+// showCallForm opens a form with one field per procedure parameter, or a
+// single JSON field when the procedure has no known schema.
+func (s *Studio) showCallForm(msg commands.CallFormMsg, values map[string]string) tea.Cmd {
+	s.pendingCall = &msg
+	return s.openForm(callFormSpec(msg, values))
+}
+
+// callFormSpec builds the form for msg, pre-filled with values from a
+// previous attempt.
+func callFormSpec(msg commands.CallFormMsg, values map[string]string) ui.FormSpec {
+	spec := ui.FormSpec{ID: callFormID, Title: "Call " + msg.Procedure}
+
+	if len(msg.Params) == 0 {
+		spec.Fields = []ui.FieldSpec{{
+			Key:         commands.CallArgsKey,
+			Label:       "Arguments (JSON)",
+			Description: "No input schema found; leave empty to call without args",
+			Placeholder: `{"msg": "hello"}`,
+			FieldType:   ui.FieldTextarea,
+			Default:     values[commands.CallArgsKey],
+		}}
+		return spec
+	}
+
+	for _, p := range msg.Params {
+		f := ui.FieldSpec{
+			Key:         p.Name,
+			Label:       p.Name,
+			Description: p.Description,
+			Placeholder: p.Type,
+			Required:    p.Required,
+			Default:     p.Default,
+		}
+		if p.Required {
+			f.Label += " *"
+		}
+		if v, ok := values[p.Name]; ok {
+			f.Default = v
+		}
+		switch {
+		case len(p.Enum) > 0:
+			f.FieldType = ui.FieldSelect
+			f.Options = p.Enum
+			if !p.Required {
+				f.Options = append([]string{""}, p.Enum...)
+			}
+		case p.Type == "boolean":
+			f.FieldType = ui.FieldSelect
+			f.Options = []string{"true", "false"}
+			if !p.Required {
+				f.Options = append([]string{""}, f.Options...)
+			}
+		case p.Type == "object" || p.Type == "array":
+			f.FieldType = ui.FieldTextarea
+		}
+		spec.Fields = append(spec.Fields, f)
+	}
+	return spec
+}
+
+// handleCallFormResult validates the submitted arguments and dispatches the
+// RPC, reopening the form when something is missing or malformed.
+func (s *Studio) handleCallFormResult(result ui.FormResult) tea.Cmd {
+	pending := s.pendingCall
+	s.pendingCall = nil
+	if pending == nil {
+		return nil
+	}
+
+	args, err := commands.BuildCallArgs(pending.Params, result.Values)
+	if err != nil {
+		s.chat.InjectSystemMessage(s.ctx.Styles.Error.Render("Cannot call " + pending.Procedure + ": " + err.Error()))
+		return s.showCallForm(*pending, result.Values)
+	}
+	return commands.CallProcedure(pending.Procedure, args, s.CommandContext())
+}
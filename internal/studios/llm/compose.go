@@ -0,0 +1,72 @@
+package llm
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/editor"
+	"github.com/hecate-social/hecate-tui/internal/modes"
+)
+
+// openCompose opens the full-screen compose overlay, seeded with whatever is
+// already in the chat input.
+func (s *Studio) openCompose() tea.Cmd {
+	s.composeView = editor.New()
+	s.composeView.SetTitle("Compose message", "Ctrl+S send | Ctrl+F find | Esc back to input")
+	s.composeView.SetContent(s.chat.InputValue())
+	s.composeView.SetSize(s.width, s.editorHeight())
+	s.composeView.Focus()
+	s.composeReady = true
+	s.setMode(modes.Compose)
+	return s.composeView.Init()
+}
+
+func (s *Studio) handleComposeKey(key string, msg tea.KeyMsg) tea.Cmd {
+	if !s.composeReady {
+		return nil
+	}
+
+	// An open prompt (find, ...) owns esc
+	if !s.composeView.PromptActive() {
+		switch key {
+		case "ctrl+s":
+			return s.sendCompose()
+		case "esc", "ctrl+q":
+			// Hand the draft back to the one-line input rather than drop it.
+			s.chat.SetInputValue(s.composeView.GetContent())
+			s.composeReady = false
+			s.setMode(modes.Insert)
+			return nil
+		}
+	}
+
+	model, cmd := s.composeView.Update(msg)
+	s.composeView = model.(editor.Model)
+	return cmd
+}
+
+// sendCompose sends the composed text as the user message.
+func (s *Studio) sendCompose() tea.Cmd {
+	content := strings.TrimSpace(s.composeView.GetContent())
+	if content == "" {
+		s.composeReady = false
+		s.setMode(modes.Normal)
+		return nil
+	}
+	if s.chat.IsStreaming() {
+		s.composeView.SetMessage("Wait for the current response to finish", true)
+		return nil
+	}
+
+	s.chat.SetInputValue(content)
+	s.recordPrompt(content)
+	cmd := s.chat.SendCurrentInput()
+	if cmd != nil {
+		s.chat.ClearError()
+		s.saveConversation()
+	}
+
+	s.composeReady = false
+	s.setMode(modes.Normal)
+	return cmd
+}
@@ -0,0 +1,68 @@
+package llm
+
+import "github.com/hecate-social/hecate-tui/internal/chat"
+
+// maxMsgHistory caps the Insert-mode prompt history.
+const maxMsgHistory = 100
+
+// resetMsgHistory seeds the prompt history from a conversation's user
+// messages, so Up recalls prompts from a loaded conversation too.
+func (s *Studio) resetMsgHistory(msgs []chat.Message) {
+	s.msgHistory = nil
+	for _, m := range msgs {
+		if m.Role == "user" {
+			s.recordPrompt(m.Content)
+		}
+	}
+	s.msgHistIdx = -1
+	s.msgDraft = ""
+}
+
+// recordPrompt appends a sent prompt, skipping immediate repeats.
+func (s *Studio) recordPrompt(content string) {
+	s.msgHistIdx = -1
+	s.msgDraft = ""
+	if content == "" {
+		return
+	}
+	if n := len(s.msgHistory); n > 0 && s.msgHistory[n-1] == content {
+		return
+	}
+	s.msgHistory = append(s.msgHistory, content)
+	if len(s.msgHistory) > maxMsgHistory {
+		s.msgHistory = s.msgHistory[1:]
+	}
+}
+
+// historyPrev recalls the previous prompt, saving the draft on the way in.
+// It only acts on the input's first line so Up still moves the cursor in
+// multi-line input; it reports whether it took the key.
+func (s *Studio) historyPrev() bool {
+	if len(s.msgHistory) == 0 || !s.chat.InputOnFirstLine() {
+		return false
+	}
+	if s.msgHistIdx == -1 {
+		s.msgDraft = s.chat.InputValue()
+		s.msgHistIdx = len(s.msgHistory) - 1
+	} else if s.msgHistIdx > 0 {
+		s.msgHistIdx--
+	}
+	s.chat.SetInputValue(s.msgHistory[s.msgHistIdx])
+	return true
+}
+
+// historyNext moves toward the newest prompt and then back to the draft.
+// Like historyPrev it only acts on the input's last line.
+func (s *Studio) historyNext() bool {
+	if s.msgHistIdx == -1 || !s.chat.InputOnLastLine() {
+		return false
+	}
+	if s.msgHistIdx < len(s.msgHistory)-1 {
+		s.msgHistIdx++
+		s.chat.SetInputValue(s.msgHistory[s.msgHistIdx])
+	} else {
+		s.msgHistIdx = -1
+		s.chat.SetInputValue(s.msgDraft)
+	}
+	return true
+}
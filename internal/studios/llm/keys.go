@@ -3,8 +3,10 @@ package llm
 import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/editor"
 	"github.com/hecate-social/hecate-tui/internal/modes"
+	"github.com/hecate-social/hecate-tui/internal/ui"
 
 	"github.com/atotto/clipboard"
 )
@@ -26,6 +28,10 @@ func (s *Studio) handleKey(msg tea.KeyMsg) tea.Cmd {
 		return s.handleEditKey(key, msg)
 	case modes.Form:
 		return s.handleFormKey(key, msg)
+	case modes.Compose:
+		return s.handleComposeKey(key, msg)
+	case modes.View:
+		return s.handleViewerKey(key)
 	default:
 		if key == "esc" {
 			s.setMode(modes.Normal)
@@ -37,20 +43,17 @@ func (s *Studio) handleKey(msg tea.KeyMsg) tea.Cmd {
 func (s *Studio) handleNormalKey(key string) tea.Cmd {
 	// Handle tool approval keys when pending
 	if s.chat.HasPendingApproval() {
-		switch key {
-		case "y":
-			return s.chat.ApproveToolCall(false)
-		case "n":
-			return s.chat.DenyToolCall()
-		case "a":
-			return s.chat.ApproveToolCall(true)
-		case "esc":
-			return s.chat.DenyToolCall()
-		}
-		return nil
+		return s.handleApprovalKey(key)
 	}
 
 	switch key {
+	case "esc":
+		if s.chat.IsComparing() {
+			s.chat.CancelCompare()
+			return nil
+		}
+		s.stopStatusWatch()
+		s.chat.SelectMessage(-1)
 	case "i":
 		s.setMode(modes.Insert)
 	case "j", "down":
@@ -70,9 +73,14 @@ func (s *Studio) handleNormalKey(key string) tea.Cmd {
 		return commands.ModeHelp(int(s.mode), ctx)
 	case "t":
 		s.chat.ToggleThinking()
+	case "F":
+		s.chat.ToggleFollow()
 	case "r":
 		return s.chat.RetryLast()
 	case "y":
+		if msg, ok := s.chat.SelectedMessage(); ok {
+			return copyMessage(s, msg.Content)
+		}
 		return yankLastResponse(s)
 	}
 	return nil
@@ -81,17 +89,7 @@ func (s *Studio) handleNormalKey(key string) tea.Cmd {
 func (s *Studio) handleInsertKey(key string) tea.Cmd {
 	// Handle tool approval keys when pending
 	if s.chat.HasPendingApproval() {
-		switch key {
-		case "y":
-			return s.chat.ApproveToolCall(false)
-		case "n":
-			return s.chat.DenyToolCall()
-		case "a":
-			return s.chat.ApproveToolCall(true)
-		case "esc":
-			return s.chat.DenyToolCall()
-		}
-		return nil
+		return s.handleApprovalKey(key)
 	}
 
 	switch key {
@@ -100,65 +98,97 @@ func (s *Studio) handleInsertKey(key string) tea.Cmd {
 			s.chat.CancelStreaming()
 			return nil
 		}
+		if s.chat.IsComparing() {
+			s.chat.CancelCompare()
+			return nil
+		}
 		s.msgHistIdx = -1
 		s.setMode(modes.Normal)
 	case "enter":
-		content := s.chat.InputValue()
-		if content != "" {
-			s.msgHistory = append(s.msgHistory, content)
-		}
-		s.msgHistIdx = -1
-		s.msgDraft = ""
+		s.recordPrompt(s.chat.InputValue())
 		cmd := s.chat.SendCurrentInput()
 		if cmd != nil {
 			s.chat.ClearError()
 			s.saveConversation()
+			// Sending shrinks the input back to one row.
+			s.chat.SetSize(s.width, s.chatAreaHeight())
 		}
 		return cmd
 	case "alt+enter":
 		s.chat.InsertNewline()
+	case "ctrl+j":
+		s.chat.CycleInputHeight()
+		s.chat.SetSize(s.width, s.chatAreaHeight())
+	case "ctrl+o":
+		return s.openCompose()
 	case "tab":
 		s.chat.CycleModel()
 	case "shift+tab":
 		s.chat.CycleModelReverse()
 	case "up":
-		if len(s.msgHistory) == 0 {
-			return nil
-		}
-		if s.msgHistIdx == -1 {
-			s.msgDraft = s.chat.InputValue()
-			s.msgHistIdx = len(s.msgHistory) - 1
-		} else if s.msgHistIdx > 0 {
-			s.msgHistIdx--
-		}
-		s.chat.SetInputValue(s.msgHistory[s.msgHistIdx])
+		s.historyKeyTaken = s.historyPrev()
 	case "down":
-		if s.msgHistIdx == -1 {
-			return nil
-		}
-		if s.msgHistIdx < len(s.msgHistory)-1 {
-			s.msgHistIdx++
-			s.chat.SetInputValue(s.msgHistory[s.msgHistIdx])
-		} else {
-			s.msgHistIdx = -1
-			s.chat.SetInputValue(s.msgDraft)
-		}
+		s.historyKeyTaken = s.historyNext()
+	}
+	return nil
+}
+
+// handleApprovalKey drives the tool approval dialog: arrows move between
+// buttons, enter confirms, and the letter shortcuts act directly.
+func (s *Studio) handleApprovalKey(key string) tea.Cmd {
+	switch key {
+	case "left", "h", "shift+tab":
+		s.approvalPrompt.Prev()
+		return nil
+	case "right", "l", "tab":
+		s.approvalPrompt.Next()
+		return nil
+	case "down", "j":
+		s.approvalPrompt.ScrollDown()
+		return nil
+	case "up", "k":
+		s.approvalPrompt.ScrollUp()
+		return nil
+	case "enter":
+		return s.resolveApproval(s.approvalPrompt.Selected())
+	case "esc":
+		return s.resolveApproval(ui.ApprovalDeny)
+	}
+	if choice, ok := ui.ChoiceForKey(key); ok {
+		return s.resolveApproval(choice)
 	}
 	return nil
 }
 
+// resolveApproval answers the pending tool call and resets the dialog.
+func (s *Studio) resolveApproval(choice ui.ApprovalChoice) tea.Cmd {
+	s.approvalPrompt.Reset()
+	switch choice {
+	case ui.ApprovalAllowOnce:
+		return s.chat.ApproveToolCall(false)
+	case ui.ApprovalAllowSession:
+		return s.chat.ApproveToolCall(true)
+	case ui.ApprovalDenySession:
+		return s.chat.DenyToolCallForSession()
+	default:
+		return s.chat.DenyToolCall()
+	}
+}
+
 func (s *Studio) handleBrowseKey(key string, msg tea.KeyMsg) tea.Cmd {
 	if !s.browseReady {
 		return nil
 	}
 
-	if key == "?" {
+	if key == "?" && !s.browseView.Searching() {
 		ctx := s.CommandContext()
 		return commands.ModeHelp(int(s.mode), ctx)
 	}
 
-	if key == "esc" {
-		s.setMode(modes.Normal)
+	if (key == "<" || key == ">") && !s.browseView.Searching() {
+		s.browseView.SetWidthRatio(stepRatio(s.browseView.WidthRatio(), key))
+		ratio := s.browseView.WidthRatio()
+		_ = s.saveConfig(func(c *config.Config) { c.UI.BrowseWidth = ratio })
 		return nil
 	}
 
@@ -167,6 +197,13 @@ func (s *Studio) handleBrowseKey(key string, msg tea.KeyMsg) tea.Cmd {
 		return cmd
 	}
 
+	// Browse consumes Esc while a filter or detail is open, so an
+	// unconsumed Esc exits.
+	if key == "esc" {
+		s.browseView.Close()
+		s.setMode(modes.Normal)
+	}
+
 	return nil
 }
 
@@ -180,6 +217,13 @@ func (s *Studio) handlePairKey(key string, msg tea.KeyMsg) tea.Cmd {
 		return commands.ModeHelp(int(s.mode), ctx)
 	}
 
+	if (key == "<" || key == ">") && s.width >= 100 {
+		split := stepRatio(config.ClampRatio(s.cfg.UI.PairSplit, config.DefaultPairSplit), key)
+		_ = s.saveConfig(func(c *config.Config) { c.UI.PairSplit = split })
+		s.pairView.SetSize(s.pairWidth(), s.pairHeight())
+		return nil
+	}
+
 	consumed, cmd := s.pairView.HandleKey(key, msg)
 	if consumed {
 		return cmd
@@ -198,11 +242,17 @@ func (s *Studio) handleEditKey(key string, msg tea.KeyMsg) tea.Cmd {
 		return nil
 	}
 
-	switch key {
-	case "ctrl+q", "esc":
-		s.editorReady = false
-		s.setMode(modes.Normal)
-		return nil
+	// An open prompt (save as, ...) owns esc
+	if !s.editorView.PromptActive() {
+		switch key {
+		case "ctrl+q", "esc":
+			if s.editorView.IsDirty() {
+				s.editorView.ConfirmClose()
+				return nil
+			}
+			s.closeEditor()
+			return nil
+		}
 	}
 
 	var cmd tea.Cmd
@@ -213,6 +263,15 @@ func (s *Studio) handleEditKey(key string, msg tea.KeyMsg) tea.Cmd {
 	return cmd
 }
 
+// closeEditor leaves Edit mode, dropping the buffer. The swap file is left
+// alone: saving and discarding already removed it, and a clean buffer may
+// be one whose swap the user chose to ignore and keep.
+func (s *Studio) closeEditor() {
+	s.rememberEditedFile(s.editorView.Filepath())
+	s.editorReady = false
+	s.setMode(modes.Normal)
+}
+
 func (s *Studio) handleFormKey(key string, msg tea.KeyMsg) tea.Cmd {
 	if !s.formReady || s.formView == nil {
 		return nil
@@ -223,13 +282,27 @@ func (s *Studio) handleFormKey(key string, msg tea.KeyMsg) tea.Cmd {
 	return cmd
 }
 
+// stepRatio widens (">") or narrows ("<") a pane ratio by one step.
+func stepRatio(r float64, key string) float64 {
+	if key == "<" {
+		r -= config.SplitRatioStep
+	} else {
+		r += config.SplitRatioStep
+	}
+	return config.ClampRatio(r, r)
+}
+
 func yankLastResponse(s *Studio) tea.Cmd {
 	content := s.chat.LastAssistantMessage()
 	if content == "" {
 		s.chat.InjectSystemMessage("No response to copy.")
 		return nil
 	}
+	return copyMessage(s, content)
+}
 
+// copyMessage puts content on the clipboard and confirms with a preview.
+func copyMessage(s *Studio, content string) tea.Cmd {
 	if err := clipboard.WriteAll(content); err != nil {
 		s.chat.InjectSystemMessage("Clipboard unavailable: " + err.Error())
 		return nil
@@ -0,0 +1,51 @@
+package llm
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/modes"
+)
+
+// doubleClickWindow is the longest gap between clicks on one message that
+// still counts as a double-click.
+const doubleClickWindow = 400 * time.Millisecond
+
+// handleMouse scrolls the chat with the wheel and, in Normal mode, selects
+// the clicked message; a double-click copies it.
+func (s *Studio) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		s.chat.ScrollUp(3)
+		return nil
+	case tea.MouseButtonWheelDown:
+		s.chat.ScrollDown(3)
+		return nil
+	}
+
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	// Clicks would fight with typing and overlays; only Normal mode selects.
+	if s.mode != modes.Normal || s.chat.HasPendingApproval() {
+		return nil
+	}
+
+	idx := s.chat.MessageAt(msg.Y)
+	if idx < 0 {
+		s.chat.SelectMessage(-1)
+		return nil
+	}
+
+	now := time.Now()
+	double := idx == s.lastClickMsg && now.Sub(s.lastClick) < doubleClickWindow
+	s.lastClick, s.lastClickMsg = now, idx
+	s.chat.SelectMessage(idx)
+
+	if double {
+		if m, ok := s.chat.SelectedMessage(); ok {
+			return copyMessage(s, m.Content)
+		}
+	}
+	return nil
+}
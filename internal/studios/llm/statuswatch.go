@@ -0,0 +1,75 @@
+package llm
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/commands"
+)
+
+// statusWatchInterval is how often /status --watch refreshes its card.
+const statusWatchInterval = 2 * time.Second
+
+// statusWatch tracks the live status card rendered by /status --watch.
+type statusWatch struct {
+	active  bool
+	msgID   int    // chat message ID of the card
+	gen     int    // bumped on each start so stale ticks are dropped
+	content string // last rendered card body
+}
+
+// statusWatchTickMsg triggers the next refresh of the status card.
+type statusWatchTickMsg struct{ gen int }
+
+// statusWatchRenderedMsg carries a freshly rendered status card.
+type statusWatchRenderedMsg struct {
+	gen     int
+	content string
+}
+
+// startStatusWatch injects the status card and begins refreshing it.
+func (s *Studio) startStatusWatch() tea.Cmd {
+	if s.statusWatch.active {
+		return nil
+	}
+	s.statusWatch.gen++
+	s.statusWatch.active = true
+	s.statusWatch.content = s.ctx.Styles.CardTitle.Render("Daemon Status") + "\n\n" + s.ctx.Styles.Subtle.Render("Checking...")
+	s.statusWatch.msgID = s.chat.InjectSystemMessage(s.statusWatch.content)
+	return s.refreshStatusWatch(s.statusWatch.gen)
+}
+
+// stopStatusWatch freezes the card at its last rendered state.
+func (s *Studio) stopStatusWatch() {
+	if !s.statusWatch.active {
+		return
+	}
+	s.statusWatch.active = false
+	footer := s.ctx.Styles.Subtle.Render("Stopped watching at " + time.Now().Format("15:04:05"))
+	s.chat.ReplaceSystemMessage(s.statusWatch.msgID, s.statusWatch.content+"\n"+footer)
+}
+
+func (s *Studio) refreshStatusWatch(gen int) tea.Cmd {
+	ctx := s.CommandContext()
+	return func() tea.Msg {
+		return statusWatchRenderedMsg{gen: gen, content: commands.RenderStatusWatch(ctx)}
+	}
+}
+
+// handleStatusWatchRendered replaces the card in place and schedules the next tick.
+func (s *Studio) handleStatusWatchRendered(msg statusWatchRenderedMsg) tea.Cmd {
+	if !s.statusWatch.active || msg.gen != s.statusWatch.gen {
+		return nil
+	}
+	s.statusWatch.content = msg.content
+	footer := s.ctx.Styles.Subtle.Render("Watching — updated " + time.Now().Format("15:04:05") + " · Esc to stop")
+	if !s.chat.ReplaceSystemMessage(s.statusWatch.msgID, msg.content+"\n"+footer) {
+		// The card is gone (chat cleared or conversation switched)
+		s.statusWatch.active = false
+		return nil
+	}
+	gen := msg.gen
+	return tea.Tick(statusWatchInterval, func(time.Time) tea.Msg {
+		return statusWatchTickMsg{gen: gen}
+	})
+}
@@ -4,19 +4,25 @@
 package llm
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hecate-social/hecate-tui/internal/alc"
 	"github.com/hecate-social/hecate-tui/internal/browse"
 	"github.com/hecate-social/hecate-tui/internal/chat"
+	"github.com/hecate-social/hecate-tui/internal/client"
 	"github.com/hecate-social/hecate-tui/internal/commands"
 	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/editor"
 	"github.com/hecate-social/hecate-tui/internal/llmtools"
 	"github.com/hecate-social/hecate-tui/internal/modes"
 	"github.com/hecate-social/hecate-tui/internal/pair"
+	"github.com/hecate-social/hecate-tui/internal/secret"
 	"github.com/hecate-social/hecate-tui/internal/studio"
 	"github.com/hecate-social/hecate-tui/internal/theme"
 	"github.com/hecate-social/hecate-tui/internal/ui"
@@ -37,27 +43,45 @@ type Studio struct {
 	editorView editor.Model
 	formView   *ui.FormModel
 
+	// Compose overlay reuses the editor as a scratch buffer
+	composeView editor.Model
+
 	// Tool system
 	toolExecutor   *llmtools.Executor
 	approvalPrompt *ui.ApprovalPrompt
 
 	// Overlay states
-	browseReady bool
-	pairReady   bool
-	editorReady bool
-	formReady   bool
+	browseReady  bool
+	pairReady    bool
+	editorReady  bool
+	formReady    bool
+	pendingCall  *commands.CallFormMsg // /call awaiting its argument form
+	composeReady bool
+	jsonViewer   *ui.JSONViewer
+
+	// Subscription deliveries shown in the chat
+	subFeed    subFeed
+	subFeedGen int // bumped by startSubFeed; older polls and retries are dropped
 
 	// Chat input history
-	msgHistory []string
-	msgHistIdx int
-	msgDraft   string
+	msgHistory      []string
+	msgHistIdx      int
+	msgDraft        string
+	historyKeyTaken bool // Up/Down recalled a prompt; don't pass it to the textarea
 
 	// System prompt / personality
 	systemPrompt string
 
+	// Mouse selection (double-click detection)
+	lastClick    time.Time
+	lastClickMsg int
+
 	// Conversation
 	conversationID    string
 	conversationTitle string
+	conversationTags  []string
+	recoverID         string // last conversation left unloaded at startup
+	autosaveGen       int    // bumped by Init; older autosave ticks are dropped
 
 	// ALC context
 	alcState *alc.State
@@ -67,10 +91,17 @@ type Studio struct {
 	height  int
 	focused bool
 
+	// Live /status --watch card
+	statusWatch statusWatch
+
 	// Cached config for save operations
 	cfg config.Config
 }
 
+// autoLoadMaxBytes is the largest conversation file opened at startup;
+// bigger ones wait for an explicit /load.
+const autoLoadMaxBytes = 8 << 20
+
 // txFlashDoneMsg resets the TX LED after flash duration.
 type txFlashDoneMsg struct{}
 
@@ -86,34 +117,49 @@ func New(ctx *studio.Context) *Studio {
 	if ctx.Config.Model != "" {
 		chatModel.SetPreferredModel(ctx.Config.Model)
 	}
+	if ctx.Config.UI.CompactMode {
+		chatModel.SetCompact(true)
+	}
+	if t := ctx.Config.UI.ApprovalTimeout; t != 0 {
+		chatModel.SetApprovalTimeout(time.Duration(t) * time.Second)
+	}
+	chatModel.SetTimestampStyle(chat.ParseTimestampStyle(ctx.Config.UI.TimestampFormat))
 
 	toolRegistry := llmtools.NewDefaultRegistry()
 	toolPermissions := llmtools.NewPermissions()
 	toolExecutor := llmtools.NewExecutor(toolRegistry, toolPermissions)
+	toolExecutor.SetRoot(ctx.Config.Tools.SandboxRoot)
+	toolExecutor.SetAuditor(auditToolCall)
 	chatModel.SetToolExecutor(toolExecutor)
 	chatModel.EnableTools(false)
+	chatModel.SetPriceOverrides(ctx.Config.Pricing)
 	llmtools.SetMeshClient(ctx.Client)
 
 	approvalPrompt := ui.NewApprovalPrompt(ctx.Theme, ctx.Styles)
 
 	convID := config.NewConversationID()
 	convTitle := ""
-	if convs := config.ListConversations(); len(convs) > 0 {
-		latest := convs[0]
-		convID = latest.ID
-		convTitle = latest.Title
-		var msgs []chat.Message
-		for _, m := range latest.Messages {
-			msgs = append(msgs, chat.Message{
-				Role:    m.Role,
-				Content: m.Content,
-				Time:    m.Time,
-			})
-		}
-		chatModel.LoadMessages(msgs)
-	}
-
-	return &Studio{
+	var convTags []string
+	var recoverID string
+	if id, size, ok := config.LatestConversationFile(); ok {
+		// A broken or huge last conversation must not stall startup.
+		if size > autoLoadMaxBytes {
+			recoverID = id
+			chatModel.InjectSystemMessage(fmt.Sprintf("Last conversation %s is %.1f MB, too large to open at startup; started a new one. Use /load %s to open it.",
+				id, float64(size)/(1<<20), id))
+		} else if latest, err := config.LoadConversation(id); err != nil {
+			recoverID = id
+			chatModel.InjectSystemMessage("Could not load last conversation: " + err.Error() + "; started a new one. Use /recover to try again.")
+		} else {
+			convID = latest.ID
+			convTitle = latest.Title
+			convTags = latest.Tags
+			chatModel.SetPins(latest.Pins)
+			chatModel.LoadMessages(chatMessages(latest))
+		}
+	}
+
+	s := &Studio{
 		ctx:               ctx,
 		mode:              modes.Normal,
 		chat:              chatModel,
@@ -123,18 +169,25 @@ func New(ctx *studio.Context) *Studio {
 		alcState:          alc.NewState(),
 		conversationID:    convID,
 		conversationTitle: convTitle,
+		conversationTags:  convTags,
+		recoverID:         recoverID,
 		cfg:               ctx.Config,
 	}
+	s.resetMsgHistory(chatModel.Messages())
+	return s
 }
 
 func (s *Studio) Name() string      { return "LLM" }
 func (s *Studio) ShortName() string { return "LLM" }
+func (s *Studio) Aliases() []string { return nil }
 func (s *Studio) Icon() string      { return "🤖" }
 
 func (s *Studio) Init() tea.Cmd {
 	return tea.Batch(
 		s.chat.Init(),
+		s.autosaveTick(),
 		s.detectVenture,
+		s.startSubFeed(),
 	)
 }
 
@@ -160,9 +213,16 @@ func (s *Studio) SetSize(width, height int) {
 	if s.editorReady {
 		s.editorView.SetSize(width, s.editorHeight())
 	}
+	if s.jsonViewer != nil {
+		s.jsonViewer.SetSize(width, height)
+	}
+	if s.composeReady {
+		s.composeView.SetSize(width, s.editorHeight())
+	}
 }
 
 func (s *Studio) StatusInfo() studio.StatusInfo {
+	chunks, elapsed, frame, streaming := s.chat.StreamProgress()
 	return studio.StatusInfo{
 		ModelName:     s.chat.ActiveModelName(),
 		ModelProvider: s.chat.ActiveModelProvider(),
@@ -170,6 +230,10 @@ func (s *Studio) StatusInfo() studio.StatusInfo {
 		ModelError:    s.modelError(),
 		InputLen:      s.chat.InputLen(),
 		SessionTokens: s.chat.SessionTokenCount(),
+		Streaming:     streaming,
+		StreamChunks:  chunks,
+		StreamElapsed: elapsed,
+		StreamFrame:   frame,
 	}
 }
 
@@ -195,6 +259,11 @@ func (s *Studio) Commands() []commands.Command {
 	return nil // Commands stay in global registry for now — migrated in future phase
 }
 
+// ActiveRole returns the active ALC role code, or "" if none.
+func (s *Studio) ActiveRole() string {
+	return s.cfg.Personality.ActiveRole
+}
+
 // ALCState returns the ALC state for the shell to read.
 func (s *Studio) ALCState() *alc.State {
 	return s.alcState
@@ -206,11 +275,8 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.MouseMsg:
-		switch msg.Button {
-		case tea.MouseButtonWheelUp:
-			s.chat.ScrollUp(3)
-		case tea.MouseButtonWheelDown:
-			s.chat.ScrollDown(3)
+		if cmd := s.handleMouse(msg); cmd != nil {
+			cmds = append(cmds, cmd)
 		}
 
 	case tea.KeyMsg:
@@ -219,6 +285,11 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 		if cmd != nil {
 			cmds = append(cmds, cmd)
 		}
+		// A recalled prompt already placed the cursor
+		if s.historyKeyTaken {
+			s.historyKeyTaken = false
+			return s, tea.Batch(cmds...)
+		}
 		// If we just switched INTO Insert mode, don't forward the key to chat
 		if modeBefore != modes.Insert && s.mode == modes.Insert {
 			return s, tea.Batch(cmds...)
@@ -228,14 +299,31 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 			return s, tea.Batch(cmds...)
 		}
 
+	case editor.CloseMsg:
+		if s.editorReady {
+			s.closeEditor()
+		}
+
 	// Command system messages that affect LLM studio
 	case commands.ClearChatMsg:
 		s.chat.ClearMessages()
 
 	case commands.SwitchModelMsg:
 		s.chat.SwitchModel(msg.Name)
-		s.cfg.Model = msg.Name
-		_ = s.cfg.Save()
+		name := msg.Name
+		_ = s.saveConfig(func(c *config.Config) { c.Model = name })
+
+	case commands.RefreshModelsMsg:
+		cmds = append(cmds, s.chat.RefreshModels())
+
+	case commands.ProvidersChangedMsg:
+		s.chat.InjectSystemMessage(msg.Notice)
+		cmds = append(cmds, s.chat.RefreshModels())
+
+	case commands.CompareModelsMsg:
+		if cmd := s.chat.Compare(msg.Models); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 
 	case commands.SetModeMsg:
 		cmd := s.enterMode(modes.Mode(msg.Mode))
@@ -244,14 +332,40 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 		}
 
 	case commands.EditFileMsg:
-		cmd := s.openEditor(msg.Path)
+		path := msg.Path
+		if msg.Last && path == "" {
+			path = s.lastEditedFile()
+		}
+		cmd := s.openEditor(path)
 		if cmd != nil {
 			cmds = append(cmds, cmd)
 		}
 
 	case commands.NewConversationMsg:
+		if msg.Fork {
+			s.forkConversation()
+		} else {
+			s.startNewConversation()
+			s.chat.InjectSystemMessage("Started new conversation.")
+		}
+
+	case commands.ImportTranscriptMsg:
 		s.startNewConversation()
-		s.chat.InjectSystemMessage("Started new conversation.")
+		s.chat.LoadMessages(msg.Messages)
+		s.resetMsgHistory(msg.Messages)
+		s.chat.InjectSystemMessage(fmt.Sprintf("Imported %d messages from %s", len(msg.Messages), msg.Source))
+
+	case commands.ShowJSONMsg:
+		s.openJSONViewer(msg)
+
+	case commands.PinMsg:
+		s.pinContext(msg)
+
+	case commands.UnpinMsg:
+		s.unpinContext(msg)
+
+	case commands.TagConversationMsg:
+		s.tagConversation(msg)
 
 	case commands.LoadConversationMsg:
 		if err := s.loadConversation(msg.ID); err != nil {
@@ -260,7 +374,19 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 			s.chat.InjectSystemMessage("Loaded conversation: " + msg.ID)
 		}
 
+	case commands.RecoverConversationMsg:
+		s.recoverConversation(msg.ID)
+
 	case commands.EnableToolsMsg:
+		if msg.Auto {
+			s.chat.SetToolsAuto()
+			status := "off"
+			if s.chat.ToolsEnabled() {
+				status = "on"
+			}
+			s.chat.InjectSystemMessage("LLM function calling follows the active model (currently " + status + ")")
+			break
+		}
 		s.chat.EnableTools(msg.Enabled)
 		status := "disabled"
 		if msg.Enabled {
@@ -269,15 +395,19 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 		s.chat.InjectSystemMessage("LLM function calling " + status)
 
 	case browse.SelectModelMsg:
-		s.chat.SwitchModel(msg.ModelName)
 		s.setMode(modes.Normal)
-		s.chat.InjectSystemMessage("Model switched to: " + msg.ModelName)
-		s.cfg.Model = msg.ModelName
-		_ = s.cfg.Save()
+		if s.chat.HasModel(msg.ModelName) {
+			s.chat.SwitchModel(msg.ModelName)
+		} else {
+			// Picked from a fresher listing than ours; catch up first.
+			cmds = append(cmds, s.chat.RefreshAndSwitch(msg.ModelName))
+		}
+		name := msg.ModelName
+		_ = s.saveConfig(func(c *config.Config) { c.Model = name })
 
 	case commands.SwitchRoleMsg:
-		s.cfg.Personality.ActiveRole = msg.Role
-		_ = s.cfg.Save()
+		role := msg.Role
+		_ = s.saveConfig(func(c *config.Config) { c.Personality.ActiveRole = role })
 		newPrompt := s.cfg.BuildSystemPrompt()
 		s.systemPrompt = newPrompt
 		s.chat.SetSystemPrompt(newPrompt)
@@ -296,6 +426,24 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
+	case commands.CallFormMsg:
+		if cmd := s.showCallForm(msg, nil); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case subFeedMsg:
+		if msg.gen == s.subFeedGen {
+			cmds = append(cmds, s.handleSubFeed(msg))
+		}
+
+	case subFeedRetryMsg:
+		if msg.gen == s.subFeedGen {
+			cmds = append(cmds, s.startSubFeed())
+		}
+
+	case commands.SubscriptionMuteMsg:
+		s.setSubFeedMuted(msg)
+
 	case commands.ChangeDirMsg:
 		if err := os.Chdir(msg.Path); err != nil {
 			s.chat.InjectSystemMessage(s.ctx.Styles.Error.Render("Failed to change directory: " + err.Error()))
@@ -321,10 +469,30 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 	case commands.InjectSystemMsg:
 		s.chat.InjectSystemMessage(msg.Content)
 
-	case ventureDetectedMsg:
-		if msg.venture != nil {
-			s.alcState.SetVenture(msg.venture, msg.source)
-			s.chat.InjectSystemMessage("Resuming venture: " + msg.venture.Name + " (detected from " + msg.source + ")")
+	case commands.StatusWatchMsg:
+		if cmd := s.startStatusWatch(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case statusWatchTickMsg:
+		if s.statusWatch.active && msg.gen == s.statusWatch.gen {
+			cmds = append(cmds, s.refreshStatusWatch(msg.gen))
+		}
+
+	case statusWatchRenderedMsg:
+		if cmd := s.handleStatusWatchRendered(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case studio.DaemonHealthMsg:
+		if s.browseReady {
+			cmds = append(cmds, s.browseView.SetDaemonUp(msg.Up))
+		}
+
+	case autosaveTickMsg:
+		if msg.gen == s.autosaveGen {
+			s.autosave()
+			cmds = append(cmds, s.scheduleAutosave())
 		}
 
 	case txFlashDoneMsg:
@@ -368,6 +536,15 @@ func (s *Studio) Update(msg tea.Msg) (studio.Studio, tea.Cmd) {
 		}
 	}
 
+	// Forward to compose overlay (non-key msgs)
+	if s.mode == modes.Compose && s.composeReady {
+		if _, isKey := msg.(tea.KeyMsg); !isKey {
+			model, composeCmd := s.composeView.Update(msg)
+			s.composeView = model.(editor.Model)
+			cmds = append(cmds, composeCmd)
+		}
+	}
+
 	// Forward to form if in Form mode (non-key msgs)
 	if s.mode == modes.Form && s.formReady && s.formView != nil {
 		if _, isKey := msg.(tea.KeyMsg); !isKey {
@@ -392,7 +569,7 @@ func (s *Studio) setMode(m modes.Mode) {
 		s.chat.SetInputVisible(false)
 	case modes.Insert:
 		s.chat.SetInputVisible(true)
-	case modes.Browse, modes.Pair, modes.Edit, modes.Form:
+	case modes.Browse, modes.Pair, modes.Edit, modes.Form, modes.Compose, modes.View:
 		s.chat.SetInputVisible(false)
 	}
 
@@ -405,10 +582,15 @@ func (s *Studio) enterMode(m modes.Mode) tea.Cmd {
 
 	switch m {
 	case modes.Browse:
-		s.browseView = browse.New(s.ctx.Client, s.ctx.Theme, s.ctx.Styles)
+		// Keep the browse model for the session so reopening restores
+		// the selection and filter; Resume refetches only when stale.
+		if !s.browseReady {
+			s.browseView = browse.New(s.ctx.Client, s.ctx.Theme, s.ctx.Styles)
+			s.browseView.SetWidthRatio(s.cfg.UI.BrowseWidth)
+			s.browseReady = true
+		}
 		s.browseView.SetSize(s.width, s.height)
-		s.browseReady = true
-		return s.browseView.Init()
+		return s.browseView.Resume()
 	case modes.Pair:
 		s.pairView = pair.New(s.ctx.Client, s.ctx.Theme, s.ctx.Styles)
 		s.pairView.SetSize(s.pairWidth(), s.pairHeight())
@@ -443,7 +625,19 @@ func (s *Studio) handleALCContextChange(msg commands.SetALCContextMsg) {
 				source = "manual"
 			}
 			s.alcState.SetVenture(msg.Venture, source)
+			if detected(source) {
+				// Already inside the venture; don't move the working directory
+				s.chat.InjectSystemMessage("Resuming venture: " + msg.Venture.Name + " (detected from " + source + ")")
+				return
+			}
 			s.chat.InjectSystemMessage("Venture selected: " + msg.Venture.Name)
+			if root := msg.Venture.Root; root != "" {
+				if err := alc.EnterRoot(root); err != nil {
+					s.chat.InjectSystemMessage(s.ctx.Styles.Error.Render("Failed to change directory: " + err.Error()))
+				} else {
+					s.chat.InjectSystemMessage(s.ctx.Styles.Subtle.Render("Changed to: " + root))
+				}
+			}
 		}
 
 	case alc.Department:
@@ -454,14 +648,27 @@ func (s *Studio) handleALCContextChange(msg commands.SetALCContextMsg) {
 	}
 }
 
+// detected reports whether a venture context source came from
+// auto-detection rather than a user's choice.
+func detected(source string) bool {
+	return source == "auto" || source == "git"
+}
+
 // CommandContext builds a commands.Context for command dispatch.
 func (s *Studio) CommandContext() *commands.Context {
+	var httpURL string
+	if s.ctx.Client.SocketPath() == "" {
+		httpURL = s.ctx.Client.BaseURL()
+	}
 	return &commands.Context{
-		Client: s.ctx.Client,
-		Theme:  s.ctx.Theme,
-		Styles: s.ctx.Styles,
-		Width:  s.width,
-		Height: s.height,
+		Client:     s.ctx.Client,
+		SocketPath: s.ctx.Client.SocketPath(),
+		HTTPUrl:    httpURL,
+		AuthToken:  s.ctx.Client.Token(),
+		Theme:      s.ctx.Theme,
+		Styles:     s.ctx.Styles,
+		Width:      s.width,
+		Height:     s.height,
 		SetMode: func(mode int) {
 			s.setMode(modes.Mode(mode))
 		},
@@ -480,14 +687,16 @@ func (s *Studio) CommandContext() *commands.Context {
 			}
 			return msgs
 		},
+		GetSessionStats: func() chat.SessionStats {
+			return s.chat.SessionStats()
+		},
 		GetSystemPrompt: func() string {
 			return s.systemPrompt
 		},
 		SetSystemPrompt: func(prompt string) {
 			s.systemPrompt = prompt
 			s.chat.SetSystemPrompt(prompt)
-			s.cfg.SystemPrompt = prompt
-			_ = s.cfg.Save()
+			_ = s.saveConfig(func(c *config.Config) { c.SystemPrompt = prompt })
 		},
 		GetToolExecutor: func() *llmtools.Executor {
 			return s.chat.ToolExecutor()
@@ -495,15 +704,30 @@ func (s *Studio) CommandContext() *commands.Context {
 		ToolsEnabled: func() bool {
 			return s.chat.ToolsEnabled()
 		},
+		ToolsAuto: func() bool {
+			return s.chat.ToolsAuto()
+		},
 		GetActiveRole: func() string {
 			return s.cfg.Personality.ActiveRole
 		},
 		SetActiveRole: func(role string) error {
-			s.cfg.Personality.ActiveRole = role
-			return s.cfg.Save()
+			return s.saveConfig(func(c *config.Config) { c.Personality.ActiveRole = role })
 		},
 		GetRoleNames: func() []string {
-			return []string{"dna", "anp", "tni", "dno"}
+			var names []string
+			for _, r := range s.cfg.Roles() {
+				names = append(names, r.Name)
+			}
+			return names
+		},
+		GetRoles: func() []config.Role {
+			return s.cfg.Roles()
+		},
+		AddRole: func(role config.CustomRole) error {
+			return s.updateRoles(func(c *config.Config) error { return c.AddRole(role) })
+		},
+		RemoveRole: func(name string) error {
+			return s.updateRoles(func(c *config.Config) error { return c.RemoveRole(name) })
 		},
 		RebuildPrompt: func() string {
 			return s.cfg.BuildSystemPrompt()
@@ -514,9 +738,38 @@ func (s *Studio) CommandContext() *commands.Context {
 	}
 }
 
+// saveConfig applies change to the studio's copy of the config and to the
+// config on disk, so settings the app saved since startup (theme, last
+// studio) aren't overwritten with stale values.
+func (s *Studio) saveConfig(change func(*config.Config)) error {
+	change(&s.cfg)
+	_, err := config.Update(change)
+	return err
+}
+
+// updateRoles applies a role change to the saved config, then refreshes
+// the system prompt in case the active role was edited or removed.
+func (s *Studio) updateRoles(change func(*config.Config) error) error {
+	var changeErr error
+	cfg, err := config.Update(func(c *config.Config) { changeErr = change(c) })
+	if changeErr != nil {
+		return changeErr
+	}
+	s.cfg.Personality = cfg.Personality
+	s.systemPrompt = s.cfg.BuildSystemPrompt()
+	s.chat.SetSystemPrompt(s.systemPrompt)
+	return err
+}
+
 // conversation management
 
 func (s *Studio) saveConversation() {
+	s.writeConversation("")
+}
+
+// writeConversation saves the chat, appending partial as an in-progress
+// assistant message when it is non-empty.
+func (s *Studio) writeConversation(partial string) {
 	msgs := s.chat.Messages()
 	if len(msgs) == 0 {
 		return
@@ -527,24 +780,39 @@ func (s *Studio) saveConversation() {
 		if m.Role == "system" {
 			continue
 		}
+		// Keys pasted into the chat stay out of the saved history.
 		convMsgs = append(convMsgs, config.ConversationMsg{
 			Role:    m.Role,
-			Content: m.Content,
+			Content: secret.Redact(m.Content),
 			Time:    m.Time,
 		})
 	}
+	if partial != "" {
+		convMsgs = append(convMsgs, config.ConversationMsg{
+			Role:    "assistant",
+			Content: secret.Redact(partial),
+			Time:    time.Now(),
+			Partial: true,
+		})
+	}
 
 	if len(convMsgs) == 0 {
 		return
 	}
 
-	title := config.TitleFromMessages(convMsgs)
-	s.conversationTitle = title
+	// Keep an existing title so forks stay named after their original.
+	title := s.conversationTitle
+	if title == "" {
+		title = config.TitleFromMessages(convMsgs)
+		s.conversationTitle = title
+	}
 
 	conv := config.Conversation{
 		ID:        s.conversationID,
 		Title:     title,
 		Model:     s.chat.ActiveModelName(),
+		Tags:      s.conversationTags,
+		Pins:      s.chat.Pins(),
 		Messages:  convMsgs,
 		CreatedAt: convMsgs[0].Time,
 	}
@@ -552,11 +820,115 @@ func (s *Studio) saveConversation() {
 	_ = config.SaveConversation(conv)
 }
 
+// forkConversation saves the current conversation and carries on with a
+// copy of it under a new ID, leaving the original untouched.
+func (s *Studio) forkConversation() {
+	if len(s.chat.Messages()) == 0 {
+		s.chat.InjectSystemMessage("Nothing to fork yet. Use /new to start a conversation.")
+		return
+	}
+	s.saveConversation()
+	originalID := s.conversationID
+	original := s.conversationTitle
+	if original == "" {
+		original = originalID
+	}
+
+	s.conversationID = config.NewConversationID()
+	s.conversationTitle = "Fork of " + original
+	s.conversationTags = append([]string(nil), s.conversationTags...)
+	s.saveConversation()
+	s.resetMsgHistory(s.chat.Messages())
+	s.chat.InjectSystemMessage("Forked into " + s.conversationID + ". The original is saved as " + originalID + ".")
+}
+
 func (s *Studio) startNewConversation() {
 	s.saveConversation()
 	s.chat.ClearMessages()
+	s.resetMsgHistory(nil)
 	s.conversationID = config.NewConversationID()
 	s.conversationTitle = ""
+	s.conversationTags = nil
+	s.chat.SetPins(nil)
+}
+
+// pinContext pins text or the last response, or lists pins.
+func (s *Studio) pinContext(msg commands.PinMsg) {
+	switch {
+	case msg.Last:
+		if !s.chat.PinLastResponse() {
+			s.chat.InjectSystemMessage("No response to pin.")
+			return
+		}
+	case strings.TrimSpace(msg.Text) != "":
+		s.chat.Pin(msg.Text)
+	default:
+		if len(s.chat.Pins()) == 0 {
+			s.chat.InjectSystemMessage("Nothing pinned. Use /pin <text> or /pin --last.")
+		} else {
+			s.chat.InjectSystemMessage(fmt.Sprintf("%d pin(s) shown above the chat. /unpin <n> removes one.", len(s.chat.Pins())))
+		}
+		return
+	}
+	s.saveConversation()
+	s.chat.InjectSystemMessage(fmt.Sprintf("Pinned (#%d). It is sent with every message.", len(s.chat.Pins())))
+}
+
+// unpinContext removes one pin, or all of them.
+func (s *Studio) unpinContext(msg commands.UnpinMsg) {
+	pins := len(s.chat.Pins())
+	switch {
+	case pins == 0:
+		s.chat.InjectSystemMessage("Nothing pinned.")
+		return
+	case msg.All:
+		s.chat.SetPins(nil)
+	case msg.Index == 0 && pins == 1:
+		s.chat.Unpin(1)
+	case msg.Index == 0:
+		s.chat.InjectSystemMessage(fmt.Sprintf("%d pins; use /unpin <n> or /unpin all.", pins))
+		return
+	case !s.chat.Unpin(msg.Index):
+		s.chat.InjectSystemMessage(fmt.Sprintf("No pin #%d.", msg.Index))
+		return
+	}
+	s.saveConversation()
+	s.chat.InjectSystemMessage(fmt.Sprintf("Unpinned. %d pin(s) left.", len(s.chat.Pins())))
+}
+
+// tagConversation applies a /tag action and persists the result.
+func (s *Studio) tagConversation(msg commands.TagConversationMsg) {
+	var changed []string
+	for _, tag := range msg.Tags {
+		var ok bool
+		switch msg.Action {
+		case "add":
+			s.conversationTags, ok = config.AddTag(s.conversationTags, tag)
+		case "remove":
+			s.conversationTags, ok = config.RemoveTag(s.conversationTags, tag)
+		}
+		if ok {
+			changed = append(changed, "#"+tag)
+		}
+	}
+	if len(changed) > 0 {
+		s.saveConversation()
+	}
+
+	current := "none"
+	if len(s.conversationTags) > 0 {
+		current = "#" + strings.Join(s.conversationTags, " #")
+	}
+	switch {
+	case msg.Action == "add" && len(changed) > 0:
+		s.chat.InjectSystemMessage("Tagged " + strings.Join(changed, " ") + " (tags: " + current + ")")
+	case msg.Action == "remove" && len(changed) > 0:
+		s.chat.InjectSystemMessage("Removed " + strings.Join(changed, " ") + " (tags: " + current + ")")
+	case msg.Action == "list":
+		s.chat.InjectSystemMessage("Tags: " + current)
+	default:
+		s.chat.InjectSystemMessage("Tags unchanged: " + current)
+	}
 }
 
 func (s *Studio) loadConversation(id string) error {
@@ -564,9 +936,41 @@ func (s *Studio) loadConversation(id string) error {
 	if err != nil {
 		return err
 	}
+	s.applyConversation(conv)
+	return nil
+}
 
-	s.saveConversation()
+// recoverConversation loads id, or the conversation startup skipped, or
+// the newest one, salvaging what it can from a damaged file.
+func (s *Studio) recoverConversation(id string) {
+	if id == "" {
+		id = s.recoverID
+	}
+	if id == "" {
+		id, _, _ = config.LatestConversationFile()
+	}
+	if id == "" {
+		s.chat.InjectSystemMessage("No saved conversation to recover.")
+		return
+	}
 
+	conv, salvaged, err := config.RecoverConversation(id)
+	if err != nil {
+		s.chat.InjectSystemMessage("Could not recover " + id + ": " + err.Error())
+		return
+	}
+	s.applyConversation(conv)
+	s.recoverID = ""
+	if salvaged {
+		s.chat.InjectSystemMessage(fmt.Sprintf("Recovered %d messages from damaged conversation %s; the rest was unreadable. The next save rewrites the file.",
+			len(conv.Messages), id))
+		return
+	}
+	s.chat.InjectSystemMessage("Loaded conversation: " + id)
+}
+
+// chatMessages converts a saved conversation to chat messages.
+func chatMessages(conv config.Conversation) []chat.Message {
 	var msgs []chat.Message
 	for _, m := range conv.Messages {
 		msgs = append(msgs, chat.Message{
@@ -575,47 +979,80 @@ func (s *Studio) loadConversation(id string) error {
 			Time:    m.Time,
 		})
 	}
+	return msgs
+}
+
+// auditToolCall appends a tool call to the audit log in the config dir.
+// A failed write must not block the tool, so errors are dropped.
+func auditToolCall(e llmtools.AuditEntry) {
+	_ = config.AppendToolAudit(config.ToolAuditEntry{
+		At:         e.At,
+		Tool:       e.Tool,
+		Args:       e.Args,
+		Decision:   e.Decision,
+		DurationMs: e.Duration.Milliseconds(),
+		OK:         e.OK,
+		Error:      e.Error,
+	})
+}
 
+// applyConversation saves the current conversation and replaces it with
+// conv.
+func (s *Studio) applyConversation(conv config.Conversation) {
+	s.saveConversation()
+
+	msgs := chatMessages(conv)
 	s.chat.ClearMessages()
 	s.chat.LoadMessages(msgs)
+	s.resetMsgHistory(msgs)
 	s.conversationID = conv.ID
 	s.conversationTitle = conv.Title
-	return nil
+	s.conversationTags = conv.Tags
+	s.chat.SetPins(conv.Pins)
 }
 
 // venture detection
 
-type ventureDetectedMsg struct {
-	venture *alc.VentureInfo
-	source  string
-}
-
+// detectVenture looks for a venture around the working directory and
+// selects it with Source "auto" (from .hecate/venture.json) or "git" (from
+// the remote URL). A malformed manifest, or one naming a venture the daemon
+// no longer knows, produces a note instead.
 func (s *Studio) detectVenture() tea.Msg {
+	st := s.ctx.Styles
 	result := alc.DetectVenture()
+	if result.Err != nil {
+		return commands.InjectSystemMsg{Content: st.StatusWarning.Render("Venture not detected: " + result.Err.Error())}
+	}
 	if !result.Found {
 		return nil
 	}
 
 	if result.Source == "config" && result.Config != nil && result.Config.VentureID != "" {
-		venture, err := s.ctx.Client.GetVentureByID(result.Config.VentureID)
-		if err == nil && venture != nil {
-			return ventureDetectedMsg{
-				venture: &alc.VentureInfo{
-					ID:    venture.VentureID,
-					Name:  venture.Name,
-					Brief: venture.Brief,
-				},
-				source: "config",
-			}
+		cfg := result.Config
+		info := &alc.VentureInfo{
+			ID:    cfg.VentureID,
+			Name:  cfg.Name,
+			Brief: cfg.Brief,
+			Root:  cfg.Root,
 		}
-		return ventureDetectedMsg{
-			venture: &alc.VentureInfo{
-				ID:    result.Config.VentureID,
-				Name:  result.Config.Name,
-				Brief: result.Config.Brief,
-			},
-			source: "config",
+		venture, err := s.ctx.Client.GetVentureByID(cfg.VentureID)
+		switch {
+		case err == nil && venture != nil:
+			info.Name = venture.Name
+			info.Brief = venture.Brief
+		case errors.Is(err, client.ErrUnreachable):
+			// Daemon offline: trust the manifest until it is back
+		default:
+			name := cfg.Name
+			if name == "" {
+				name = cfg.VentureID
+			}
+			return commands.InjectSystemMsg{Content: st.StatusWarning.Render(
+				"Venture " + name + " from " + filepath.Join(cfg.Root, ".hecate", "venture.json") +
+					" is not known to the daemon. Use /venture select to pick one.")}
 		}
+		_ = config.SaveVentureRoot(cfg.VentureID, cfg.Root)
+		return commands.SetALCContextMsg{Context: alc.Venture, Venture: info, Source: "auto"}
 	}
 
 	if result.Source == "git" && result.Config != nil {
@@ -623,13 +1060,14 @@ func (s *Studio) detectVenture() tea.Msg {
 		if err == nil {
 			for _, v := range ventures {
 				if containsIgnoreCase(result.Config.Name, v.Name) {
-					return ventureDetectedMsg{
-						venture: &alc.VentureInfo{
+					return commands.SetALCContextMsg{
+						Context: alc.Venture,
+						Venture: &alc.VentureInfo{
 							ID:    v.VentureID,
 							Name:  v.Name,
 							Brief: v.Brief,
 						},
-						source: "git",
+						Source: "git",
 					}
 				}
 			}
@@ -659,27 +1097,32 @@ func (s *Studio) showForm(formType string) tea.Cmd {
 	switch formType {
 	case "venture_init":
 		cwd, _ := os.Getwd()
-		spec := ui.VentureInitSpec(cwd)
-		s.formView = ui.BuildForm(spec, s.ctx.Theme, s.ctx.Styles)
-		formWidth := 60
-		if s.width > 0 && s.width < 70 {
-			formWidth = s.width - 4
-		}
-		s.formView.SetWidth(formWidth)
-		s.formReady = true
-		s.setMode(modes.Form)
-		return s.formView.Init()
+		return s.openForm(ui.VentureInitSpec(cwd))
 	default:
 		s.chat.InjectSystemMessage("Unknown form type: " + formType)
 		return nil
 	}
 }
 
+// openForm builds spec and shows it as the form overlay.
+func (s *Studio) openForm(spec ui.FormSpec) tea.Cmd {
+	s.formView = ui.BuildForm(spec, s.ctx.Theme, s.ctx.Styles)
+	formWidth := 60
+	if s.width > 0 && s.width < 70 {
+		formWidth = s.width - 4
+	}
+	s.formView.SetWidth(formWidth)
+	s.formReady = true
+	s.setMode(modes.Form)
+	return s.formView.Init()
+}
+
 func (s *Studio) handleFormResult(result ui.FormResult) tea.Cmd {
 	s.formReady = false
 	s.setMode(modes.Normal)
 
 	if !result.Submitted {
+		s.pendingCall = nil
 		s.chat.InjectSystemMessage("Cancelled.")
 		return nil
 	}
@@ -687,6 +1130,8 @@ func (s *Studio) handleFormResult(result ui.FormResult) tea.Cmd {
 	switch result.FormID {
 	case "venture_init":
 		return s.handleVentureFormResult(result)
+	case callFormID:
+		return s.handleCallFormResult(result)
 	default:
 		s.chat.InjectSystemMessage("Unknown form: " + result.FormID)
 		return nil
@@ -748,10 +1193,12 @@ func (s *Studio) openEditor(path string) tea.Cmd {
 			return nil
 		}
 		s.editorView = ed
+		s.rememberEditedFile(path)
 	} else {
 		s.editorView = editor.New()
 	}
 
+	s.editorView.SetLineNumberColor(s.ctx.Theme.TextMuted)
 	s.editorView.SetSize(s.width, s.editorHeight())
 	s.editorView.Focus()
 	s.editorReady = true
@@ -759,16 +1206,58 @@ func (s *Studio) openEditor(path string) tea.Cmd {
 	return s.editorView.Init()
 }
 
+// rememberEditedFile records path as the file a bare /edit reopens.
+func (s *Studio) rememberEditedFile(path string) {
+	if path == "" {
+		return
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if s.cfg.Editor.LastFile == path {
+		return
+	}
+	_ = s.saveConfig(func(c *config.Config) { c.Editor.LastFile = path })
+}
+
+// lastEditedFile returns the remembered file if it still exists.
+func (s *Studio) lastEditedFile() string {
+	if s.cfg.Editor.LastFile == "" {
+		return ""
+	}
+	if _, err := os.Stat(s.cfg.Editor.LastFile); err != nil {
+		return ""
+	}
+	return s.cfg.Editor.LastFile
+}
+
 // SwitchTheme updates the studio's components for a new theme.
 func (s *Studio) SwitchTheme(t *theme.Theme, styles *theme.Styles) {
 	s.ctx.Theme = t
 	s.ctx.Styles = styles
-	s.chat = chat.New(s.ctx.Client, t, styles)
-	s.chat.SetSize(s.width, s.chatAreaHeight())
+	s.chat.ReTheme(t, styles)
 	s.approvalPrompt = ui.NewApprovalPrompt(t, styles)
 	s.chat.InjectSystemMessage("Theme switched to: " + t.Name)
 }
 
+// HasPendingApproval reports whether a tool call is waiting on the approval dialog.
+func (s *Studio) HasPendingApproval() bool {
+	return s.chat.HasPendingApproval()
+}
+
+// CycleModel switches to the next available model, as Tab does in Insert.
+func (s *Studio) CycleModel() {
+	if s.chat.IsStreaming() {
+		return
+	}
+	s.chat.CycleModel()
+}
+
+// IsExecutingTool reports whether a tool call is running between turns.
+func (s *Studio) IsExecutingTool() bool {
+	return s.chat.IsExecutingTool()
+}
+
 // IsStreaming returns whether the chat is currently streaming a response.
 func (s *Studio) IsStreaming() bool {
 	return s.chat.IsStreaming()
@@ -0,0 +1,163 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/client"
+	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/config"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+const (
+	// subFeedInterval is how often queued deliveries are drained; everything
+	// that arrived in between is shown as one batch.
+	subFeedInterval = 2 * time.Second
+
+	// subFeedRetry is the delay before reconnecting a dropped stream.
+	subFeedRetry = 30 * time.Second
+
+	// subFeedPerTopic is how many deliveries per topic are shown in full
+	// before the rest of the batch is summarized.
+	subFeedPerTopic = 3
+
+	// subFeedMaxBatch caps how many queued deliveries one poll drains.
+	subFeedMaxBatch = 500
+)
+
+// subFeed holds the subscription delivery stream.
+type subFeed struct {
+	events <-chan client.SubscriptionEvent
+	cancel context.CancelFunc
+}
+
+// subFeedMsg carries the deliveries drained by one poll. gen ties it to
+// the stream it was read from.
+type subFeedMsg struct {
+	gen    int
+	events []client.SubscriptionEvent
+	closed bool
+}
+
+// subFeedRetryMsg reconnects the stream after it dropped.
+type subFeedRetryMsg struct{ gen int }
+
+// startSubFeed connects to the subscription stream and starts polling it.
+// Init runs on every return to the studio, so each start retires the
+// previous stream and its poll loop rather than running beside them.
+func (s *Studio) startSubFeed() tea.Cmd {
+	s.subFeedGen++
+	if s.subFeed.cancel != nil {
+		s.subFeed.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	// Errors end the stream and are retried; they are not worth a chat
+	// message since older daemons do not offer the endpoint at all.
+	events, _ := s.ctx.Client.SubscriptionEvents(ctx)
+	s.subFeed = subFeed{events: events, cancel: cancel}
+	return s.pollSubFeed()
+}
+
+// pollSubFeed drains whatever arrived since the last poll without blocking.
+func (s *Studio) pollSubFeed() tea.Cmd {
+	events := s.subFeed.events
+	gen := s.subFeedGen
+	return tea.Tick(subFeedInterval, func(time.Time) tea.Msg {
+		msg := subFeedMsg{gen: gen}
+		for len(msg.events) < subFeedMaxBatch {
+			select {
+			case evt, ok := <-events:
+				if !ok {
+					msg.closed = true
+					return msg
+				}
+				msg.events = append(msg.events, evt)
+			default:
+				return msg
+			}
+		}
+		return msg
+	})
+}
+
+// handleSubFeed shows a batch of deliveries and schedules the next poll.
+func (s *Studio) handleSubFeed(msg subFeedMsg) tea.Cmd {
+	if len(msg.events) > 0 && !s.cfg.UI.MuteSubscriptions {
+		s.chat.InjectSystemMessage(renderSubFeed(msg.events, s.ctx.Styles))
+	}
+	if msg.closed {
+		s.subFeed.cancel()
+		gen := s.subFeedGen
+		return tea.Tick(subFeedRetry, func(time.Time) tea.Msg { return subFeedRetryMsg{gen: gen} })
+	}
+	return s.pollSubFeed()
+}
+
+// setSubFeedMuted toggles subscription notifications and persists the choice.
+func (s *Studio) setSubFeedMuted(msg commands.SubscriptionMuteMsg) {
+	muted := msg.Muted
+	_ = s.saveConfig(func(c *config.Config) { c.UI.MuteSubscriptions = muted })
+	if msg.Muted {
+		s.chat.InjectSystemMessage("Subscription notifications muted (subscriptions stay active).")
+	} else {
+		s.chat.InjectSystemMessage("Subscription notifications unmuted.")
+	}
+}
+
+// renderSubFeed groups a batch by topic, summarizing chatty topics.
+func renderSubFeed(events []client.SubscriptionEvent, st *theme.Styles) string {
+	var order []string
+	byTopic := make(map[string][]client.SubscriptionEvent)
+	for _, e := range events {
+		topic := e.Topic
+		if topic == "" {
+			topic = e.ServiceMRI
+		}
+		if _, ok := byTopic[topic]; !ok {
+			order = append(order, topic)
+		}
+		byTopic[topic] = append(byTopic[topic], e)
+	}
+
+	var b strings.Builder
+	for i, topic := range order {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		batch := byTopic[topic]
+		b.WriteString("📡 " + st.Bold.Render(topic))
+		shown := batch
+		if len(batch) > subFeedPerTopic {
+			b.WriteString(st.Subtle.Render(fmt.Sprintf("  %d messages, latest:", len(batch))))
+			shown = batch[len(batch)-1:]
+		}
+		for _, e := range shown {
+			b.WriteString("\n  " + payloadPreview(e.Payload, 200))
+		}
+	}
+	return b.String()
+}
+
+// payloadPreview renders a payload on one line, unquoting plain strings.
+func payloadPreview(payload json.RawMessage, limit int) string {
+	var text string
+	if err := json.Unmarshal(payload, &text); err != nil {
+		var buf bytes.Buffer
+		if json.Compact(&buf, payload) == nil {
+			text = buf.String()
+		} else {
+			text = string(payload)
+		}
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	if r := []rune(text); len(r) > limit {
+		text = string(r[:limit-1]) + "…"
+	}
+	return text
+}
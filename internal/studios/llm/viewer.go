@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/modes"
+	"github.com/hecate-social/hecate-tui/internal/ui"
+)
+
+// openJSONViewer shows a large JSON result in the full-screen viewer.
+func (s *Studio) openJSONViewer(msg commands.ShowJSONMsg) {
+	viewer, err := ui.NewJSONViewer(s.ctx.Theme, s.ctx.Styles, msg.Title, msg.Data)
+	if err != nil {
+		s.chat.InjectSystemMessage("Cannot display result: " + err.Error())
+		return
+	}
+	viewer.SetSize(s.width, s.height)
+	s.jsonViewer = viewer
+	if msg.Summary != "" {
+		s.chat.InjectSystemMessage(msg.Summary)
+	}
+	s.setMode(modes.View)
+}
+
+func (s *Studio) handleViewerKey(key string) tea.Cmd {
+	if s.jsonViewer == nil {
+		s.setMode(modes.Normal)
+		return nil
+	}
+
+	switch s.jsonViewer.HandleKey(key) {
+	case ui.JSONViewerClose:
+		s.jsonViewer = nil
+		s.setMode(modes.Normal)
+	case ui.JSONViewerCopy:
+		if err := clipboard.WriteAll(s.jsonViewer.Content()); err != nil {
+			s.chat.InjectSystemMessage("Clipboard unavailable: " + err.Error())
+		} else {
+			s.chat.InjectSystemMessage("Copied result to clipboard.")
+		}
+	}
+	return nil
+}
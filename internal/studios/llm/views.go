@@ -6,6 +6,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/hecate-social/hecate-tui/internal/commands"
+	"github.com/hecate-social/hecate-tui/internal/config"
 	"github.com/hecate-social/hecate-tui/internal/llmtools"
 	"github.com/hecate-social/hecate-tui/internal/modes"
 	"github.com/hecate-social/hecate-tui/internal/scaffold"
@@ -33,6 +34,16 @@ func (s *Studio) View() string {
 		return s.editorView.View()
 	}
 
+	// Compose mode takes the full content area too
+	if s.mode == modes.Compose && s.composeReady {
+		return s.composeView.View()
+	}
+
+	// JSON viewer takes the full content area
+	if s.mode == modes.View && s.jsonViewer != nil {
+		return s.jsonViewer.View()
+	}
+
 	// Form mode overlays the chat
 	if s.mode == modes.Form && s.formReady {
 		return s.renderFormLayout()
@@ -92,6 +103,8 @@ func (s *Studio) renderWithApprovalOverlay(content string) string {
 		dialogWidth = s.width - 4
 	}
 	s.approvalPrompt.SetWidth(dialogWidth)
+	s.approvalPrompt.SetHeight(s.height)
+	s.approvalPrompt.SetSandbox(s.toolExecutor.Root())
 
 	prompt := s.approvalPrompt.Render(tool, *call)
 
@@ -238,7 +251,7 @@ func (s *Studio) renderFormLayout() string {
 func (s *Studio) chatAreaHeight() int {
 	inputHeight := 0
 	if s.mode == modes.Insert {
-		inputHeight = 3 // 1 row + border
+		inputHeight = s.chat.InputRows() + 2 // rows + border
 	}
 
 	statsHeight := 1
@@ -251,7 +264,7 @@ func (s *Studio) chatAreaHeight() int {
 
 func (s *Studio) pairWidth() int {
 	if s.width >= 100 {
-		return s.width / 2
+		return int(float64(s.width) * config.ClampRatio(s.cfg.UI.PairSplit, config.DefaultPairSplit))
 	}
 	return s.width - 4
 }
@@ -276,9 +289,10 @@ func buildVentureScaffoldMsg(st *theme.Styles, ventureID, name, brief string, in
 	}
 
 	result := scaffold.Scaffold(path, manifest)
+	_ = config.SaveVentureRoot(ventureID, path)
 
 	var b strings.Builder
-	b.WriteString(st.StatusOK.Render("Venture Initiated"))
+	b.WriteString(commands.VentureInitiatedHeading(st, result))
 	b.WriteString("\n\n")
 	b.WriteString(st.CardTitle.Render("Venture: " + name))
 	b.WriteString("\n")
@@ -289,47 +303,8 @@ func buildVentureScaffoldMsg(st *theme.Styles, ventureID, name, brief string, in
 	b.WriteString(st.Subtle.Render(path))
 	b.WriteString("\n\n")
 
-	b.WriteString(st.CardTitle.Render("Scaffolded:"))
+	b.WriteString(commands.RenderScaffoldResult(st, result))
 	b.WriteString("\n")
-
-	if result.Success {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render(".hecate/venture.json"))
-		b.WriteString("\n")
-	}
-	if result.AgentsCloned {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render(".hecate/agents/"))
-		b.WriteString("\n")
-	}
-	if result.ReadmeCreated {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render("README.md"))
-		b.WriteString("\n")
-	}
-	if result.ChangelogCreated {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render("CHANGELOG.md"))
-		b.WriteString("\n")
-	}
-
-	if result.GitInitialized {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render("git init"))
-		b.WriteString("\n")
-	}
-
-	if result.GitCommitted {
-		b.WriteString(st.StatusOK.Render("  ✓ "))
-		b.WriteString(st.Subtle.Render("git commit"))
-		b.WriteString("\n")
-	}
-
-	for _, warn := range result.Warnings {
-		b.WriteString(st.StatusWarning.Render("  ⚠ " + warn))
-		b.WriteString("\n")
-	}
-
 	b.WriteString("\n")
 	b.WriteString(st.Subtle.Render("Next: gh repo create --public --source=. --push"))
 
@@ -81,6 +81,7 @@ func New(ctx *studio.Context) *Studio {
 
 func (s *Studio) Name() string      { return "Node" }
 func (s *Studio) ShortName() string { return "Node" }
+func (s *Studio) Aliases() []string { return []string{"ops"} }
 func (s *Studio) Icon() string      { return "\U0001F310" }
 func (s *Studio) Mode() modes.Mode {
 	if s.actionMode == actionViewForm && s.formReady {
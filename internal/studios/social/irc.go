@@ -155,6 +155,11 @@ func (m *ircModel) update(msg tea.Msg) tea.Cmd {
 		m.connected = false
 		return m.connectStream()
 
+	case irc.IrcErrorMsg:
+		m.connected = false
+		m.loadErr = msg.Err
+		return nil
+
 	case ircPollTickMsg:
 		return m.pollStream()
 
@@ -213,10 +218,7 @@ func (m *ircModel) appendMessage(channelID string, msg ircMessage) {
 
 // connectStream creates and subscribes to the IRC SSE stream.
 func (m *ircModel) connectStream() tea.Cmd {
-	m.stream = irc.NewConnection(
-		m.ctx.Client.SocketPath(),
-		m.ctx.Client.BaseURL(),
-	)
+	m.stream = irc.NewConnection(m.ctx.Client)
 	return m.stream.Subscribe()
 }
 
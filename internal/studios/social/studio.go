@@ -53,6 +53,7 @@ func New(ctx *studio.Context) *Studio {
 
 func (s *Studio) Name() string      { return "Social" }
 func (s *Studio) ShortName() string { return "Social" }
+func (s *Studio) Aliases() []string { return nil }
 func (s *Studio) Icon() string      { return "\U0001F4AC" }
 func (s *Studio) Focused() bool     { return s.focused }
 
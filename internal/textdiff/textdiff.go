@@ -0,0 +1,147 @@
+// Package textdiff computes line-based diffs for comparing chat responses.
+package textdiff
+
+// Op is the kind of a diff line.
+type Op int
+
+const (
+	Equal  Op = iota // present in both
+	Delete           // only in a
+	Insert           // only in b
+)
+
+// Line is one line of a diff.
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxCells bounds the LCS table; larger inputs fall back to replacing
+// every line rather than spending quadratic memory.
+const maxCells = 4_000_000
+
+// Lines diffs a against b using a longest common subsequence, listing
+// deletions before insertions within each changed run.
+func Lines(a, b []string) []Line {
+	// Trim the common prefix and suffix so the table stays small.
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+
+	var out []Line
+	for _, s := range a[:pre] {
+		out = append(out, Line{Equal, s})
+	}
+	out = append(out, middle(a[pre:len(a)-suf], b[pre:len(b)-suf])...)
+	for _, s := range a[len(a)-suf:] {
+		out = append(out, Line{Equal, s})
+	}
+	return out
+}
+
+func middle(a, b []string) []Line {
+	var out []Line
+	if len(a)*len(b) > maxCells {
+		for _, s := range a {
+			out = append(out, Line{Delete, s})
+		}
+		for _, s := range b {
+			out = append(out, Line{Insert, s})
+		}
+		return out
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var dels, ins []Line
+	flush := func() {
+		out = append(out, dels...)
+		out = append(out, ins...)
+		dels, ins = nil, nil
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			flush()
+			out = append(out, Line{Equal, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			dels = append(dels, Line{Delete, a[i]})
+			i++
+		default:
+			ins = append(ins, Line{Insert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		dels = append(dels, Line{Delete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		ins = append(ins, Line{Insert, b[j]})
+	}
+	flush()
+	return out
+}
+
+// Row is one row of a side-by-side diff. A side is empty when that line
+// only exists on the other side.
+type Row struct {
+	Left, Right       string
+	HasLeft, HasRight bool
+	Changed           bool
+}
+
+// SideBySide pairs a diff's deletions with the insertions that follow
+// them so changed lines line up across two columns.
+func SideBySide(lines []Line) []Row {
+	var rows []Row
+	for i := 0; i < len(lines); {
+		if lines[i].Op == Equal {
+			rows = append(rows, Row{Left: lines[i].Text, Right: lines[i].Text, HasLeft: true, HasRight: true})
+			i++
+			continue
+		}
+		var dels, ins []string
+		for i < len(lines) && lines[i].Op == Delete {
+			dels = append(dels, lines[i].Text)
+			i++
+		}
+		for i < len(lines) && lines[i].Op == Insert {
+			ins = append(ins, lines[i].Text)
+			i++
+		}
+		for k := 0; k < len(dels) || k < len(ins); k++ {
+			r := Row{Changed: true}
+			if k < len(dels) {
+				r.Left, r.HasLeft = dels[k], true
+			}
+			if k < len(ins) {
+				r.Right, r.HasRight = ins[k], true
+			}
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
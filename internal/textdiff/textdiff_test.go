@@ -0,0 +1,48 @@
+package textdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	a := strings.Split("one\ntwo\nthree\nfour", "\n")
+	b := strings.Split("one\n2\nthree\nfour\nfive", "\n")
+
+	want := []Line{
+		{Equal, "one"},
+		{Delete, "two"},
+		{Insert, "2"},
+		{Equal, "three"},
+		{Equal, "four"},
+		{Insert, "five"},
+	}
+	if got := Lines(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %v, want %v", got, want)
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	lines := []Line{
+		{Equal, "same"},
+		{Delete, "old 1"},
+		{Delete, "old 2"},
+		{Insert, "new 1"},
+		{Insert, "extra"},
+		{Insert, "extra 2"},
+	}
+	rows := SideBySide(lines)
+	if len(rows) != 4 {
+		t.Fatalf("got %d rows, want 4: %+v", len(rows), rows)
+	}
+	if rows[0].Changed || rows[0].Left != "same" || rows[0].Right != "same" {
+		t.Errorf("row 0 = %+v", rows[0])
+	}
+	if rows[1].Left != "old 1" || rows[1].Right != "new 1" || !rows[1].Changed {
+		t.Errorf("row 1 = %+v", rows[1])
+	}
+	if rows[3].HasLeft || rows[3].Right != "extra 2" {
+		t.Errorf("row 3 = %+v", rows[3])
+	}
+}
@@ -158,8 +158,20 @@ func Monochrome() *Theme {
 	}
 }
 
-// BuiltinThemes returns all available themes keyed by name.
+// BuiltinThemes returns all available themes keyed by name, including
+// user themes discovered in UserThemesDir. Built-in names take precedence.
 func BuiltinThemes() map[string]*Theme {
+	themes := builtinThemes()
+	for name, t := range UserThemes() {
+		if _, exists := themes[name]; !exists {
+			themes[name] = t
+		}
+	}
+	return themes
+}
+
+// builtinThemes returns only the themes shipped with the TUI.
+func builtinThemes() map[string]*Theme {
 	return map[string]*Theme{
 		"dark":       HecateDark(),
 		"light":      HecateLight(),
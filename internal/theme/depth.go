@@ -0,0 +1,201 @@
+package theme
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ColorDepth is the number of colors the terminal can display.
+type ColorDepth int
+
+const (
+	TrueColor ColorDepth = iota
+	ANSI256
+	ANSI16
+	NoColor // NO_COLOR or output that isn't a terminal
+)
+
+// colorOverride returns the depth forced by HECATE_COLOR=truecolor|256|16,
+// for terminals that misreport their support.
+func colorOverride() (ColorDepth, bool) {
+	switch strings.ToLower(os.Getenv("HECATE_COLOR")) {
+	case "truecolor", "24bit":
+		return TrueColor, true
+	case "256":
+		return ANSI256, true
+	case "16":
+		return ANSI16, true
+	}
+	return 0, false
+}
+
+// DetectColorDepth returns the HECATE_COLOR override when set, and
+// otherwise the profile lipgloss detected, which honours NO_COLOR and
+// non-TTY output.
+func DetectColorDepth() ColorDepth {
+	if depth, ok := colorOverride(); ok {
+		return depth
+	}
+	return depthForProfile(lipgloss.ColorProfile())
+}
+
+// depthForProfile maps a termenv profile to a ColorDepth.
+func depthForProfile(p termenv.Profile) ColorDepth {
+	switch p {
+	case termenv.TrueColor:
+		return TrueColor
+	case termenv.ANSI256:
+		return ANSI256
+	case termenv.ANSI:
+		return ANSI16
+	default:
+		return NoColor
+	}
+}
+
+// ApplyColorOverride makes lipgloss render at the HECATE_COLOR depth. It
+// does nothing without an override, leaving lipgloss's own detection in
+// place.
+func ApplyColorOverride() {
+	depth, ok := colorOverride()
+	if !ok {
+		return
+	}
+	switch depth {
+	case TrueColor:
+		lipgloss.SetColorProfile(termenv.TrueColor)
+	case ANSI256:
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	case ANSI16:
+		lipgloss.SetColorProfile(termenv.ANSI)
+	}
+}
+
+// ForDepth returns a copy of the theme with hex colors mapped to the nearest
+// palette entry when the terminal can't show true color.
+func (t *Theme) ForDepth(depth ColorDepth) *Theme {
+	if depth == TrueColor {
+		return t
+	}
+
+	out := *t
+	v := reflect.ValueOf(&out).Elem()
+	colorType := reflect.TypeOf(lipgloss.Color(""))
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Type() != colorType {
+			continue
+		}
+		hex := field.String()
+		var idx int
+		var ok bool
+		if depth == ANSI256 {
+			idx, ok = hexTo256(hex)
+		} else {
+			idx, ok = hexTo16(hex)
+		}
+		if ok {
+			field.Set(reflect.ValueOf(lipgloss.Color(strconv.Itoa(idx))))
+		}
+	}
+	return &out
+}
+
+// parseHex decodes #RGB or #RRGGBB.
+func parseHex(hex string) (r, g, b int, ok bool) {
+	if !hexColor.MatchString(hex) {
+		return 0, 0, 0, false
+	}
+	hex = hex[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if _, err := fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b); err != nil {
+		return 0, 0, 0, false
+	}
+	return r, g, b, true
+}
+
+// cubeLevels are the channel values of the xterm 6x6x6 color cube.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// hexTo256 maps a hex color to the nearest xterm-256 index, choosing between
+// the 6x6x6 cube (16-231) and the grayscale ramp (232-255).
+func hexTo256(hex string) (int, bool) {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return 0, false
+	}
+
+	nearestLevel := func(c int) int {
+		best := 0
+		for i, l := range cubeLevels {
+			if abs(c-l) < abs(c-cubeLevels[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	ri, gi, bi := nearestLevel(r), nearestLevel(g), nearestLevel(b)
+	cubeIdx := 16 + 36*ri + 6*gi + bi
+	cubeDist := colorDist(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	// Grayscale ramp: 232 + n, value 8 + 10n
+	avg := (r + g + b) / 3
+	n := int(math.Round(float64(avg-8) / 10))
+	if n < 0 {
+		n = 0
+	}
+	if n > 23 {
+		n = 23
+	}
+	gray := 8 + 10*n
+	grayDist := colorDist(r, g, b, gray, gray, gray)
+
+	if grayDist < cubeDist {
+		return 232 + n, true
+	}
+	return cubeIdx, true
+}
+
+// ansi16 are the typical RGB values of the 16 standard ANSI colors.
+var ansi16 = [16][3]int{
+	{0, 0, 0}, {128, 0, 0}, {0, 128, 0}, {128, 128, 0},
+	{0, 0, 128}, {128, 0, 128}, {0, 128, 128}, {192, 192, 192},
+	{128, 128, 128}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{0, 0, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// hexTo16 maps a hex color to the nearest of the 16 standard ANSI colors.
+func hexTo16(hex string) (int, bool) {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return 0, false
+	}
+	best, bestDist := 0, math.MaxInt
+	for i, c := range ansi16 {
+		if d := colorDist(r, g, b, c[0], c[1], c[2]); d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return best, true
+}
+
+func colorDist(r1, g1, b1, r2, g2, b2 int) int {
+	dr, dg, db := r1-r2, g1-g2, b1-b2
+	return dr*dr + dg*dg + db*db
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
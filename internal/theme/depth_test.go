@@ -0,0 +1,84 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestHexTo256(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want int
+	}{
+		{"#000000", 16},
+		{"#ffffff", 231},
+		{"#ff0000", 196},
+		{"#00ff00", 46},
+		{"#0000ff", 21},
+		{"#808080", 244},
+		{"#fff", 231},
+		{"#7C3AED", 99},
+	}
+	for _, tt := range tests {
+		got, ok := hexTo256(tt.hex)
+		if !ok {
+			t.Fatalf("hexTo256(%q) not ok", tt.hex)
+		}
+		if got != tt.want {
+			t.Errorf("hexTo256(%q) = %d, want %d", tt.hex, got, tt.want)
+		}
+	}
+}
+
+func TestHexTo256_Invalid(t *testing.T) {
+	if _, ok := hexTo256("212"); ok {
+		t.Error("palette index should not be remapped")
+	}
+}
+
+func TestForDepth(t *testing.T) {
+	dark := HecateDark()
+	if dark.ForDepth(TrueColor) != dark {
+		t.Error("ForDepth(TrueColor) should return the theme unchanged")
+	}
+
+	degraded := dark.ForDepth(ANSI256)
+	if degraded.Name != dark.Name {
+		t.Errorf("Name = %q, want %q", degraded.Name, dark.Name)
+	}
+	if degraded.Primary == dark.Primary {
+		t.Error("Primary should be mapped to a palette index")
+	}
+	if dark.Primary != "#7C3AED" {
+		t.Error("ForDepth must not mutate the source theme")
+	}
+}
+
+func TestDetectColorDepth(t *testing.T) {
+	tests := []struct {
+		profile termenv.Profile
+		want    ColorDepth
+	}{
+		{termenv.TrueColor, TrueColor},
+		{termenv.ANSI256, ANSI256},
+		{termenv.ANSI, ANSI16},
+		{termenv.Ascii, NoColor},
+	}
+	for _, tt := range tests {
+		if got := depthForProfile(tt.profile); got != tt.want {
+			t.Errorf("depthForProfile(%v) = %v, want %v", tt.profile, got, tt.want)
+		}
+	}
+
+	// Without an override the detected profile is used as is.
+	t.Setenv("HECATE_COLOR", "")
+	if got, want := DetectColorDepth(), depthForProfile(lipgloss.ColorProfile()); got != want {
+		t.Errorf("DetectColorDepth() = %v, want detected %v", got, want)
+	}
+	t.Setenv("HECATE_COLOR", "256")
+	if got := DetectColorDepth(); got != ANSI256 {
+		t.Errorf("DetectColorDepth() with HECATE_COLOR=256 = %v, want ANSI256", got)
+	}
+}
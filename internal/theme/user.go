@@ -0,0 +1,136 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// User themes live in ~/.config/hecate-tui/themes/<name>.toml. Keys are the
+// snake_case Theme field names (primary, bg_chat, user_bubble_fg, ...).
+// Omitted colors are taken from the base theme ("dark" unless `base` is set).
+//
+//	name = "Solarized"
+//	base = "dark"
+//	primary = "#268bd2"
+//	bg_chat = "#002b36"
+
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// UserThemesDir returns ~/.config/hecate-tui/themes.
+func UserThemesDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = filepath.Join(os.Getenv("HOME"), ".config")
+	}
+	return filepath.Join(dir, "hecate-tui", "themes")
+}
+
+// UserThemes loads every valid theme file in UserThemesDir, keyed by file name.
+// Invalid files are skipped; use LoadUserTheme to see why.
+func UserThemes() map[string]*Theme {
+	themes := make(map[string]*Theme)
+	entries, err := os.ReadDir(UserThemesDir())
+	if err != nil {
+		return themes
+	}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".toml" {
+			continue
+		}
+		name := strings.ToLower(strings.TrimSuffix(e.Name(), ".toml"))
+		if t, err := LoadUserTheme(name); err == nil {
+			themes[name] = t
+		}
+	}
+	return themes
+}
+
+// LoadUserTheme reads and validates themes/<name>.toml.
+// The file is re-read on every call so edits apply on the next /theme.
+func LoadUserTheme(name string) (*Theme, error) {
+	data, err := os.ReadFile(filepath.Join(UserThemesDir(), name+".toml"))
+	if err != nil {
+		return nil, err
+	}
+	return ParseTheme(name, data)
+}
+
+// ParseTheme builds a Theme from TOML, merging omitted colors from its base.
+func ParseTheme(name string, data []byte) (*Theme, error) {
+	var raw map[string]string
+	if _, err := toml.Decode(string(data), &raw); err != nil {
+		return nil, fmt.Errorf("theme %s: %w", name, err)
+	}
+
+	base := HecateDark()
+	if b, ok := raw["base"]; ok {
+		builtin, found := builtinThemes()[strings.ToLower(b)]
+		if !found {
+			return nil, fmt.Errorf("theme %s: unknown base %q", name, b)
+		}
+		base = builtin
+		delete(raw, "base")
+	}
+
+	t := *base
+	t.Name = name
+	if n, ok := raw["name"]; ok && n != "" {
+		t.Name = n
+	}
+	delete(raw, "name")
+
+	v := reflect.ValueOf(&t).Elem()
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if f.Type == reflect.TypeOf(lipgloss.Color("")) {
+			fields[snakeCase(f.Name)] = v.Field(i)
+		}
+	}
+
+	for key, value := range raw {
+		field, ok := fields[key]
+		if !ok {
+			return nil, fmt.Errorf("theme %s: unknown key %q", name, key)
+		}
+		if !validColor(value) {
+			return nil, fmt.Errorf("theme %s: invalid color %q for %s", name, value, key)
+		}
+		field.Set(reflect.ValueOf(lipgloss.Color(value)))
+	}
+
+	return &t, nil
+}
+
+// validColor accepts #RGB, #RRGGBB, or an ANSI palette index (0-255).
+func validColor(s string) bool {
+	if hexColor.MatchString(s) {
+		return true
+	}
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 255
+}
+
+// snakeCase converts a Go field name like BgChat to bg_chat.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,46 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTheme_MergesWithBase(t *testing.T) {
+	data := []byte(`
+name = "Solar"
+base = "light"
+primary = "#268bd2"
+bg_chat = "#fff"
+user_bubble_fg = "231"
+`)
+	th, err := ParseTheme("solar", data)
+	if err != nil {
+		t.Fatalf("ParseTheme error = %v", err)
+	}
+	if th.Name != "Solar" {
+		t.Errorf("Name = %q, want Solar", th.Name)
+	}
+	if th.Primary != "#268bd2" || th.BgChat != "#fff" || th.UserBubbleFg != "231" {
+		t.Errorf("overrides not applied: %+v", th)
+	}
+	if th.Secondary != HecateLight().Secondary {
+		t.Errorf("Secondary = %q, want base value %q", th.Secondary, HecateLight().Secondary)
+	}
+}
+
+func TestParseTheme_Invalid(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`primary = "purple"`, "invalid color"},
+		{`primry = "#fff"`, "unknown key"},
+		{`base = "neon"`, "unknown base"},
+	}
+	for _, tt := range tests {
+		_, err := ParseTheme("bad", []byte(tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseTheme(%s) error = %v, want %q", tt.data, err, tt.want)
+		}
+	}
+}
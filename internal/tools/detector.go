@@ -1,8 +1,11 @@
 package tools
 
 import (
+	"context"
 	"os/exec"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Tool represents an external tool
@@ -14,8 +17,13 @@ type Tool struct {
 	Category    ToolCategory
 	Installed   bool
 	Version     string
+	Path        string // resolved executable, when installed
 }
 
+// versionTimeout bounds each version probe, since some tools start an
+// interactive session on flags they don't understand.
+const versionTimeout = 2 * time.Second
+
 // ToolCategory groups related tools
 type ToolCategory string
 
@@ -78,16 +86,26 @@ func NewDetector() *Detector {
 	}
 }
 
-// Detect checks which tools are installed
+// Detect checks which tools are installed, looking each one up on PATH
+// afresh and probing installed ones for their version in parallel.
 func (d *Detector) Detect() []Tool {
 	copy(d.tools, KnownTools)
 
+	var wg sync.WaitGroup
 	for i := range d.tools {
-		d.tools[i].Installed = d.isInstalled(d.tools[i].Command)
-		if d.tools[i].Installed {
-			d.tools[i].Version = d.getVersion(d.tools[i].Command)
+		path, err := exec.LookPath(d.tools[i].Command)
+		if err != nil {
+			continue
 		}
+		d.tools[i].Installed = true
+		d.tools[i].Path = path
+		wg.Add(1)
+		go func(t *Tool) {
+			defer wg.Done()
+			t.Version = d.getVersion(t.Path)
+		}(&d.tools[i])
 	}
+	wg.Wait()
 
 	return d.tools
 }
@@ -125,15 +143,12 @@ func (d *Detector) GetPreferredEditor() *Tool {
 	return nil
 }
 
-func (d *Detector) isInstalled(cmd string) bool {
-	_, err := exec.LookPath(cmd)
-	return err == nil
-}
-
 func (d *Detector) getVersion(cmd string) string {
 	// Try common version flags
 	for _, flag := range []string{"--version", "-version", "version"} {
-		out, err := exec.Command(cmd, flag).Output()
+		ctx, cancel := context.WithTimeout(context.Background(), versionTimeout)
+		out, err := exec.CommandContext(ctx, cmd, flag).Output()
+		cancel()
 		if err == nil {
 			// Return first line, trimmed
 			lines := strings.Split(string(out), "\n")
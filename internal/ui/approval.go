@@ -1,7 +1,6 @@
 package ui
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -11,19 +10,52 @@ import (
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
 
+// ApprovalChoice is one of the actions offered by the approval dialog.
+type ApprovalChoice int
+
+const (
+	ApprovalAllowOnce ApprovalChoice = iota
+	ApprovalAllowSession
+	ApprovalDeny
+	ApprovalDenySession
+)
+
+// approvalButtons lists the dialog buttons in display order with their shortcut keys.
+var approvalButtons = []struct {
+	choice ApprovalChoice
+	key    string
+	label  string
+}{
+	{ApprovalAllowOnce, "y", "Allow once"},
+	{ApprovalAllowSession, "a", "Allow session"},
+	{ApprovalDeny, "n", "Deny"},
+	{ApprovalDenySession, "d", "Deny session"},
+}
+
 // ApprovalPrompt renders a tool approval dialog.
 type ApprovalPrompt struct {
-	theme  *theme.Theme
-	styles *theme.Styles
-	width  int
+	theme   *theme.Theme
+	styles  *theme.Styles
+	width   int
+	focused int // index into approvalButtons
+
+	callID string // call being shown; a new call resets focus and scroll
+
+	sandbox string // root path-taking tools are confined to
+
+	// Argument viewer
+	argHeight int // visible argument lines
+	argTotal  int // lines in the last rendered arguments
+	scroll    int
 }
 
 // NewApprovalPrompt creates a new approval prompt renderer.
 func NewApprovalPrompt(t *theme.Theme, s *theme.Styles) *ApprovalPrompt {
 	return &ApprovalPrompt{
-		theme:  t,
-		styles: s,
-		width:  60,
+		theme:     t,
+		styles:    s,
+		width:     60,
+		argHeight: 10,
 	}
 }
 
@@ -38,8 +70,72 @@ func (p *ApprovalPrompt) SetWidth(w int) {
 	}
 }
 
+// SetHeight fits the argument viewer to the available screen height.
+func (p *ApprovalPrompt) SetHeight(h int) {
+	// Title, badge, sandbox, description, buttons, padding and border take ~17 rows
+	p.argHeight = h - 17
+	if p.argHeight < 3 {
+		p.argHeight = 3
+	}
+}
+
+// SetSandbox sets the sandbox root shown for tools that take a path.
+func (p *ApprovalPrompt) SetSandbox(root string) {
+	p.sandbox = root
+}
+
+// ScrollDown scrolls the argument viewer one line down.
+func (p *ApprovalPrompt) ScrollDown() {
+	if p.scroll+p.argHeight < p.argTotal {
+		p.scroll++
+	}
+}
+
+// ScrollUp scrolls the argument viewer one line up.
+func (p *ApprovalPrompt) ScrollUp() {
+	if p.scroll > 0 {
+		p.scroll--
+	}
+}
+
+// Next moves focus to the next button, wrapping around.
+func (p *ApprovalPrompt) Next() {
+	p.focused = (p.focused + 1) % len(approvalButtons)
+}
+
+// Prev moves focus to the previous button, wrapping around.
+func (p *ApprovalPrompt) Prev() {
+	p.focused = (p.focused + len(approvalButtons) - 1) % len(approvalButtons)
+}
+
+// Selected returns the choice under focus.
+func (p *ApprovalPrompt) Selected() ApprovalChoice {
+	return approvalButtons[p.focused].choice
+}
+
+// Reset returns focus to the first button and scroll to the top for the next prompt.
+func (p *ApprovalPrompt) Reset() {
+	p.focused = 0
+	p.scroll = 0
+}
+
+// ChoiceForKey maps a letter shortcut to its choice.
+func ChoiceForKey(key string) (ApprovalChoice, bool) {
+	for _, b := range approvalButtons {
+		if b.key == key {
+			return b.choice, true
+		}
+	}
+	return 0, false
+}
+
 // Render renders the approval prompt for a tool call.
 func (p *ApprovalPrompt) Render(tool llmtools.Tool, call llm.ToolCall) string {
+	if call.ID != p.callID {
+		p.Reset()
+		p.callID = call.ID
+	}
+
 	// Styles
 	titleStyle := lipgloss.NewStyle().
 		Bold(true).
@@ -62,55 +158,57 @@ func (p *ApprovalPrompt) Render(tool llmtools.Tool, call llm.ToolCall) string {
 	dimStyle := lipgloss.NewStyle().
 		Foreground(p.theme.TextDim)
 
-	keyStyle := lipgloss.NewStyle().
-		Foreground(p.theme.Success).
-		Bold(true)
-
 	// Format title
 	title := titleStyle.Render(fmt.Sprintf("🔧 Tool Request: %s", tool.Name))
 
 	// Format description
 	desc := valueStyle.Render(tool.Description)
 
-	// Format arguments
-	var argsDisplay string
+	// Format arguments — full detail, scrollable, since this is what gets approved
+	argsDisplay := dimStyle.Render("(no arguments)")
+	scrollHint := ""
 	if len(call.Arguments) > 0 {
-		var args map[string]interface{}
-		if err := json.Unmarshal(call.Arguments, &args); err == nil {
-			argsDisplay = p.formatArgs(args, labelStyle, valueStyle)
-		} else {
-			// Fallback: show raw JSON truncated
-			raw := string(call.Arguments)
-			if len(raw) > 200 {
-				raw = raw[:200] + "..."
-			}
-			argsDisplay = dimStyle.Render(raw)
+		lines := p.argLines(call.Arguments)
+		p.argTotal = len(lines)
+		if p.scroll > len(lines)-p.argHeight {
+			p.scroll = max(0, len(lines)-p.argHeight)
+		}
+		end := min(len(lines), p.scroll+p.argHeight)
+		argsDisplay = strings.Join(lines[p.scroll:end], "\n")
+		if len(lines) > p.argHeight {
+			scrollHint = dimStyle.Render(fmt.Sprintf("j/k scroll · lines %d-%d of %d", p.scroll+1, end, len(lines)))
 		}
-	} else {
-		argsDisplay = dimStyle.Render("(no arguments)")
 	}
 
 	// Build category badge
 	categoryBadge := p.categoryBadge(tool.Category)
 
-	// Format keybindings
-	keybindings := fmt.Sprintf(
-		"%s Allow  %s Deny  %s Allow all (session)",
-		keyStyle.Render("[y]"),
-		keyStyle.Render("[n]"),
-		keyStyle.Render("[a]"),
-	)
+	// Buttons: focused one highlighted, letter shortcuts still shown
+	keybindings := p.renderButtons() + "\n" +
+		dimStyle.Render("←/→ select · enter confirm · esc deny")
 
 	// Assemble content
 	var parts []string
 	parts = append(parts, title)
 	parts = append(parts, "")
 	parts = append(parts, categoryBadge)
+	if llmtools.IsSandboxed(tool) && p.sandbox != "" {
+		if llmtools.ConfinesOnlyWorkingDir(tool) {
+			// Only the starting directory is checked; don't imply the command is
+			parts = append(parts, labelStyle.Render("Sandbox: ")+valueStyle.Render("working dir only ("+ShortenHome(p.sandbox)+")")+
+				dimStyle.Render(" – command is not confined"))
+		} else {
+			parts = append(parts, labelStyle.Render("Sandbox: ")+valueStyle.Render(ShortenHome(p.sandbox)))
+		}
+	}
 	parts = append(parts, "")
 	parts = append(parts, desc)
 	parts = append(parts, "")
 	parts = append(parts, labelStyle.Render("Arguments:"))
 	parts = append(parts, argsDisplay)
+	if scrollHint != "" {
+		parts = append(parts, scrollHint)
+	}
 	parts = append(parts, "")
 	parts = append(parts, keybindings)
 
@@ -118,53 +216,27 @@ func (p *ApprovalPrompt) Render(tool llmtools.Tool, call llm.ToolCall) string {
 	return borderStyle.Render(content)
 }
 
-// formatArgs formats the arguments map for display.
-func (p *ApprovalPrompt) formatArgs(args map[string]interface{}, labelStyle, valueStyle lipgloss.Style) string {
-	var lines []string
-	for key, val := range args {
-		valStr := p.formatValue(val)
-		// Truncate long values
-		if len(valStr) > 60 {
-			valStr = valStr[:57] + "..."
-		}
-		line := fmt.Sprintf("  %s %s",
-			labelStyle.Render(key+":"),
-			valueStyle.Render(valStr))
-		lines = append(lines, line)
-	}
-	return strings.Join(lines, "\n")
-}
+// renderButtons renders the action buttons with the focused one highlighted.
+func (p *ApprovalPrompt) renderButtons() string {
+	normal := lipgloss.NewStyle().
+		Foreground(p.theme.Text).
+		Padding(0, 1)
+	focused := lipgloss.NewStyle().
+		Foreground(p.theme.BgPrimary).
+		Background(p.theme.Success).
+		Bold(true).
+		Padding(0, 1)
 
-// formatValue formats a single value for display.
-func (p *ApprovalPrompt) formatValue(val interface{}) string {
-	switch v := val.(type) {
-	case string:
-		return v
-	case float64:
-		if v == float64(int(v)) {
-			return fmt.Sprintf("%d", int(v))
-		}
-		return fmt.Sprintf("%.2f", v)
-	case bool:
-		if v {
-			return "true"
-		}
-		return "false"
-	case nil:
-		return "null"
-	case []interface{}:
-		if len(v) == 0 {
-			return "[]"
-		}
-		var items []string
-		for _, item := range v {
-			items = append(items, p.formatValue(item))
+	var buttons []string
+	for i, b := range approvalButtons {
+		label := "[" + b.key + "] " + b.label
+		if i == p.focused {
+			buttons = append(buttons, focused.Render(label))
+		} else {
+			buttons = append(buttons, normal.Render(label))
 		}
-		return "[" + strings.Join(items, ", ") + "]"
-	default:
-		b, _ := json.Marshal(v)
-		return string(b)
 	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, buttons...)
 }
 
 // categoryBadge returns a styled badge for the tool category.
@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/llm"
+	"github.com/hecate-social/hecate-tui/internal/llmtools"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestApprovalSandboxLine(t *testing.T) {
+	th := theme.HecateDark()
+	p := NewApprovalPrompt(th, th.ComputeStyles())
+	p.SetWidth(100)
+	p.SetSandbox("/srv/project")
+	registry := llmtools.NewDefaultRegistry()
+
+	tests := []struct {
+		tool       string
+		want       string
+		notConfine bool
+	}{
+		{"read_file", "Sandbox: /srv/project", false},
+		{"run_command", "Sandbox: working dir only (/srv/project)", true},
+	}
+	for _, tt := range tests {
+		tool, _, ok := registry.Get(tt.tool)
+		if !ok {
+			t.Fatalf("%s not registered", tt.tool)
+		}
+		out := p.Render(tool, llm.ToolCall{ID: tt.tool, Name: tt.tool})
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%s: approval missing %q:\n%s", tt.tool, tt.want, out)
+		}
+		if got := strings.Contains(out, "command is not confined"); got != tt.notConfine {
+			t.Errorf("%s: shows 'command is not confined' = %v, want %v", tt.tool, got, tt.notConfine)
+		}
+	}
+}
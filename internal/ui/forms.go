@@ -2,10 +2,6 @@
 package ui
 
 import (
-	"os"
-	"path/filepath"
-	"strings"
-
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
@@ -42,7 +38,7 @@ func NewVentureForm(t *theme.Theme, s *theme.Styles, cwd string) *FormModel {
 	huhTheme := huh.ThemeCharm()
 
 	// Default path placeholder uses shortened cwd
-	cwdDisplay := shortenHome(cwd)
+	cwdDisplay := ShortenHome(cwd)
 
 	form := huh.NewForm(
 		huh.NewGroup(
@@ -85,42 +81,6 @@ func NewVentureForm(t *theme.Theme, s *theme.Styles, cwd string) *FormModel {
 	}
 }
 
-// shortenHome replaces home directory with ~
-func shortenHome(path string) string {
-	if home := os.Getenv("HOME"); home != "" && len(path) > 0 {
-		if strings.HasPrefix(path, home) {
-			return "~" + path[len(home):]
-		}
-	}
-	return path
-}
-
-// ExpandPath expands ~ and makes path absolute relative to cwd.
-func ExpandPath(path, cwd string) string {
-	if path == "" {
-		return cwd
-	}
-
-	// Expand ~
-	if strings.HasPrefix(path, "~") {
-		if home, err := os.UserHomeDir(); err == nil {
-			path = filepath.Join(home, path[1:])
-		}
-	}
-
-	// Make absolute if relative
-	if !filepath.IsAbs(path) {
-		path = filepath.Join(cwd, path)
-	}
-
-	return filepath.Clean(path)
-}
-
-// InferName extracts the project name from a path.
-func InferName(path string) string {
-	return filepath.Base(path)
-}
-
 // Init initializes the form.
 func (m *FormModel) Init() tea.Cmd {
 	return m.form.Init()
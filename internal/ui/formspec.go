@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/charmbracelet/huh"
 	"github.com/hecate-social/hecate-tui/internal/theme"
 )
@@ -67,6 +70,7 @@ func BuildForm(spec FormSpec, t *theme.Theme, s *theme.Styles) *FormModel {
 				Title(f.Label).
 				Description(f.Description).
 				Placeholder(f.Placeholder).
+				Validate(requiredValidator(f)).
 				Value(val))
 
 		default: // FieldText
@@ -75,6 +79,7 @@ func BuildForm(spec FormSpec, t *theme.Theme, s *theme.Styles) *FormModel {
 				Title(f.Label).
 				Description(f.Description).
 				Placeholder(f.Placeholder).
+				Validate(requiredValidator(f)).
 				Value(val))
 		}
 	}
@@ -105,9 +110,19 @@ func BuildForm(spec FormSpec, t *theme.Theme, s *theme.Styles) *FormModel {
 	}
 }
 
+// requiredValidator rejects blank input for required fields.
+func requiredValidator(f FieldSpec) func(string) error {
+	return func(v string) error {
+		if f.Required && strings.TrimSpace(v) == "" {
+			return fmt.Errorf("%s is required", f.Label)
+		}
+		return nil
+	}
+}
+
 // VentureInitSpec returns the FormSpec for creating a new venture.
 func VentureInitSpec(cwd string) FormSpec {
-	cwdDisplay := shortenHome(cwd)
+	cwdDisplay := ShortenHome(cwd)
 	return FormSpec{
 		ID:    "venture_init",
 		Title: "New Venture",
@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// argLines pretty-prints tool arguments, hard-wraps them to the dialog's
+// inner width, and applies JSON syntax coloring line by line.
+func (p *ApprovalPrompt) argLines(raw json.RawMessage) []string {
+	var buf bytes.Buffer
+	text := string(raw)
+	if err := json.Indent(&buf, raw, "", "  "); err == nil {
+		text = buf.String()
+	}
+
+	width := p.width - 6 // border + padding
+	if width < 20 {
+		width = 20
+	}
+
+	var wrapped []string
+	for _, line := range strings.Split(text, "\n") {
+		wrapped = append(wrapped, hardWrap(line, width)...)
+	}
+
+	hl := jsonHighlighter{
+		key:     lipgloss.NewStyle().Foreground(p.theme.Primary),
+		str:     lipgloss.NewStyle().Foreground(p.theme.Success),
+		literal: lipgloss.NewStyle().Foreground(p.theme.Warning),
+		punct:   lipgloss.NewStyle().Foreground(p.theme.TextDim),
+	}
+	out := make([]string, len(wrapped))
+	for i, line := range wrapped {
+		out[i] = hl.line(line)
+	}
+	return out
+}
+
+// hardWrap splits a line into chunks of at most width runes.
+func hardWrap(line string, width int) []string {
+	if utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+	var chunks []string
+	runes := []rune(line)
+	for len(runes) > width {
+		chunks = append(chunks, string(runes[:width]))
+		runes = runes[width:]
+	}
+	return append(chunks, string(runes))
+}
+
+// jsonHighlighter colors JSON text. It carries string state across lines so
+// long values that were hard-wrapped keep their color.
+type jsonHighlighter struct {
+	key, str, literal, punct lipgloss.Style
+
+	inString bool
+	isKey    bool
+}
+
+func (h *jsonHighlighter) line(s string) string {
+	var b strings.Builder
+	var tok strings.Builder
+
+	flushString := func() {
+		style := h.str
+		if h.isKey {
+			style = h.key
+		}
+		b.WriteString(style.Render(tok.String()))
+		tok.Reset()
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if h.inString {
+			tok.WriteRune(r)
+			if r == '\\' && i+1 < len(runes) {
+				i++
+				tok.WriteRune(runes[i])
+				continue
+			}
+			if r == '"' {
+				h.inString = false
+				flushString()
+			}
+			continue
+		}
+
+		switch {
+		case r == '"':
+			h.inString = true
+			h.isKey = isKeyAhead(runes[i+1:])
+			tok.WriteRune(r)
+		case strings.ContainsRune("{}[],:", r):
+			b.WriteString(h.punct.Render(string(r)))
+		case r == ' ':
+			b.WriteRune(r)
+		default:
+			// number, true, false, null
+			j := i
+			for j < len(runes) && !strings.ContainsRune("{}[],: ", runes[j]) {
+				j++
+			}
+			b.WriteString(h.literal.Render(string(runes[i:j])))
+			i = j - 1
+		}
+	}
+	if tok.Len() > 0 {
+		flushString()
+	}
+	return b.String()
+}
+
+// isKeyAhead reports whether the string starting after an opening quote is
+// an object key (its closing quote is followed by a colon).
+func isKeyAhead(rest []rune) bool {
+	for i := 0; i < len(rest); i++ {
+		switch rest[i] {
+		case '\\':
+			i++
+		case '"':
+			tail := strings.TrimLeft(string(rest[i+1:]), " ")
+			return strings.HasPrefix(tail, ":")
+		}
+	}
+	return false
+}
@@ -0,0 +1,325 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// JSONViewerAction is what the studio should do after a viewer keypress.
+type JSONViewerAction int
+
+const (
+	JSONViewerNone JSONViewerAction = iota
+	JSONViewerClose
+	JSONViewerCopy
+)
+
+// jsonNode is one value in the parsed document. Object keys keep their
+// original order.
+type jsonNode struct {
+	key       string // quoted key when the parent is an object
+	open      string // "{" or "[" for containers
+	scalar    string // encoded value for scalars
+	children  []*jsonNode
+	collapsed bool
+}
+
+func (n *jsonNode) container() bool { return n.open != "" }
+
+func (n *jsonNode) close() string {
+	if n.open == "{" {
+		return "}"
+	}
+	return "]"
+}
+
+// viewLine is one rendered row and the container it toggles, if any.
+type viewLine struct {
+	node *jsonNode
+	text string
+}
+
+// JSONViewer is a scrollable, foldable JSON document overlay.
+type JSONViewer struct {
+	theme  *theme.Theme
+	styles *theme.Styles
+	title  string
+	pretty string
+	root   *jsonNode
+	lines  []viewLine
+	cursor int
+	offset int
+	width  int
+	height int
+}
+
+// NewJSONViewer parses data and returns a viewer titled title.
+func NewJSONViewer(t *theme.Theme, s *theme.Styles, title string, data []byte) (*JSONViewer, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	root, err := decodeNode(dec, "")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	var buf bytes.Buffer
+	pretty := string(data)
+	if json.Indent(&buf, data, "", "  ") == nil {
+		pretty = buf.String()
+	}
+
+	v := &JSONViewer{theme: t, styles: s, title: title, pretty: pretty, root: root, width: 80, height: 24}
+	v.rebuild()
+	return v, nil
+}
+
+func decodeNode(dec *json.Decoder, key string) (*jsonNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	n := &jsonNode{key: key}
+	switch t := tok.(type) {
+	case json.Delim:
+		n.open = t.String()
+		for dec.More() {
+			childKey := ""
+			if n.open == "{" {
+				k, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				quoted, _ := json.Marshal(k)
+				childKey = string(quoted)
+			}
+			child, err := decodeNode(dec, childKey)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, child)
+		}
+		if _, err := dec.Token(); err != nil { // closing delimiter
+			return nil, err
+		}
+	default:
+		encoded, _ := json.Marshal(t)
+		n.scalar = string(encoded)
+	}
+	return n, nil
+}
+
+// rebuild flattens the visible tree into lines.
+func (v *JSONViewer) rebuild() {
+	v.lines = v.lines[:0]
+	v.flatten(v.root, 0, true)
+	if v.cursor >= len(v.lines) {
+		v.cursor = len(v.lines) - 1
+	}
+	v.clampOffset()
+}
+
+func (v *JSONViewer) flatten(n *jsonNode, depth int, last bool) {
+	indent := strings.Repeat("  ", depth)
+	prefix := indent
+	if n.key != "" {
+		prefix += n.key + ": "
+	}
+	comma := ","
+	if last {
+		comma = ""
+	}
+
+	switch {
+	case !n.container():
+		v.lines = append(v.lines, viewLine{text: prefix + n.scalar + comma})
+	case len(n.children) == 0:
+		v.lines = append(v.lines, viewLine{text: prefix + n.open + n.close() + comma})
+	case n.collapsed:
+		unit := "item"
+		if n.open == "{" {
+			unit = "key"
+		}
+		if len(n.children) != 1 {
+			unit += "s"
+		}
+		v.lines = append(v.lines, viewLine{
+			node: n,
+			text: fmt.Sprintf("%s%s…%s%s  (%d %s)", prefix, n.open, n.close(), comma, len(n.children), unit),
+		})
+	default:
+		v.lines = append(v.lines, viewLine{node: n, text: prefix + n.open})
+		for i, c := range n.children {
+			v.flatten(c, depth+1, i == len(n.children)-1)
+		}
+		v.lines = append(v.lines, viewLine{node: n, text: indent + n.close() + comma})
+	}
+}
+
+// SetSize sets the overlay dimensions.
+func (v *JSONViewer) SetSize(w, h int) {
+	v.width, v.height = w, h
+	v.clampOffset()
+}
+
+// Content returns the pretty-printed document (for copying).
+func (v *JSONViewer) Content() string {
+	return v.pretty
+}
+
+// bodyHeight is the number of document rows shown (minus border, title
+// and footer).
+func (v *JSONViewer) bodyHeight() int {
+	h := v.height - 6
+	if h < 3 {
+		h = 3
+	}
+	return h
+}
+
+func (v *JSONViewer) clampOffset() {
+	if v.cursor < 0 {
+		v.cursor = 0
+	}
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	}
+	if v.cursor >= v.offset+v.bodyHeight() {
+		v.offset = v.cursor - v.bodyHeight() + 1
+	}
+	if v.offset < 0 {
+		v.offset = 0
+	}
+}
+
+// HandleKey moves, folds and reports close/copy requests.
+func (v *JSONViewer) HandleKey(key string) JSONViewerAction {
+	switch key {
+	case "esc", "q":
+		return JSONViewerClose
+	case "y":
+		return JSONViewerCopy
+	case "j", "down":
+		v.cursor++
+	case "k", "up":
+		v.cursor--
+	case "ctrl+d", "pgdown":
+		v.cursor += v.bodyHeight() / 2
+	case "ctrl+u", "pgup":
+		v.cursor -= v.bodyHeight() / 2
+	case "g", "home":
+		v.cursor = 0
+	case "G", "end":
+		v.cursor = len(v.lines) - 1
+	case "enter", " ":
+		if n := v.lines[v.cursor].node; n != nil {
+			v.fold(n, !n.collapsed)
+		}
+	case "h", "left":
+		if n := v.lines[v.cursor].node; n != nil && !n.collapsed {
+			v.fold(n, true)
+		}
+	case "l", "right":
+		if n := v.lines[v.cursor].node; n != nil && n.collapsed {
+			v.fold(n, false)
+		}
+	case "c":
+		setCollapsed(v.root, true)
+		v.root.collapsed = false
+		v.cursor = 0
+		v.rebuild()
+	case "e":
+		setCollapsed(v.root, false)
+		v.rebuild()
+	}
+	if v.cursor >= len(v.lines) {
+		v.cursor = len(v.lines) - 1
+	}
+	v.clampOffset()
+	return JSONViewerNone
+}
+
+// fold collapses or expands n and keeps the cursor on its opening line.
+func (v *JSONViewer) fold(n *jsonNode, collapsed bool) {
+	n.collapsed = collapsed
+	v.rebuild()
+	for i, l := range v.lines {
+		if l.node == n {
+			v.cursor = i
+			break
+		}
+	}
+	v.clampOffset()
+}
+
+func setCollapsed(n *jsonNode, collapsed bool) {
+	if !n.container() {
+		return
+	}
+	n.collapsed = collapsed
+	for _, c := range n.children {
+		setCollapsed(c, collapsed)
+	}
+}
+
+// View renders the overlay.
+func (v *JSONViewer) View() string {
+	inner := v.width - 4 // border + padding
+	if inner < 20 {
+		inner = 20
+	}
+
+	hl := jsonHighlighter{
+		key:     lipgloss.NewStyle().Foreground(v.theme.Primary),
+		str:     lipgloss.NewStyle().Foreground(v.theme.Success),
+		literal: lipgloss.NewStyle().Foreground(v.theme.Warning),
+		punct:   lipgloss.NewStyle().Foreground(v.theme.TextDim),
+	}
+	marker := lipgloss.NewStyle().Foreground(v.theme.Accent).Render("›")
+
+	var rows []string
+	end := v.offset + v.bodyHeight()
+	if end > len(v.lines) {
+		end = len(v.lines)
+	}
+	for i := v.offset; i < end; i++ {
+		text := truncateRunes(v.lines[i].text, inner-2)
+		prefix := "  "
+		if i == v.cursor {
+			prefix = marker + " "
+		}
+		hl.inString = false
+		rows = append(rows, prefix+hl.line(text))
+	}
+	for len(rows) < v.bodyHeight() {
+		rows = append(rows, "")
+	}
+
+	position := fmt.Sprintf("%d/%d", v.cursor+1, len(v.lines))
+	title := v.styles.CardTitle.Render(v.title) + "  " + v.styles.Subtle.Render(position)
+	footer := v.styles.Subtle.Render("j/k move  Enter fold  c/e collapse/expand all  y copy  Esc close")
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Primary).
+		Padding(0, 1).
+		Width(v.width - 2).
+		Render(title + "\n\n" + strings.Join(rows, "\n") + "\n\n" + footer)
+}
+
+// truncateRunes cuts s to at most width runes, marking the cut.
+func truncateRunes(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestJSONViewerFolding(t *testing.T) {
+	th := theme.HecateDark()
+	v, err := NewJSONViewer(th, th.ComputeStyles(), "test", []byte(`{"b":1,"a":{"x":[1,2]},"c":"s"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Keys keep document order
+	if got := v.lines[1].text; got != `  "b": 1,` {
+		t.Errorf("line 1 = %q", got)
+	}
+	expanded := len(v.lines)
+
+	v.cursor = 2 // "a": {
+	v.HandleKey("enter")
+	if len(v.lines) != expanded-5 {
+		t.Fatalf("collapsed line count = %d, want %d", len(v.lines), expanded-5)
+	}
+	if got := v.lines[2].text; !strings.Contains(got, `"a": {…},`) || !strings.Contains(got, "(1 key)") {
+		t.Errorf("collapsed line = %q", got)
+	}
+
+	v.HandleKey("e")
+	if len(v.lines) != expanded {
+		t.Errorf("expand all gave %d lines, want %d", len(v.lines), expanded)
+	}
+
+	if v.HandleKey("y") != JSONViewerCopy || v.HandleKey("esc") != JSONViewerClose {
+		t.Error("y/esc should request copy/close")
+	}
+}
+
+func TestJSONViewerRejectsInvalid(t *testing.T) {
+	th := theme.HecateDark()
+	for _, in := range []string{`{"a":`, `[1] [2]`, ``} {
+		if _, err := NewJSONViewer(th, th.ComputeStyles(), "t", []byte(in)); err == nil {
+			t.Errorf("NewJSONViewer(%q) should fail", in)
+		}
+	}
+}
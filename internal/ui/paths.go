@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExpandHome replaces a leading "~" or "~/" with the user's home
+// directory. Other "~user" forms are returned unchanged.
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
+// ShortenHome replaces the home directory prefix of path with ~.
+func ShortenHome(path string) string {
+	if home := os.Getenv("HOME"); home != "" && len(path) > 0 {
+		if strings.HasPrefix(path, home) {
+			return "~" + path[len(home):]
+		}
+	}
+	return path
+}
+
+// ExpandPath expands ~ and makes path absolute relative to cwd, returning
+// it cleaned of "..", "." and trailing separators. An empty path is cwd.
+func ExpandPath(path, cwd string) string {
+	if path == "" {
+		return cwd
+	}
+	path = ExpandHome(path)
+	if !filepath.IsAbs(path) {
+		if cwd == "" {
+			if abs, err := filepath.Abs(path); err == nil {
+				return abs
+			}
+		}
+		path = filepath.Join(cwd, path)
+	}
+	return filepath.Clean(path)
+}
+
+// InferName extracts the project name from a path: its last element, or
+// "unnamed" for a filesystem root.
+func InferName(path string) string {
+	name := filepath.Base(filepath.Clean(path))
+	if name == "." || name == string(filepath.Separator) || strings.HasSuffix(name, ":"+string(filepath.Separator)) {
+		return "unnamed"
+	}
+	return name
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpandPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	cwd := filepath.Join(home, "work")
+
+	tests := []struct {
+		path, want string
+	}{
+		{"", cwd},
+		{"~", home},
+		{"~/sub", filepath.Join(home, "sub")},
+		{"~/sub/", filepath.Join(home, "sub")},
+		{"project", filepath.Join(cwd, "project")},
+		{"project/", filepath.Join(cwd, "project")},
+		{"../other/./x", filepath.Join(home, "other", "x")},
+		{"~bob/x", filepath.Join(cwd, "~bob", "x")},
+	}
+	for _, tt := range tests {
+		if got := ExpandPath(tt.path, cwd); got != tt.want {
+			t.Errorf("ExpandPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	abs := filepath.Join(os.TempDir(), "abs")
+	if got := ExpandPath(abs+string(filepath.Separator), cwd); got != abs {
+		t.Errorf("ExpandPath(absolute/) = %q, want %q", got, abs)
+	}
+}
+
+func TestInferName(t *testing.T) {
+	tests := []struct {
+		path, want string
+	}{
+		{filepath.Join("a", "my-venture"), "my-venture"},
+		{filepath.Join("a", "my-venture") + string(filepath.Separator), "my-venture"},
+		{string(filepath.Separator), "unnamed"},
+		{"", "unnamed"},
+	}
+	for _, tt := range tests {
+		if got := InferName(tt.path); got != tt.want {
+			t.Errorf("InferName(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ProgressBar renders "[===---] 42%" with the filled part in fill and the
+// remainder in track. A zero total renders as empty.
+func ProgressBar(current, total, width int, fill, track lipgloss.Color) string {
+	if total <= 0 {
+		total = 1
+	}
+	if current < 0 {
+		current = 0
+	}
+	filled := (current * width) / total
+	if filled > width {
+		filled = width
+	}
+	empty := width - filled
+
+	bar := lipgloss.NewStyle().Foreground(fill).
+		Render(strings.Repeat("=", filled))
+	bar += lipgloss.NewStyle().Foreground(track).
+		Render(strings.Repeat("-", empty))
+
+	pct := (current * 100) / total
+	if pct > 100 {
+		pct = 100
+	}
+	return fmt.Sprintf("[%s] %d%%", bar, pct)
+}
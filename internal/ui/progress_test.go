@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestProgressBar(t *testing.T) {
+	c := lipgloss.Color("#ffffff")
+	tests := []struct {
+		current, total int
+		want           string
+	}{
+		{3, 6, "[=====-----] 50%"},
+		{0, 0, "[----------] 0%"},
+		{9, 6, "[==========] 100%"},
+	}
+	for _, tt := range tests {
+		got := ProgressBar(tt.current, tt.total, 10, c, c)
+		if !strings.Contains(got, tt.want) {
+			t.Errorf("ProgressBar(%d, %d) = %q, want %q", tt.current, tt.total, got, tt.want)
+		}
+	}
+}
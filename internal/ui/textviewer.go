@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+// TextViewer is a scrollable, read-only overlay for pre-rendered text.
+type TextViewer struct {
+	theme  *theme.Theme
+	styles *theme.Styles
+	title  string
+	lines  []string
+	offset int
+	width  int
+	height int
+}
+
+// NewTextViewer returns a viewer titled title showing content.
+func NewTextViewer(t *theme.Theme, s *theme.Styles, title, content string) *TextViewer {
+	return &TextViewer{
+		theme:  t,
+		styles: s,
+		title:  title,
+		lines:  strings.Split(content, "\n"),
+		width:  80,
+		height: 24,
+	}
+}
+
+// SetSize sets the overlay dimensions.
+func (v *TextViewer) SetSize(w, h int) {
+	v.width, v.height = w, h
+	v.clampOffset()
+}
+
+// bodyHeight is the number of content rows shown (minus border, title
+// and footer).
+func (v *TextViewer) bodyHeight() int {
+	h := v.height - 6
+	if h < 3 {
+		h = 3
+	}
+	return h
+}
+
+func (v *TextViewer) maxOffset() int {
+	if m := len(v.lines) - v.bodyHeight(); m > 0 {
+		return m
+	}
+	return 0
+}
+
+func (v *TextViewer) clampOffset() {
+	if v.offset > v.maxOffset() {
+		v.offset = v.maxOffset()
+	}
+	if v.offset < 0 {
+		v.offset = 0
+	}
+}
+
+// HandleKey scrolls the view and reports whether the viewer should close.
+func (v *TextViewer) HandleKey(key string) (closed bool) {
+	switch key {
+	case "esc", "q":
+		return true
+	case "j", "down":
+		v.offset++
+	case "k", "up":
+		v.offset--
+	case "ctrl+d", "pgdown", " ":
+		v.offset += v.bodyHeight() / 2
+	case "ctrl+u", "pgup":
+		v.offset -= v.bodyHeight() / 2
+	case "g", "home":
+		v.offset = 0
+	case "G", "end":
+		v.offset = v.maxOffset()
+	}
+	v.clampOffset()
+	return false
+}
+
+// View renders the overlay.
+func (v *TextViewer) View() string {
+	end := v.offset + v.bodyHeight()
+	if end > len(v.lines) {
+		end = len(v.lines)
+	}
+	rows := append([]string(nil), v.lines[v.offset:end]...)
+	for len(rows) < v.bodyHeight() {
+		rows = append(rows, "")
+	}
+
+	title := v.styles.CardTitle.Render(v.title)
+	if v.maxOffset() > 0 {
+		title += "  " + v.styles.Subtle.Render(fmt.Sprintf("%d-%d/%d", v.offset+1, end, len(v.lines)))
+	}
+	footer := v.styles.Subtle.Render("j/k scroll  Ctrl+D/U page  g/G top/bottom  Esc close")
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(v.theme.Primary).
+		Padding(0, 1).
+		Width(v.width - 2).
+		Render(title + "\n\n" + strings.Join(rows, "\n") + "\n\n" + footer)
+}
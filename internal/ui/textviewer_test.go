@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hecate-social/hecate-tui/internal/theme"
+)
+
+func TestTextViewerScrolling(t *testing.T) {
+	th := theme.HecateDark()
+	var lines []string
+	for i := 0; i < 40; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	v := NewTextViewer(th, th.ComputeStyles(), "test", strings.Join(lines, "\n"))
+	v.SetSize(80, 16) // 10 body rows
+
+	v.HandleKey("k")
+	if v.offset != 0 {
+		t.Errorf("offset after k at top = %d, want 0", v.offset)
+	}
+	v.HandleKey("ctrl+d")
+	if v.offset != 5 {
+		t.Errorf("offset after ctrl+d = %d, want 5", v.offset)
+	}
+	v.HandleKey("G")
+	if v.offset != 30 {
+		t.Errorf("offset after G = %d, want 30", v.offset)
+	}
+	v.HandleKey("j")
+	if v.offset != 30 {
+		t.Errorf("offset past bottom = %d, want 30", v.offset)
+	}
+	if !strings.Contains(v.View(), "line 39") {
+		t.Error("bottom view should show the last line")
+	}
+	if !v.HandleKey("esc") {
+		t.Error("esc should close")
+	}
+}